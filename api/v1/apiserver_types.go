@@ -30,8 +30,80 @@ type APIServerSpec struct {
 	// used in conjunction with ControlPlaneNodeSelector or ControlPlaneTolerations, then these overrides
 	// take precedence.
 	APIServerDeployment *APIServerDeployment `json:"apiServerDeployment,omitempty"`
+
+	// TLS configures the serving certificate and TLS settings of the API server.
+	// +optional
+	TLS *APIServerTLS `json:"tls,omitempty"`
+
+	// UserWorkloadTier configures a policy tier for user workloads that the operator creates and keeps at the
+	// configured order. If not specified, the operator does not manage a tier for user workloads. Removing this
+	// field does not delete a previously created tier.
+	// +optional
+	UserWorkloadTier *UserWorkloadTier `json:"userWorkloadTier,omitempty"`
+
+	// RequestTimeout is the duration after which the API server times out a request. It must be positive.
+	// If omitted, the API server's default of 60s is used.
+	// +optional
+	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
+
+	// MaxRequestsInflight is the maximum number of non-mutating requests the API server handles at a time; further
+	// requests are rejected with a 429 response. It must be positive.
+	// If omitted, the API server's default of 400 is used.
+	// +optional
+	MaxRequestsInflight *int32 `json:"maxRequestsInflight,omitempty"`
+
+	// MaxMutatingRequestsInflight is the maximum number of mutating requests the API server handles at a time;
+	// further requests are rejected with a 429 response. It must be positive.
+	// If omitted, the API server's default of 200 is used.
+	// +optional
+	MaxMutatingRequestsInflight *int32 `json:"maxMutatingRequestsInflight,omitempty"`
+}
+
+// UserWorkloadTier defines a policy tier for user workloads that is managed by the operator.
+type UserWorkloadTier struct {
+	// Name is the name of the tier. It must not be "allow-tigera" or "default".
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Order is the precedence of the tier; tiers with lower orders are evaluated first. It must be greater than
+	// the order of the allow-tigera tier (100), so that policy for Calico components is always evaluated first.
+	Order int32 `json:"order"`
+}
+
+// APIServerTLS defines the serving certificate configuration of the API server.
+type APIServerTLS struct {
+	// SecretName is the name of a secret in the tigera-operator namespace that contains the private key (tls.key)
+	// and certificate (tls.crt) that the API server serves with. The certificate must be valid for the API server's
+	// service DNS name, <service>.<namespace>.svc. When set, this certificate is used instead of one issued by
+	// the operator.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// MinVersion is the minimum TLS version the API server accepts from clients, including the Kubernetes
+	// aggregation layer.
+	// If omitted, the API server's default minimum version is used.
+	// +kubebuilder:validation:Enum=VersionTLS12;VersionTLS13
+	// +optional
+	MinVersion *TLSVersion `json:"minVersion,omitempty"`
+
+	// CipherSuites is the list of TLS 1.2 cipher suites the API server accepts, using their IANA names, e.g.
+	// TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256. Only cipher suites without known security issues are supported.
+	// The TLS 1.3 cipher suites are not configurable, so this cannot be combined with a minVersion of VersionTLS13.
+	// If omitted, the API server's default cipher suites are used.
+	// +optional
+	CipherSuites []string `json:"cipherSuites,omitempty"`
 }
 
+// TLSVersion is a TLS protocol version.
+//
+// One of: VersionTLS12, VersionTLS13
+type TLSVersion string
+
+const (
+	TLSVersion12 TLSVersion = "VersionTLS12"
+	TLSVersion13 TLSVersion = "VersionTLS13"
+)
+
 // APIServerStatus defines the observed state of Tigera API server.
 type APIServerStatus struct {
 	// State provides user-readable status.
@@ -62,6 +62,26 @@ type AuthenticationSpec struct {
 	// DexDeployment configures the Dex Deployment.
 	// +optional
 	DexDeployment *DexDeployment `json:"dexDeployment,omitempty"`
+
+	// ExternalDex configures components to use a Dex instance that is run outside of the operator, instead of
+	// deploying Dex. The identity provider is then configured in the external Dex, so OIDC, Openshift and LDAP
+	// must not be set. The external Dex must have a static client with ID tigera-manager, and with redirect
+	// URIs <managerDomain>/login/oidc/callback and <managerDomain>/tigera-kibana/api/security/oidc/callback.
+	// +optional
+	ExternalDex *AuthenticationExternalDex `json:"externalDex,omitempty"`
+
+	// DexNamespace is the namespace that Dex is deployed into. If it is not tigera-dex, the namespace
+	// must be created before Dex can be rendered.
+	// Default: tigera-dex
+	// +optional
+	DexNamespace string `json:"dexNamespace,omitempty"`
+
+	// SigningKeyRotationPeriod is how often Dex rotates the keys it uses to sign ID tokens, e.g. 24h.
+	// It must be between 15m, the lifetime of the ID tokens Dex issues, and 2160h (90 days).
+	// Not supported together with ExternalDex.
+	// If omitted, Dex uses its default rotation period of 6h.
+	// +optional
+	SigningKeyRotationPeriod *metav1.Duration `json:"signingKeyRotationPeriod,omitempty"`
 }
 
 // AuthenticationStatus defines the observed state of Authentication
@@ -152,6 +172,18 @@ const (
 	PromptTypeSelectAccount PromptType = "SelectAccount"
 )
 
+// AuthenticationExternalDex is the configuration needed to use an external Dex.
+type AuthenticationExternalDex struct {
+	// IssuerURL is the issuer URL of the external Dex, e.g. https://dex.example.com/dex. It must use https.
+	// +required
+	IssuerURL string `json:"issuerURL"`
+
+	// CASecretName is the name of a secret in the tigera-operator namespace that holds the CA certificate of the
+	// external Dex's serving certificate under the key rootCA. If omitted, the system root CAs are trusted.
+	// +optional
+	CASecretName string `json:"caSecretName,omitempty"`
+}
+
 // AuthenticationOpenshift is the configuration needed to setup Openshift.
 type AuthenticationOpenshift struct {
 	// IssuerURL is the URL to the Openshift OAuth provider. Ex.: https://api.my-ocp-domain.com:6443
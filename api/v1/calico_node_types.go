@@ -34,6 +34,28 @@ type CalicoNodeDaemonSetContainer struct {
 	// If used in conjunction with the deprecated ComponentResources, then this value takes precedence.
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// StartupProbe configures a startup probe for the calico-node container. The liveness and readiness probes
+	// only start once it succeeds, so that calico-node is not restarted on nodes that are slow to boot.
+	// If omitted, no startup probe is used.
+	// +optional
+	StartupProbe *CalicoNodeStartupProbe `json:"startupProbe,omitempty"`
+}
+
+// CalicoNodeStartupProbe configures the startup probe of the calico-node container. The probe checks Felix's
+// liveness endpoint, and calico-node is restarted if it does not succeed within periodSeconds * failureThreshold.
+type CalicoNodeStartupProbe struct {
+	// PeriodSeconds is how often, in seconds, the startup probe is run.
+	// Default: 10
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+
+	// FailureThreshold is the number of consecutive failures after which calico-node is restarted.
+	// Default: 30
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
 }
 
 // CalicoNodeDaemonSetInitContainer is a calico-node DaemonSet init container.
@@ -65,6 +87,13 @@ type CalicoNodeDaemonSetPodSpec struct {
 	// +optional
 	Containers []CalicoNodeDaemonSetContainer `json:"containers,omitempty"`
 
+	// ExtraContainers is a list of user-provided sidecar containers to add to the calico-node DaemonSet,
+	// for example to run custom telemetry agents alongside calico-node. They are added after the containers
+	// managed by the operator. Names must be unique within the calico-node pod, and volume mounts may only
+	// refer to volumes of the calico-node pod.
+	// +optional
+	ExtraContainers []v1.Container `json:"extraContainers,omitempty"`
+
 	// Affinity is a group of affinity scheduling rules for the calico-node pods.
 	// If specified, this overrides any affinity that may be set on the calico-node DaemonSet.
 	// If omitted, the calico-node DaemonSet will use its default value for affinity.
@@ -191,6 +220,34 @@ func (c *CalicoNodeDaemonSet) GetContainers() []v1.Container {
 	return nil
 }
 
+// GetExtraContainers returns the user-provided sidecar containers to add to the calico-node DaemonSet.
+func (c *CalicoNodeDaemonSet) GetExtraContainers() []v1.Container {
+	if c.Spec != nil {
+		if c.Spec.Template != nil {
+			if c.Spec.Template.Spec != nil {
+				return c.Spec.Template.Spec.ExtraContainers
+			}
+		}
+	}
+	return nil
+}
+
+// GetStartupProbe returns the startup probe configured for the named calico-node container, or nil if none is set.
+func (c *CalicoNodeDaemonSet) GetStartupProbe(name string) *CalicoNodeStartupProbe {
+	if c.Spec != nil {
+		if c.Spec.Template != nil {
+			if c.Spec.Template.Spec != nil {
+				for _, v := range c.Spec.Template.Spec.Containers {
+					if v.Name == name {
+						return v.StartupProbe
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (c *CalicoNodeDaemonSet) GetAffinity() *v1.Affinity {
 	if c.Spec != nil {
 		if c.Spec.Template != nil {
@@ -35,6 +35,27 @@ type DexDeploymentSpec struct {
 	// Template describes the Dex Deployment pod that will be created.
 	// +optional
 	Template *DexDeploymentPodTemplateSpec `json:"template,omitempty"`
+
+	// RevisionHistoryLimit is the number of old ReplicaSets to retain to allow rollback of the Dex Deployment.
+	// If omitted, the Kubernetes default of 10 is used.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// The deployment strategy to use to replace existing pods with new ones.
+	// If omitted, the Dex Deployment uses a RollingUpdate strategy with the Kubernetes defaults.
+	// +optional
+	// +patchStrategy=retainKeys
+	Strategy *DexDeploymentStrategy `json:"strategy,omitempty" patchStrategy:"retainKeys"`
+}
+
+// DexDeploymentStrategy describes how to replace existing pods with new ones. Only RollingUpdate is supported
+// at this time so the Type field is not exposed.
+type DexDeploymentStrategy struct {
+	// Rolling update config params. Present only if DeploymentStrategyType =
+	// RollingUpdate.
+	// +optional
+	RollingUpdate *appsv1.RollingUpdateDeployment `json:"rollingUpdate,omitempty"`
 }
 
 // DexDeploymentPodTemplateSpec is the Dex Deployment's PodTemplateSpec
@@ -58,6 +79,13 @@ type DexDeploymentPodSpec struct {
 	// If omitted, the Dex Deployment will use its default values for its containers.
 	// +optional
 	Containers []DexDeploymentContainer `json:"containers,omitempty"`
+
+	// TerminationGracePeriodSeconds is the duration in seconds the Dex pod has to finish serving in-flight
+	// requests after it is sent a termination signal, before it is forcibly halted.
+	// If omitted, the Dex Deployment uses a grace period of 30 seconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
 }
 
 // DexDeploymentContainer is a Dex Deployment container.
@@ -96,6 +124,13 @@ func (c *DexDeployment) GetMinReadySeconds() *int32 {
 	return nil
 }
 
+func (c *DexDeployment) GetRevisionHistoryLimit() *int32 {
+	if c.Spec != nil {
+		return c.Spec.RevisionHistoryLimit
+	}
+	return nil
+}
+
 func (c *DexDeployment) GetPodTemplateMetadata() *Metadata {
 	return nil
 }
@@ -164,10 +199,23 @@ func (c *DexDeployment) GetTolerations() []v1.Toleration {
 }
 
 func (c *DexDeployment) GetTerminationGracePeriodSeconds() *int64 {
+	if c.Spec != nil {
+		if c.Spec.Template != nil {
+			if c.Spec.Template.Spec != nil {
+				return c.Spec.Template.Spec.TerminationGracePeriodSeconds
+			}
+		}
+	}
 	return nil
 }
 
 func (c *DexDeployment) GetDeploymentStrategy() *appsv1.DeploymentStrategy {
+	if c.Spec != nil && c.Spec.Strategy != nil && c.Spec.Strategy.RollingUpdate != nil {
+		return &appsv1.DeploymentStrategy{
+			Type:          appsv1.RollingUpdateDeploymentStrategyType,
+			RollingUpdate: c.Spec.Strategy.RollingUpdate,
+		}
+	}
 	return nil
 }
 
@@ -35,10 +35,22 @@ type GuardianDeploymentSpec struct {
 	// Template describes the guardian Deployment pod that will be created.
 	// +optional
 	Template *GuardianDeploymentPodTemplateSpec `json:"template,omitempty"`
+
+	// RevisionHistoryLimit is the number of old ReplicaSets to retain to allow rollback of the guardian Deployment.
+	// If omitted, the Kubernetes default of 10 is used.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
 }
 
 // GuardianDeploymentPodTemplateSpec is the guardian Deployment's PodTemplateSpec
 type GuardianDeploymentPodTemplateSpec struct {
+	// Metadata is a subset of a Kubernetes object's metadata that is added to
+	// the pod's metadata. Annotations are passed through to the guardian pods as given, which allows
+	// tooling such as checkpoint/restore to be configured with its own pod annotations. Annotations
+	// managed by the operator (those with a hash.operator.tigera.io key) cannot be overridden.
+	// +optional
+	Metadata *Metadata `json:"metadata,omitempty"`
 
 	// Spec is the guardian Deployment's PodSpec.
 	// +optional
@@ -58,6 +70,12 @@ type GuardianDeploymentPodSpec struct {
 	// If omitted, the guardian Deployment will use its default values for its containers.
 	// +optional
 	Containers []GuardianDeploymentContainer `json:"containers,omitempty"`
+
+	// ExtraInitContainers is a list of user-provided init containers to add to the guardian Deployment.
+	// These run after any init containers managed by the operator, and can be used to perform
+	// environment specific pre-start steps. Names must be unique within the guardian pod.
+	// +optional
+	ExtraInitContainers []v1.Container `json:"extraInitContainers,omitempty"`
 }
 
 // GuardianDeploymentContainer is a guardian Deployment container.
@@ -72,8 +90,23 @@ type GuardianDeploymentContainer struct {
 	// If omitted, the guardian Deployment will use its default value for this container's resources.
 	// +optional
 	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+
+	// LivenessProbeType selects the type of liveness probe used for the guardian container.
+	// HTTP probes guardian's health endpoint, while TCP only checks that the health port accepts connections.
+	// Default: HTTP
+	// +optional
+	LivenessProbeType *GuardianProbeType `json:"livenessProbeType,omitempty"`
 }
 
+// GuardianProbeType is the type of probe used for a guardian container.
+// +kubebuilder:validation:Enum=HTTP;TCP
+type GuardianProbeType string
+
+const (
+	GuardianProbeTypeHTTP GuardianProbeType = "HTTP"
+	GuardianProbeTypeTCP  GuardianProbeType = "TCP"
+)
+
 // GuardianDeploymentInitContainer is a guardian Deployment init container.
 type GuardianDeploymentInitContainer struct {
 	// Name is an enum which identifies the guardian Deployment init container by name.
@@ -94,7 +127,19 @@ func (c *GuardianDeployment) GetMinReadySeconds() *int32 {
 	return nil
 }
 
+func (c *GuardianDeployment) GetRevisionHistoryLimit() *int32 {
+	if c.Spec != nil {
+		return c.Spec.RevisionHistoryLimit
+	}
+	return nil
+}
+
 func (c *GuardianDeployment) GetPodTemplateMetadata() *Metadata {
+	if c.Spec != nil {
+		if c.Spec.Template != nil {
+			return c.Spec.Template.Metadata
+		}
+	}
 	return nil
 }
 
@@ -142,6 +187,34 @@ func (c *GuardianDeployment) GetContainers() []v1.Container {
 	return nil
 }
 
+// GetExtraInitContainers returns the user-provided init containers to add to the guardian Deployment.
+func (c *GuardianDeployment) GetExtraInitContainers() []v1.Container {
+	if c.Spec != nil {
+		if c.Spec.Template != nil {
+			if c.Spec.Template.Spec != nil {
+				return c.Spec.Template.Spec.ExtraInitContainers
+			}
+		}
+	}
+	return nil
+}
+
+// GetLivenessProbeType returns the liveness probe type configured for the named guardian container, or nil if none is set.
+func (c *GuardianDeployment) GetLivenessProbeType(name string) *GuardianProbeType {
+	if c.Spec != nil {
+		if c.Spec.Template != nil {
+			if c.Spec.Template.Spec != nil {
+				for _, v := range c.Spec.Template.Spec.Containers {
+					if v.Name == name {
+						return v.LivenessProbeType
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (c *GuardianDeployment) GetAffinity() *v1.Affinity {
 	return nil
 }
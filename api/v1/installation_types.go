@@ -116,7 +116,8 @@ type InstallationSpec struct {
 
 	// NodeMetricsPort specifies which port calico/node serves prometheus metrics on. By default, metrics are not enabled.
 	// If specified, this overrides any FelixConfiguration resources which may exist. If omitted, then
-	// prometheus metrics may still be configured through FelixConfiguration.
+	// prometheus metrics may still be configured through FelixConfiguration. When the field is removed, the metrics
+	// settings it applied to the default FelixConfiguration are reverted, unless they have been changed since.
 	// +optional
 	NodeMetricsPort *int32 `json:"nodeMetricsPort,omitempty"`
 
@@ -124,6 +125,20 @@ type InstallationSpec struct {
 	// +optional
 	TyphaMetricsPort *int32 `json:"typhaMetricsPort,omitempty"`
 
+	// DefaultPodSecurityContext is a baseline pod securityContext applied to the pods of all components
+	// managed by the operator. Each field is only applied to pods that don't already set it. The runAsNonRoot,
+	// runAsUser and runAsGroup fields are only applied to pods whose containers all declare that they run as a
+	// non-root user, so pods that run as root, such as calico-node, are left alone. Only runAsNonRoot,
+	// runAsUser, runAsGroup, fsGroup and seccompProfile are supported.
+	// +optional
+	DefaultPodSecurityContext *v1.PodSecurityContext `json:"defaultPodSecurityContext,omitempty"`
+
+	// FailsafePorts configures the host ports that Felix always allows traffic to and from, regardless of
+	// host endpoint policy. If specified, this overrides the corresponding failsafe port settings in the
+	// default FelixConfiguration. If omitted, the FelixConfiguration settings (or Felix's defaults) are used.
+	// +optional
+	FailsafePorts *FailsafePorts `json:"failsafePorts,omitempty"`
+
 	// FlexVolumePath optionally specifies a custom path for FlexVolume. If not specified, FlexVolume will be
 	// enabled by default. If set to 'None', FlexVolume will be disabled. The default is based on the
 	// kubernetesProvider.
@@ -137,10 +152,22 @@ type InstallationSpec struct {
 	KubeletVolumePluginPath string `json:"kubeletVolumePluginPath,omitempty"`
 
 	// NodeUpdateStrategy can be used to customize the desired update strategy, such as the MaxUnavailable
-	// field.
+	// field. Supported types are RollingUpdate and OnDelete. When OnDelete is used, updates to calico-node
+	// are only rolled out when the existing calico-node pods are manually deleted.
 	// +optional
 	NodeUpdateStrategy appsv1.DaemonSetUpdateStrategy `json:"nodeUpdateStrategy,omitempty"`
 
+	// MaxConcurrentNodeRestarts caps the number of calico-node pods that may be restarted at the same time
+	// during a rolling update, regardless of what triggered the rollout. When NodeUpdateStrategy's
+	// maxUnavailable is larger than this value it is reduced to this value. The limit also applies when
+	// migrating calico-node from kube-system. The cap applies to each calico-node DaemonSet separately, so
+	// calico-node and calico-node-windows may each restart up to this many pods at the same time.
+	// Requires an integer NodeUpdateStrategy maxUnavailable.
+	// If omitted, only NodeUpdateStrategy limits restarts.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentNodeRestarts *int32 `json:"maxConcurrentNodeRestarts,omitempty"`
+
 	// Deprecated. Please use CalicoNodeDaemonSet, TyphaDeployment, and KubeControllersDeployment.
 	// ComponentResources can be used to customize the resource requirements for each component.
 	// Node, Typha, and KubeControllers are supported for installations.
@@ -168,6 +195,18 @@ type InstallationSpec struct {
 	// conjunction with the deprecated ComponentResources, then these overrides take precedence.
 	CalicoKubeControllersDeployment *CalicoKubeControllersDeployment `json:"calicoKubeControllersDeployment,omitempty"`
 
+	// KubeControllersConfig configures the controllers run by calico-kube-controllers. When set, the operator writes
+	// these settings to the default KubeControllersConfiguration. Settings that are omitted are left unchanged on the
+	// KubeControllersConfiguration, so calico-kube-controllers' defaults apply unless they are configured there directly.
+	// +optional
+	KubeControllersConfig *KubeControllersConfig `json:"kubeControllersConfig,omitempty"`
+
+	// NodeLocalDNSCache configures the allow-tigera.node-local-dns policy, which allows DNS traffic from Calico
+	// components to NodeLocal DNSCache. That policy is rendered when the kube-system/node-local-dns DaemonSet is
+	// detected, or when this is set, and always allows the kube-dns service IPs. It is not rendered on OpenShift.
+	// +optional
+	NodeLocalDNSCache *NodeLocalDNSCache `json:"nodeLocalDNSCache,omitempty"`
+
 	// TyphaDeployment configures the typha Deployment. If used in conjunction with the deprecated
 	// ComponentResources or TyphaAffinity, then these overrides take precedence.
 	TyphaDeployment *TyphaDeployment `json:"typhaDeployment,omitempty"`
@@ -196,6 +235,66 @@ type InstallationSpec struct {
 	// Kubernetes Service CIDRs. Specifying this is required when using Calico for Windows.
 	// +optional
 	ServiceCIDRs []string `json:"serviceCIDRs,omitempty"`
+
+	// NamespaceResourceQuotas configures ResourceQuotas for namespaces managed by the operator.
+	// A ResourceQuota is created in each listed namespace once that namespace exists.
+	// If omitted, the operator does not create any ResourceQuotas.
+	// +optional
+	NamespaceResourceQuotas []NamespaceResourceQuota `json:"namespaceResourceQuotas,omitempty"`
+
+	// PodSecurityAdmissionModes configures the Pod Security Admission modes that the operator labels the namespaces
+	// it manages with. Each namespace is labelled with the pod security standard its components require, e.g.
+	// privileged for calico-system, so that labelling the audit and warn modes as well exempts those namespaces
+	// from cluster-wide restricted audit and warn defaults. The list must include Enforce.
+	// Default: [Enforce]
+	// +optional
+	PodSecurityAdmissionModes []PodSecurityAdmissionMode `json:"podSecurityAdmissionModes,omitempty"`
+
+	// CalicoNodeGenerationLabel is the key of a label that the operator adds to calico-node pods, with the
+	// generation of the Installation the pods were rendered from as its value, e.g.
+	// example.com/installation-generation. It lets calico-node pods be correlated with the Installation change
+	// that rolled them out. Since the label changes whenever the Installation spec changes, setting it makes every
+	// such change roll out calico-node.
+	// If omitted, no generation label is added.
+	// +optional
+	CalicoNodeGenerationLabel string `json:"calicoNodeGenerationLabel,omitempty"`
+
+	// ServiceAccountAnnotations configures annotations that the operator adds to the service accounts of
+	// components, e.g. to bind them to a cloud IAM role with IRSA or Workload Identity.
+	// +optional
+	ServiceAccountAnnotations []ServiceAccountAnnotations `json:"serviceAccountAnnotations,omitempty"`
+}
+
+// ServiceAccountAnnotations associates annotations with the service account of a component by name.
+type ServiceAccountAnnotations struct {
+	// ComponentName identifies the component whose service account is annotated.
+	// +kubebuilder:validation:Enum=Node;Typha;KubeControllers;Guardian;ESGateway;Linseed
+	ComponentName ComponentName `json:"componentName"`
+
+	// Annotations are the annotations added to the component's service account, e.g.
+	// eks.amazonaws.com/role-arn: arn:aws:iam::111122223333:role/my-role.
+	Annotations map[string]string `json:"annotations"`
+}
+
+// PodSecurityAdmissionMode is a Pod Security Admission mode that a namespace can be labelled with.
+// One of: Enforce, Audit, Warn
+// +kubebuilder:validation:Enum=Enforce;Audit;Warn
+type PodSecurityAdmissionMode string
+
+const (
+	PodSecurityAdmissionModeEnforce PodSecurityAdmissionMode = "Enforce"
+	PodSecurityAdmissionModeAudit   PodSecurityAdmissionMode = "Audit"
+	PodSecurityAdmissionModeWarn    PodSecurityAdmissionMode = "Warn"
+)
+
+// NamespaceResourceQuota defines a ResourceQuota for a namespace managed by the operator.
+type NamespaceResourceQuota struct {
+	// Namespace is the name of the operator managed namespace to create the ResourceQuota in,
+	// for example calico-system.
+	Namespace string `json:"namespace"`
+
+	// Spec defines the hard limits to enforce in the namespace.
+	Spec v1.ResourceQuotaSpec `json:"spec"`
 }
 
 type Logging struct {
@@ -274,6 +373,9 @@ const (
 	ComponentNameConfdWindows    ComponentName = "ConfdWindows"
 	ComponentNameTypha           ComponentName = "Typha"
 	ComponentNameKubeControllers ComponentName = "KubeControllers"
+	ComponentNameGuardian        ComponentName = "Guardian"
+	ComponentNameESGateway       ComponentName = "ESGateway"
+	ComponentNameLinseed         ComponentName = "Linseed"
 )
 
 // Deprecated. Please use component resource config fields in Installation.Spec instead.
@@ -330,6 +432,177 @@ const (
 	ContainerIPForwardingDisabled ContainerIPForwardingType = "Disabled"
 )
 
+// FailsafePorts holds the inbound and outbound failsafe ports for Felix.
+type FailsafePorts struct {
+	// Inbound is the list of ports that Felix allows incoming traffic to on host endpoints.
+	// If omitted, the inbound failsafe ports in FelixConfiguration are left unchanged.
+	// +optional
+	Inbound *[]ProtoPort `json:"inbound,omitempty"`
+
+	// Outbound is the list of ports that Felix allows outgoing traffic from host endpoints to.
+	// If omitted, the outbound failsafe ports in FelixConfiguration are left unchanged.
+	// +optional
+	Outbound *[]ProtoPort `json:"outbound,omitempty"`
+}
+
+// ProtoPort is a combination of protocol, port and optional CIDR.
+type ProtoPort struct {
+	// Protocol is the protocol of the port.
+	// +kubebuilder:validation:Enum=TCP;UDP
+	Protocol string `json:"protocol"`
+
+	// Port is the port number.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// Net optionally restricts the port to traffic to or from the given CIDR.
+	// +optional
+	Net string `json:"net,omitempty"`
+}
+
+// BGPReadinessGateType specifies whether calico-node pod readiness waits for BGP convergence.
+//
+// One of: Enabled, Disabled
+type BGPReadinessGateType string
+
+const (
+	BGPReadinessGateEnabled  BGPReadinessGateType = "Enabled"
+	BGPReadinessGateDisabled BGPReadinessGateType = "Disabled"
+)
+
+// XDPAccelerationType specifies whether and how Felix uses XDP to accelerate policy.
+//
+// One of: Disabled, Enabled, Generic
+type XDPAccelerationType string
+
+const (
+	XDPAccelerationDisabled XDPAccelerationType = "Disabled"
+	XDPAccelerationEnabled  XDPAccelerationType = "Enabled"
+	XDPAccelerationGeneric  XDPAccelerationType = "Generic"
+)
+
+// EndpointToHostActionType specifies what Felix does with traffic from workload endpoints to the host they run on.
+//
+// One of: Drop, Return, Accept
+type EndpointToHostActionType string
+
+const (
+	EndpointToHostActionDrop   EndpointToHostActionType = "Drop"
+	EndpointToHostActionReturn EndpointToHostActionType = "Return"
+	EndpointToHostActionAccept EndpointToHostActionType = "Accept"
+)
+
+// AWSSrcDstCheckType specifies how Felix sets the source/destination check on the network interfaces of AWS instances.
+//
+// One of: DoNothing, Enable, Disable
+type AWSSrcDstCheckType string
+
+const (
+	AWSSrcDstCheckDoNothing AWSSrcDstCheckType = "DoNothing"
+	AWSSrcDstCheckEnable    AWSSrcDstCheckType = "Enable"
+	AWSSrcDstCheckDisable   AWSSrcDstCheckType = "Disable"
+)
+
+// FloatingIPsType specifies whether Felix programs floating IPs for workload endpoints.
+//
+// One of: Enabled, Disabled
+type FloatingIPsType string
+
+const (
+	FloatingIPsEnabled  FloatingIPsType = "Enabled"
+	FloatingIPsDisabled FloatingIPsType = "Disabled"
+)
+
+// WireguardHostEncryptionType specifies whether Felix encrypts traffic between hosts with WireGuard.
+//
+// One of: Enabled, Disabled
+type WireguardHostEncryptionType string
+
+const (
+	WireguardHostEncryptionEnabled  WireguardHostEncryptionType = "Enabled"
+	WireguardHostEncryptionDisabled WireguardHostEncryptionType = "Disabled"
+)
+
+// RemoveExternalRoutesType specifies whether Felix removes routes it does not manage from the routing tables it
+// programs.
+//
+// One of: Enabled, Disabled
+type RemoveExternalRoutesType string
+
+const (
+	RemoveExternalRoutesEnabled  RemoveExternalRoutesType = "Enabled"
+	RemoveExternalRoutesDisabled RemoveExternalRoutesType = "Disabled"
+)
+
+// BPFExternalServiceModeType specifies how the BPF dataplane forwards connections from outside the cluster to
+// services.
+//
+// One of: Tunnel, DSR
+type BPFExternalServiceModeType string
+
+const (
+	BPFExternalServiceModeTunnel BPFExternalServiceModeType = "Tunnel"
+	BPFExternalServiceModeDSR    BPFExternalServiceModeType = "DSR"
+)
+
+// BPFConnectTimeLoadBalancingType specifies which services the BPF dataplane load balances at connect time.
+//
+// One of: TCP, Enabled, Disabled
+type BPFConnectTimeLoadBalancingType string
+
+const (
+	BPFConnectTimeLoadBalancingTCP      BPFConnectTimeLoadBalancingType = "TCP"
+	BPFConnectTimeLoadBalancingEnabled  BPFConnectTimeLoadBalancingType = "Enabled"
+	BPFConnectTimeLoadBalancingDisabled BPFConnectTimeLoadBalancingType = "Disabled"
+)
+
+// BPFMapSizes are the sizes, in entries, of the BPF dataplane's maps. A size that is omitted is left unchanged in
+// FelixConfiguration, so Felix's default applies unless it is configured there directly.
+type BPFMapSizes struct {
+	// Conntrack is the size of the conntrack map, which holds an entry for each active connection. Changing it
+	// disrupts existing connections.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Conntrack *int32 `json:"conntrack,omitempty"`
+
+	// NATFrontend is the size of the NAT frontend map, which holds an entry for each port of each service, node
+	// port and external IP.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	NATFrontend *int32 `json:"natFrontend,omitempty"`
+
+	// NATBackend is the size of the NAT backend map, which holds an entry for each service endpoint.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	NATBackend *int32 `json:"natBackend,omitempty"`
+
+	// Route is the size of the routes map, which holds an entry for each workload and a few for each node.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Route *int32 `json:"route,omitempty"`
+}
+
+// PolicySyncType specifies whether Felix serves the policy sync API.
+//
+// One of: Enabled, Disabled
+type PolicySyncType string
+
+const (
+	PolicySyncEnabled  PolicySyncType = "Enabled"
+	PolicySyncDisabled PolicySyncType = "Disabled"
+)
+
+// EndpointStatusReportingType specifies whether Felix reports the status of workload endpoints to files on the host.
+//
+// One of: Enabled, Disabled
+type EndpointStatusReportingType string
+
+const (
+	EndpointStatusReportingEnabled  EndpointStatusReportingType = "Enabled"
+	EndpointStatusReportingDisabled EndpointStatusReportingType = "Disabled"
+)
+
 // HostPortsType specifies host port support.
 //
 // One of: Enabled, Disabled
@@ -439,6 +712,14 @@ type CalicoNetworkSpec struct {
 	// +kubebuilder:validation:MaxItems=25
 	IPPools []IPPool `json:"ipPools,omitempty"`
 
+	// MaxRecommendedIPPools is the number of IP pools in the cluster above which the IP pool status is reported as
+	// degraded, since large numbers of IP pools degrade Calico's performance. The limit is advisory: IP pools
+	// beyond it are still reconciled. Both the IP pools configured here and other IP pools in the cluster count.
+	// Default: 10
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRecommendedIPPools *int32 `json:"maxRecommendedIPPools,omitempty"`
+
 	// MTU specifies the maximum transmission unit to use on the pod network.
 	// If not specified, Calico will perform MTU auto-detection based on the cluster network.
 	// +optional
@@ -489,8 +770,336 @@ type CalicoNetworkSpec struct {
 	// Default: 0
 	// +optional
 	LinuxPolicySetupTimeoutSeconds *int32 `json:"linuxPolicySetupTimeoutSeconds,omitempty"`
+
+	// BGPReadinessGate configures whether calico-node pods are only marked Ready once their BGP sessions
+	// have converged. When enabled, a readiness gate is added to the calico-node pods for the
+	// projectcalico.org/BGPConverged condition, which calico-node sets on its own pod. Only valid when
+	// BGP is enabled.
+	// Default: Disabled
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	BGPReadinessGate *BGPReadinessGateType `json:"bgpReadinessGate,omitempty"`
+
+	// AdvertisedServiceClusterIPs lists the CIDRs of service cluster IPs to advertise over BGP. Each CIDR must
+	// be within one of spec.serviceCIDRs. When set, the operator writes them to the serviceClusterIPs of the
+	// default BGPConfiguration. Only valid when BGP is enabled.
+	// +optional
+	AdvertisedServiceClusterIPs []string `json:"advertisedServiceClusterIPs,omitempty"`
+
+	// XDPAcceleration configures Felix to accelerate untracked deny policy rules with XDP. Enabled uses
+	// XDP in native (driver) mode, which requires kernel and NIC driver support. Generic also allows XDP
+	// in generic mode on NICs whose drivers do not support it, at a lower performance. Only valid with the
+	// Iptables Linux dataplane.
+	// If omitted, the XDP settings in FelixConfiguration are left unchanged.
+	// +optional
+	// +kubebuilder:validation:Enum=Disabled;Enabled;Generic
+	XDPAcceleration *XDPAccelerationType `json:"xdpAcceleration,omitempty"`
+
+	// BPFExternalServiceMode controls how the BPF dataplane forwards connections from outside the cluster to
+	// services (node ports and cluster IPs) whose backing pods are on another node. Tunnel forwards the traffic, and
+	// the response, through the ingress node. DSR (direct server return) preserves the client's source IP and lets
+	// the backing node respond directly, which requires the network to allow the asymmetric traffic. Only valid with
+	// the BPF Linux dataplane.
+	// If omitted, the bpfExternalServiceMode in FelixConfiguration is left unchanged.
+	// +optional
+	// +kubebuilder:validation:Enum=Tunnel;DSR
+	BPFExternalServiceMode *BPFExternalServiceModeType `json:"bpfExternalServiceMode,omitempty"`
+
+	// BPFConnectTimeLoadBalancing controls whether the BPF dataplane load balances connections to services when
+	// they are made, rather than per packet. Connect-time load balancing lets the host reach services and avoids
+	// translating every packet, but the backing pods see the connection come from the client's own IP. TCP limits
+	// it to services with TCP ports, Enabled also applies it to UDP, and Disabled turns it off. Only valid with the
+	// BPF Linux dataplane.
+	// If omitted, the bpfConnectTimeLoadBalancing in FelixConfiguration is left unchanged.
+	// +optional
+	// +kubebuilder:validation:Enum=TCP;Enabled;Disabled
+	BPFConnectTimeLoadBalancing *BPFConnectTimeLoadBalancingType `json:"bpfConnectTimeLoadBalancing,omitempty"`
+
+	// DefaultEndpointToHostAction controls what Felix does with traffic from workload endpoints to the host they
+	// run on, once it has passed the endpoint's egress policy. Drop blocks it, Return hands it to the rest of the
+	// host's INPUT chain, and Accept allows it. When set, the operator writes it to the defaultEndpointToHostAction
+	// of the default FelixConfiguration and calico-node on Linux no longer overrides it.
+	// If omitted, calico-node continues to accept this traffic.
+	// +optional
+	// +kubebuilder:validation:Enum=Drop;Return;Accept
+	DefaultEndpointToHostAction *EndpointToHostActionType `json:"defaultEndpointToHostAction,omitempty"`
+
+	// AWSSrcDstCheck controls whether Felix changes the source/destination check on the network interfaces of the
+	// AWS instances it runs on. Disable turns the check off, which is needed when pod traffic leaves the instance
+	// unencapsulated, e.g. with cross-subnet encapsulation. Enable turns it on, and DoNothing leaves it as it is.
+	// When set, the operator writes it to the awsSrcDstCheck of the default FelixConfiguration. Only valid with
+	// the EKS provider.
+	// If omitted, the awsSrcDstCheck in FelixConfiguration is left unchanged.
+	// +optional
+	// +kubebuilder:validation:Enum=DoNothing;Enable;Disable
+	AWSSrcDstCheck *AWSSrcDstCheckType `json:"awsSrcDstCheck,omitempty"`
+
+	// FloatingIPs controls whether Felix programs the floating IPs of workload endpoints, set through the
+	// cni.projectcalico.org/floatingIPs pod annotation, so that traffic to them is forwarded to the pods. When set,
+	// the operator writes it to the floatingIPs of the default FelixConfiguration.
+	// If omitted, the floatingIPs in FelixConfiguration is left unchanged.
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	FloatingIPs *FloatingIPsType `json:"floatingIPs,omitempty"`
+
+	// WireguardHostEncryption controls whether Felix also uses WireGuard to encrypt traffic between hosts, in
+	// addition to pod traffic. When set, the operator writes it to the wireguardHostEncryptionEnabled of the default
+	// FelixConfiguration and calico-node no longer overrides it. Enabled requires WireGuard to be enabled in the
+	// default FelixConfiguration; otherwise the setting is skipped and the Installation is reported as degraded.
+	// If omitted, calico-node enables host encryption with the AKS provider and Azure VNET CNI, and with the EKS
+	// provider and Amazon VPC CNI, and otherwise leaves the FelixConfiguration setting unchanged.
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	WireguardHostEncryption *WireguardHostEncryptionType `json:"wireguardHostEncryption,omitempty"`
+
+	// NATPortRange is the range of source ports Felix uses when it SNATs outgoing traffic, either a single port or
+	// a range such as 32768:65535. Set this when the default ephemeral port range is constrained or conflicts with
+	// other services on the hosts. When set, the operator writes it to the natPortRange of the default
+	// FelixConfiguration.
+	// If omitted, the natPortRange in FelixConfiguration is left unchanged, so the kernel's default port selection
+	// applies unless it is configured there directly.
+	// +optional
+	NATPortRange *string `json:"natPortRange,omitempty"`
+
+	// RemoveExternalRoutes controls whether Felix removes routes that it did not program from the routing tables
+	// and interfaces it manages. Disable it on nodes where other software manages routes to workload interfaces.
+	// When set, the operator writes it to the removeExternalRoutes of the default FelixConfiguration.
+	// If omitted, the removeExternalRoutes in FelixConfiguration is left unchanged, so Felix removes them unless
+	// it is configured there directly.
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	RemoveExternalRoutes *RemoveExternalRoutesType `json:"removeExternalRoutes,omitempty"`
+
+	// VXLANPort is the UDP port used for VXLAN encapsulated traffic between nodes. Set this when the default port
+	// of 4789 conflicts with another VXLAN user on the network. When set, the operator writes it to the vxlanPort of
+	// the default FelixConfiguration. Only valid when at least one IP pool uses VXLAN encapsulation.
+	// If omitted, the vxlanPort in FelixConfiguration is left unchanged, so the default of 4789 applies unless it
+	// is configured there directly.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	VXLANPort *int32 `json:"vxlanPort,omitempty"`
+
+	// DNSExtraTTL is how long Felix keeps allowing traffic to IPs learned from DNS for domain-based policy, on top
+	// of the TTL advertised in each DNS response, e.g. 60s. Raise it when clients keep using resolved addresses
+	// after their TTL has expired and their connections are dropped. When set, the operator writes it to the
+	// dnsExtraTTL of the default FelixConfiguration. Only supported for Calico Enterprise.
+	// If omitted, the dnsExtraTTL in FelixConfiguration is left unchanged, so the default of 0s applies unless it
+	// is configured there directly.
+	// +optional
+	DNSExtraTTL *metav1.Duration `json:"dnsExtraTTL,omitempty"`
+
+	// ConntrackCleanupInterval is how often Felix scans the conntrack table and removes stale entries for
+	// workloads that no longer exist, e.g. 5s. Lower it on clusters with high pod churn so that connections to
+	// reused pod IPs are not matched against stale entries. When set, the operator writes it to the
+	// conntrackCleanupInterval of the default FelixConfiguration.
+	// If omitted, the conntrackCleanupInterval in FelixConfiguration is left unchanged, so the default of 10s
+	// applies unless it is configured there directly.
+	// +optional
+	ConntrackCleanupInterval *metav1.Duration `json:"conntrackCleanupInterval,omitempty"`
+
+	// BPFConntrackCleanupInterval is how often Felix scans the BPF conntrack map and removes expired entries,
+	// e.g. 5s. When set, the operator writes it to the bpfConntrackCleanupInterval of the default
+	// FelixConfiguration. Only valid with the BPF Linux dataplane.
+	// If omitted, the bpfConntrackCleanupInterval in FelixConfiguration is left unchanged, so the default of 10s
+	// applies unless it is configured there directly.
+	// +optional
+	BPFConntrackCleanupInterval *metav1.Duration `json:"bpfConntrackCleanupInterval,omitempty"`
+
+	// IptablesRefreshInterval is how often Felix re-reads the iptables state and fixes up any rules that have
+	// been changed or removed outside of Calico, e.g. 90s. Raise it on nodes with large rule sets where each
+	// refresh causes a noticeable CPU spike. When set, the operator writes it to the iptablesRefreshInterval
+	// of the default FelixConfiguration. Only valid with the Iptables Linux dataplane.
+	// If omitted, the iptablesRefreshInterval in FelixConfiguration is left unchanged, so the default of 90s
+	// applies unless it is configured there directly.
+	// +optional
+	IptablesRefreshInterval *metav1.Duration `json:"iptablesRefreshInterval,omitempty"`
+
+	// BPFMapSizes configures the sizes of the maps the BPF dataplane uses to track connections, services and
+	// routes. Raise them on large clusters whose connections, service endpoints or workloads no longer fit in the
+	// default sizes. When set, the operator writes each size to the default FelixConfiguration. Only valid with the
+	// BPF Linux dataplane.
+	// If omitted, the map sizes in FelixConfiguration are left unchanged.
+	// +optional
+	BPFMapSizes *BPFMapSizes `json:"bpfMapSizes,omitempty"`
+
+	// BGPGracefulRestartTime is how long BGP peers in the node-to-node mesh keep routes learned from a
+	// restarting calico-node before withdrawing them, e.g. 120s. When set, the operator writes it to the
+	// nodeMeshMaxRestartTime of the default BGPConfiguration. Only valid when BGP is enabled. If the node-to-node
+	// mesh is disabled in the BGPConfiguration, it is not written and the Installation is reported as degraded.
+	// If omitted, the BGPConfiguration is left unchanged, so BIRD's default of 120s applies unless it is
+	// configured there directly.
+	// +optional
+	BGPGracefulRestartTime *metav1.Duration `json:"bgpGracefulRestartTime,omitempty"`
+
+	// BGPListenPort is the port on which BIRD listens for BGP connections. Set this when port 179 is already
+	// in use on the nodes, for example by another BGP daemon. When set, the operator writes it to the
+	// listenPort of the default BGPConfiguration. Only valid when BGP is enabled.
+	// If omitted, the BGPConfiguration is left unchanged, so the default port of 179 applies unless it is
+	// configured there directly.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	BGPListenPort *int32 `json:"bgpListenPort,omitempty"`
+
+	// PolicySync configures Felix to serve the policy sync API that application layer policy integrations, such as
+	// Istio with Dikastes, use to receive policy. When Enabled, the operator sets the policySyncPathPrefix of the
+	// default FelixConfiguration to /var/run/nodeagent, the host directory calico-node shares with those integrations.
+	// When Disabled or omitted, any policySyncPathPrefix already configured on the FelixConfiguration is left unchanged.
+	// Not supported with the VPP dataplane.
+	// Default: Disabled
+	// +optional
+	// +kubebuilder:validation:Enum=Disabled;Enabled
+	PolicySync *PolicySyncType `json:"policySync,omitempty"`
+
+	// EndpointStatusReporting configures Felix to write the status of each workload endpoint on a node to a file,
+	// so that the Calico CNI plugin can wait for a pod's policy to be programmed before the pod starts. When
+	// Enabled, the operator sets the endpointStatusPathPrefix of the default FelixConfiguration to /var/run/calico,
+	// the host directory calico-node shares with the CNI plugin. When Disabled or omitted, any
+	// endpointStatusPathPrefix already configured on the FelixConfiguration is left unchanged.
+	// Default: Disabled
+	// +optional
+	// +kubebuilder:validation:Enum=Disabled;Enabled
+	EndpointStatusReporting *EndpointStatusReportingType `json:"endpointStatusReporting,omitempty"`
+
+	// BGPFilters is a list of Calico BGPFilter resources for the operator to manage. BGP peers reference these
+	// filters by name to control which routes are imported and exported. The operator creates and updates a BGPFilter
+	// for each entry and deletes the BGPFilters it created that are no longer listed. Only valid when BGP is enabled.
+	// +optional
+	BGPFilters []BGPFilter `json:"bgpFilters,omitempty"`
+
+	// RouteReflectors configures a BGP route reflector topology, in which every node peers with a set of route
+	// reflector nodes instead of with every other node. When set, the operator disables the node-to-node mesh in
+	// the default BGPConfiguration, manages a BGPPeer that peers all nodes with the route reflectors, and sets the
+	// route reflector cluster ID on the selected nodes, clearing it from all others. When removed, the operator
+	// deletes the BGPPeer, clears the cluster IDs and enables the node-to-node mesh again. Only valid when BGP is
+	// enabled.
+	// +optional
+	RouteReflectors *RouteReflectors `json:"routeReflectors,omitempty"`
+}
+
+// RouteReflectors selects the nodes that act as BGP route reflectors.
+type RouteReflectors struct {
+	// NodeSelector is the set of node labels that selects the route reflector nodes. A node must have all of the
+	// labels to be selected.
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// ClusterID is the route reflector cluster ID set on the selected nodes, in the form of an IPv4 address,
+	// e.g. 244.0.0.1.
+	ClusterID string `json:"clusterID"`
+}
+
+// NodeLocalDNSCache describes the NodeLocal DNSCache deployed in the cluster.
+type NodeLocalDNSCache struct {
+	// LocalIP is the link-local IP address the node-local DNS cache listens on, for example 169.254.20.10.
+	// When set, the allow-tigera.node-local-dns policy also allows DNS traffic to it. This is required when
+	// kube-proxy runs in IPVS mode, where the cache does not listen on the kube-dns service IPs.
+	// +optional
+	LocalIP string `json:"localIP,omitempty"`
+}
+
+// KubeControllersConfig configures the controllers run by calico-kube-controllers.
+type KubeControllersConfig struct {
+	// Node configures the node controller, which cleans up the Calico resources and IP addresses of deleted nodes.
+	// +optional
+	Node *KubeControllersNodeConfig `json:"node,omitempty"`
+}
+
+// KubeControllersNodeConfig configures the calico-kube-controllers node controller.
+type KubeControllersNodeConfig struct {
+	// ReconcilerPeriod is how often the node controller performs a full reconciliation with the Calico
+	// datastore, e.g. 5m. If omitted, calico-kube-controllers defaults to 5m.
+	// +optional
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty"`
+
+	// LeakGracePeriod is how long an IP address must be unused before the node controller garbage collects it,
+	// e.g. 15m. Set to 0s to disable IP address garbage collection. If omitted, calico-kube-controllers defaults to 15m.
+	// +optional
+	LeakGracePeriod *metav1.Duration `json:"leakGracePeriod,omitempty"`
+
+	// AutoHostEndpoints controls whether the node controller creates a host endpoint for each node.
+	// If omitted, calico-kube-controllers defaults to Disabled.
+	// +optional
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	AutoHostEndpoints *AutoHostEndpointsType `json:"autoHostEndpoints,omitempty"`
+
+	// AutoHostEndpointInterfacePattern is a regular expression that selects, by name, the node interfaces that
+	// automatic host endpoints are created for, e.g. "^eth[0-9]+$". When set, each node gets a host endpoint for the
+	// matching interfaces instead of the default host endpoint that covers all of its interfaces.
+	// Requires autoHostEndpoints to be Enabled.
+	// +optional
+	AutoHostEndpointInterfacePattern string `json:"autoHostEndpointInterfacePattern,omitempty"`
+}
+
+// AutoHostEndpointsType specifies whether host endpoints are created automatically for each node.
+// One of: Enabled, Disabled
+type AutoHostEndpointsType string
+
+const (
+	AutoHostEndpointsEnabled  AutoHostEndpointsType = "Enabled"
+	AutoHostEndpointsDisabled AutoHostEndpointsType = "Disabled"
+)
+
+// BGPFilter describes a Calico BGPFilter resource managed by the operator.
+type BGPFilter struct {
+	// Name is the name of the BGPFilter resource.
+	Name string `json:"name"`
+
+	// ExportV4 is the ordered list of rules applied to IPv4 routes exported to a peer.
+	// +optional
+	ExportV4 []BGPFilterRule `json:"exportV4,omitempty"`
+
+	// ImportV4 is the ordered list of rules applied to IPv4 routes imported from a peer.
+	// +optional
+	ImportV4 []BGPFilterRule `json:"importV4,omitempty"`
+
+	// ExportV6 is the ordered list of rules applied to IPv6 routes exported to a peer.
+	// +optional
+	ExportV6 []BGPFilterRule `json:"exportV6,omitempty"`
+
+	// ImportV6 is the ordered list of rules applied to IPv6 routes imported from a peer.
+	// +optional
+	ImportV6 []BGPFilterRule `json:"importV6,omitempty"`
 }
 
+// BGPFilterRule matches routes by prefix and either accepts or rejects them.
+type BGPFilterRule struct {
+	// CIDR is the prefix that routes are compared against. It must match the IP family of the list the rule is in,
+	// and must be set together with MatchOperator. If omitted, the rule matches all routes.
+	// +optional
+	CIDR string `json:"cidr,omitempty"`
+
+	// MatchOperator is how routes are compared against CIDR. Equal and NotEqual compare the route prefix with CIDR,
+	// while In and NotIn check whether the route falls within CIDR.
+	// +optional
+	// +kubebuilder:validation:Enum=Equal;NotEqual;In;NotIn
+	MatchOperator BGPFilterMatchOperator `json:"matchOperator,omitempty"`
+
+	// Action is applied to routes that match the rule.
+	// +kubebuilder:validation:Enum=Accept;Reject
+	Action BGPFilterAction `json:"action"`
+}
+
+// BGPFilterMatchOperator specifies how a BGPFilterRule compares routes against its CIDR.
+// One of: Equal, NotEqual, In, NotIn
+type BGPFilterMatchOperator string
+
+const (
+	BGPFilterMatchOperatorEqual    BGPFilterMatchOperator = "Equal"
+	BGPFilterMatchOperatorNotEqual BGPFilterMatchOperator = "NotEqual"
+	BGPFilterMatchOperatorIn       BGPFilterMatchOperator = "In"
+	BGPFilterMatchOperatorNotIn    BGPFilterMatchOperator = "NotIn"
+)
+
+// BGPFilterAction is the action a BGPFilterRule applies to matching routes.
+// One of: Accept, Reject
+type BGPFilterAction string
+
+const (
+	BGPFilterActionAccept BGPFilterAction = "Accept"
+	BGPFilterActionReject BGPFilterAction = "Reject"
+)
+
 // NodeAddressAutodetection provides configuration options for auto-detecting node addresses. At most one option
 // can be used. If no detection option is specified, then IP auto detection will be disabled for this address family and IPs
 // must be specified directly on the Node resource.
@@ -505,12 +1114,15 @@ type NodeAddressAutodetection struct {
 	// +kubebuilder:validation:Enum=NodeInternalIP
 	Kubernetes *KubernetesAutodetectionMethod `json:"kubernetes,omitempty"`
 
-	// Interface enables IP auto-detection based on interfaces that match the given regex.
+	// Interface enables IP auto-detection based on interfaces that match the given regex. Multiple regexes
+	// may be given as a comma-separated list, e.g. "^bond[0-9]+$,^eth0\.[0-9]+$", in which case an interface
+	// matching any of them is used.
 	// +optional
 	Interface string `json:"interface,omitempty"`
 
 	// SkipInterface enables IP auto-detection based on interfaces that do not match
-	// the given regex.
+	// the given regex. Multiple regexes may be given as a comma-separated list, in which case
+	// interfaces matching any of them are skipped.
 	// +optional
 	SkipInterface string `json:"skipInterface,omitempty"`
 
@@ -815,6 +1427,13 @@ type IPAMSpec struct {
 	// Default: Calico
 	// +kubebuilder:validation:Enum=Calico;HostLocal;AmazonVPC;AzureVNET
 	Type IPAMPluginType `json:"type"`
+
+	// StrictAffinity, when true, stops Calico IPAM from borrowing IP addresses from blocks affine to other
+	// nodes. When set, the operator writes it to the default IPAMConfig. Only valid with Calico IPAM.
+	// If omitted, the IPAMConfig is left unchanged, so addresses may be borrowed unless it is configured
+	// there directly.
+	// +optional
+	StrictAffinity *bool `json:"strictAffinity,omitempty"`
 }
 
 // CNISpec contains configuration for the CNI plugin.
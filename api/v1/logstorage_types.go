@@ -18,6 +18,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -67,6 +68,146 @@ type LogStorageSpec struct {
 
 	// ElasticsearchMetricsDeployment configures the tigera-elasticsearch-metric Deployment.
 	ElasticsearchMetricsDeployment *ElasticsearchMetricsDeployment `json:"elasticsearchMetricsDeployment,omitempty"`
+
+	// ESGateway configures the ES Gateway.
+	// +optional
+	ESGateway *ESGateway `json:"esGateway,omitempty"`
+
+	// SnapshotRepository configures an Elasticsearch snapshot repository that can be used to back up the log data.
+	// It is ignored when an external Elasticsearch cluster is used.
+	// +optional
+	SnapshotRepository *SnapshotRepository `json:"snapshotRepository,omitempty"`
+}
+
+// ESGateway defines configuration for the ES Gateway.
+type ESGateway struct {
+	// IdleConnectionTimeout is how long ES Gateway keeps an idle connection to Elasticsearch open before closing it.
+	// Lower this when a stateful firewall between ES Gateway and Elasticsearch resets idle connections.
+	// If omitted, ES Gateway uses its default timeout.
+	// +optional
+	IdleConnectionTimeout *metav1.Duration `json:"idleConnectionTimeout,omitempty"`
+
+	// RateLimit limits the rate of requests ES Gateway forwards to Elasticsearch, protecting it from query storms.
+	// If omitted, requests are not rate limited.
+	// +optional
+	RateLimit *ESGatewayRateLimit `json:"rateLimit,omitempty"`
+
+	// ConfigMapName is the name of a ConfigMap in the tigera-operator namespace that holds a custom ES Gateway
+	// configuration file under the key "config.yaml". The operator copies it into the ES Gateway namespace and
+	// mounts it at /etc/es-gateway/config.yaml. Use this only for tuning that is not exposed by other fields.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// ElasticHTTPVersion is the HTTP version ES Gateway uses for its connections to Elasticsearch. HTTP2
+	// multiplexes requests over fewer connections.
+	// Default: HTTP1
+	// +kubebuilder:validation:Enum=HTTP1;HTTP2
+	// +optional
+	ElasticHTTPVersion *ESGatewayHTTPVersion `json:"elasticHTTPVersion,omitempty"`
+
+	// AccessLogSidecar is a user-provided container that ships ES Gateway's access logs to a log pipeline. When set,
+	// ES Gateway writes structured (JSON) access logs to an emptyDir volume that is mounted at /var/log/es-gateway in
+	// both ES Gateway and the sidecar. The sidecar's name must be unique within the ES Gateway pod, and it must not
+	// specify volume mounts of its own.
+	// If omitted, ES Gateway does not write access logs to a file.
+	// +optional
+	AccessLogSidecar *corev1.Container `json:"accessLogSidecar,omitempty"`
+
+	// RevisionHistoryLimit is the number of old ReplicaSets to retain to allow rollback of the ES Gateway Deployment.
+	// If omitted, the Kubernetes default of 10 is used.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// MetricsPort is the port on which ES Gateway serves Prometheus metrics. When set, the ES Gateway
+	// allow-tigera network policy also allows Prometheus to scrape this port. It must differ from the port ES Gateway
+	// serves Elasticsearch and Kibana traffic on (5554).
+	// If omitted, ES Gateway does not serve metrics.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	MetricsPort *int32 `json:"metricsPort,omitempty"`
+
+	// MaxRequestBodySize is the largest request body ES Gateway accepts, e.g. 100Mi. Larger requests, such as
+	// oversized bulk requests, are rejected instead of being buffered in memory.
+	// If omitted, ES Gateway does not limit the size of request bodies.
+	// +optional
+	MaxRequestBodySize *resource.Quantity `json:"maxRequestBodySize,omitempty"`
+
+	// ServiceAccountAnnotations are annotations added to the ES Gateway service account, e.g. to bind it to a
+	// cloud IAM role for IAM-authenticated Elasticsearch. They take precedence over annotations for the ESGateway
+	// component in the Installation's serviceAccountAnnotations.
+	// +optional
+	ServiceAccountAnnotations map[string]string `json:"serviceAccountAnnotations,omitempty"`
+}
+
+// ESGatewayHTTPVersion is the HTTP version of ES Gateway's connections to Elasticsearch.
+type ESGatewayHTTPVersion string
+
+const (
+	ESGatewayHTTP1 ESGatewayHTTPVersion = "HTTP1"
+	ESGatewayHTTP2 ESGatewayHTTPVersion = "HTTP2"
+)
+
+// ESGatewayRateLimit defines a token bucket rate limit for requests through ES Gateway.
+type ESGatewayRateLimit struct {
+	// RequestsPerSecond is the sustained number of requests per second ES Gateway forwards to Elasticsearch.
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerSecond int32 `json:"requestsPerSecond"`
+
+	// Burst is the maximum number of requests allowed above the sustained rate at any one time.
+	// If omitted, ES Gateway uses RequestsPerSecond as the burst.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+}
+
+// SnapshotRepositoryType is the storage backend of an Elasticsearch snapshot repository.
+// +kubebuilder:validation:Enum=S3;GCS
+type SnapshotRepositoryType string
+
+const (
+	SnapshotRepositoryTypeS3  SnapshotRepositoryType = "S3"
+	SnapshotRepositoryTypeGCS SnapshotRepositoryType = "GCS"
+)
+
+// SnapshotRepository defines an Elasticsearch snapshot repository backed by an object store bucket.
+type SnapshotRepository struct {
+	// Name is the name of the snapshot repository in Elasticsearch.
+	// Default: tigera-backup
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Type is the object store backing the repository.
+	Type SnapshotRepositoryType `json:"type"`
+
+	// Bucket is the name of the bucket snapshots are written to. The bucket must already exist.
+	Bucket string `json:"bucket"`
+
+	// BasePath is the path within the bucket under which snapshots are stored.
+	// +optional
+	BasePath string `json:"basePath,omitempty"`
+
+	// CredentialsSecretName is the name of a secret in the tigera-operator namespace holding the object store
+	// credentials. Every key in the secret is added to the Elasticsearch keystore, so the keys must be the
+	// client settings expected by the repository type, e.g. s3.client.default.access_key and
+	// s3.client.default.secret_key for S3, or gcs.client.default.credentials_file for GCS.
+	// If omitted, Elasticsearch uses the credentials available to its pods.
+	// +optional
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+
+	// Endpoints are the domain names at which Elasticsearch reaches the object store, e.g.
+	// s3.eu-west-1.amazonaws.com. Wildcards such as *.example.com are supported. Elasticsearch is only allowed to
+	// connect to the object store on port 443 at these domains and at CIDRs.
+	// If neither Endpoints nor CIDRs are set, they default to *.amazonaws.com for S3 and storage.googleapis.com
+	// for GCS.
+	// +optional
+	Endpoints []string `json:"endpoints,omitempty"`
+
+	// CIDRs are the address ranges at which Elasticsearch reaches the object store, e.g. for an S3 compatible
+	// store inside the data center.
+	// +optional
+	CIDRs []string `json:"cidrs,omitempty"`
 }
 
 // LogStorageStatus defines the observed state of Tigera flow and DNS log storage.
@@ -100,6 +241,12 @@ type Nodes struct {
 	// ResourceRequirements defines the resource limits and requirements for the Elasticsearch cluster.
 	// +optional
 	ResourceRequirements *corev1.ResourceRequirements `json:"resourceRequirements,omitempty"`
+
+	// HeapSize is the JVM heap size of the Elasticsearch nodes, e.g. 2Gi. It must not be more than half of the
+	// memory limit of the Elasticsearch container. If omitted, the heap size is half of the memory request of
+	// the Elasticsearch container, up to 26Gi.
+	// +optional
+	HeapSize *resource.Quantity `json:"heapSize,omitempty"`
 }
 
 // NodeSets defines configuration specific to each Elasticsearch Node Set
@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -33,8 +35,325 @@ type ManagementClusterConnectionSpec struct {
 
 	// GuardianDeployment configures the guardian Deployment.
 	GuardianDeployment *GuardianDeployment `json:"guardianDeployment,omitempty"`
+
+	// IPFamilyPreference selects the IP family guardian prefers when connecting to the management cluster on
+	// dual-stack clusters. When the management cluster address is an IPv4-mapped IPv6 address, this also selects
+	// whether guardian's egress policy allows the address as an IPv4 or an IPv6 network.
+	// If omitted, guardian uses the address family as resolved.
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	// +optional
+	IPFamilyPreference *IPFamily `json:"ipFamilyPreference,omitempty"`
+
+	// MaxRequestHeaderBytes is the maximum size, in bytes, of the request headers guardian accepts, including
+	// the request line. Raise this when large authentication headers cause requests to be rejected.
+	// If omitted, guardian uses its default limit.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxRequestHeaderBytes *int32 `json:"maxRequestHeaderBytes,omitempty"`
+
+	// TunnelMaxFrameBytes is the maximum size, in bytes, of a single frame guardian sends or receives over the
+	// tunnel. Raise this when large responses, such as big flow log queries, fail to cross the tunnel.
+	// If omitted, guardian uses its default limit.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TunnelMaxFrameBytes *int32 `json:"tunnelMaxFrameBytes,omitempty"`
+
+	// TunnelCompression controls whether guardian compresses traffic sent over the tunnel to the management
+	// cluster. Enabling it can improve responsiveness over high-latency or low-bandwidth links at the cost of CPU.
+	// Default: Disabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	TunnelCompression *TunnelCompressionType `json:"tunnelCompression,omitempty"`
+
+	// BackendRetryPolicy configures how guardian retries requests to backend services, such as prometheus
+	// and queryserver, that fail transiently. If omitted, guardian does not retry failed backend requests.
+	// +optional
+	BackendRetryPolicy *GuardianRetryPolicy `json:"backendRetryPolicy,omitempty"`
+
+	// DNSCacheTTL is how long guardian caches the results of DNS lookups, such as the resolution of the management
+	// cluster address, e.g. 30s. Lower this when the addresses behind those names change often. A value of 0
+	// disables caching. If omitted, guardian uses its default TTL.
+	// +optional
+	DNSCacheTTL *metav1.Duration `json:"dnsCacheTTL,omitempty"`
+
+	// GuardianService configures the guardian Service.
+	// +optional
+	GuardianService *GuardianService `json:"guardianService,omitempty"`
+
+	// InsecureSkipTLSVerify disables verification of the management cluster's certificate by guardian. It is only
+	// meant to bootstrap a connection to a management cluster that still uses a self-signed certificate, and leaves
+	// the tunnel open to man-in-the-middle attacks. While it is enabled the ManagementClusterConnection is reported
+	// as degraded so that it is not left on by accident.
+	// Default: false
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+
+	// AccessLogging configures guardian to log every request it proxies, e.g. for security auditing.
+	// +optional
+	AccessLogging *GuardianAccessLogging `json:"accessLogging,omitempty"`
+
+	// TunnelLatencyBuckets are the upper bounds, in seconds, of the buckets of the Prometheus histogram guardian
+	// exports for the latency of requests over the tunnel, e.g. ["0.05", "0.1", "0.5", "1"]. The values must be
+	// numbers in strictly ascending order. If omitted, guardian uses its default buckets.
+	// +optional
+	TunnelLatencyBuckets []string `json:"tunnelLatencyBuckets,omitempty"`
+
+	// TunnelHeaders are extra HTTP headers guardian adds to the requests it sends to the management cluster, e.g.
+	// for an authenticating proxy in front of the management cluster. Headers that guardian manages itself, such as
+	// Host, Authorization and the Impersonate-* headers, cannot be set.
+	// +optional
+	TunnelHeaders map[string]string `json:"tunnelHeaders,omitempty"`
+
+	// TunnelPort is the port guardian listens on for the tunnel. Change it when port 9443 conflicts with a sidecar
+	// or another service in the guardian pod. It must not be guardian's proxy port (8080) or health port (9080).
+	// Default: 9443
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	TunnelPort *int32 `json:"tunnelPort,omitempty"`
+
+	// ServiceAccountToken configures a projected service account token with a custom audience that is mounted
+	// into the guardian pod, e.g. for federating guardian's identity with a cloud provider's IAM.
+	// If omitted, no projected token is mounted.
+	// +optional
+	ServiceAccountToken *GuardianServiceAccountToken `json:"serviceAccountToken,omitempty"`
+
+	// Workers is the number of worker goroutines guardian uses to handle requests over the tunnel. Raise this to
+	// increase throughput when many requests are proxied at once, e.g. on large management clusters.
+	// If omitted, guardian uses its default number of workers.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Workers *int32 `json:"workers,omitempty"`
+
+	// TunnelBandwidthLimit is the maximum rate, in bytes per second, at which guardian sends data over the tunnel,
+	// e.g. 10Mi. Use this to keep guardian from starving other traffic on a shared link.
+	// If omitted, the tunnel bandwidth is not limited.
+	// +optional
+	TunnelBandwidthLimit *resource.Quantity `json:"tunnelBandwidthLimit,omitempty"`
+
+	// ClusterLabels are labels guardian advertises for this managed cluster over the tunnel, e.g. region or
+	// environment, so that dashboards in the management cluster can group managed clusters by them. Keys and values
+	// must be valid Kubernetes label keys and values.
+	// +optional
+	ClusterLabels map[string]string `json:"clusterLabels,omitempty"`
+
+	// BackendCABundles configures the CA bundles guardian uses to verify the backend services it proxies requests
+	// to, for backends whose certificates are not issued by a CA in the shared trusted bundle.
+	// If omitted, guardian verifies all backends using the shared trusted bundle.
+	// +optional
+	BackendCABundles *GuardianBackendCABundles `json:"backendCABundles,omitempty"`
+
+	// RequestCoalescing controls whether guardian coalesces identical concurrent requests to a backend service into
+	// a single backend request, sharing its response. Enabling it reduces the load on backends when many users
+	// refresh the same views at once.
+	// Default: Disabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	RequestCoalescing *RequestCoalescingType `json:"requestCoalescing,omitempty"`
+
+	// ShutdownDrainDelay is how long guardian keeps running when its pod is terminated, e.g. 10s. Guardian waits
+	// for the delay before it stops accepting connections, giving clients time to stop sending it new ones, and
+	// then finishes in-flight requests for up to the same delay before exiting. The pod's termination grace period
+	// is extended to fit both. If omitted, guardian exits as soon as it is stopped.
+	// +optional
+	ShutdownDrainDelay *metav1.Duration `json:"shutdownDrainDelay,omitempty"`
+
+	// EgressGateway routes guardian's outbound traffic, including the tunnel to the management cluster, through a
+	// Calico egress gateway, e.g. so that it leaves the cluster from a known IP address.
+	// If omitted, guardian's traffic does not use an egress gateway.
+	// +optional
+	EgressGateway *GuardianEgressGateway `json:"egressGateway,omitempty"`
+
+	// HeartbeatInterval is how often guardian sends a heartbeat to the management cluster over the tunnel, e.g. 30s.
+	// The management cluster records the heartbeats as metrics, so that dashboards can show when each managed
+	// cluster was last seen. If omitted, guardian does not send heartbeats.
+	// +optional
+	HeartbeatInterval *metav1.Duration `json:"heartbeatInterval,omitempty"`
+
+	// CertificateExpiryThreshold is how long before the management cluster's certificate in the tunnel secret
+	// expires that the ManagementClusterConnection is reported as degraded, e.g. 168h. This gives time to rotate
+	// the certificate before the tunnel breaks.
+	// Default: 720h
+	// +optional
+	CertificateExpiryThreshold *metav1.Duration `json:"certificateExpiryThreshold,omitempty"`
+
+	// DebugConfigEndpoint controls whether guardian serves its effective configuration, with secrets redacted, at
+	// /debug/config on port 9081 for troubleshooting. Guardian's network policy only allows pods in the guardian
+	// namespace to reach the endpoint, so it is usually accessed with kubectl port-forward.
+	// Default: Disabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	DebugConfigEndpoint *DebugConfigEndpointType `json:"debugConfigEndpoint,omitempty"`
+
+	// TierReadinessGate controls whether guardian pods have a readiness gate that the operator only satisfies once
+	// the allow-tigera tier exists, so that controllers that wait on guardian being ready are sequenced after the
+	// tier. Only supported with the TigeraSecureEnterprise variant. The operator sets the gate's condition on the
+	// guardian pods itself, so its ClusterRole must allow the patch verb on pods/status.
+	// Default: Disabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	TierReadinessGate *TierReadinessGateType `json:"tierReadinessGate,omitempty"`
+}
+
+// GuardianEgressGateway selects the egress gateway that guardian's outbound traffic is routed through.
+type GuardianEgressGateway struct {
+	// Selector is a Calico selector that matches the labels of the egress gateway pods to use,
+	// e.g. "egress-code == 'red'".
+	// +kubebuilder:validation:MinLength=1
+	Selector string `json:"selector"`
+
+	// NamespaceSelector is a Calico selector that matches the labels of the namespaces the egress gateway pods
+	// run in. If omitted, egress gateways are selected from the guardian namespace only.
+	// +optional
+	NamespaceSelector string `json:"namespaceSelector,omitempty"`
+}
+
+// GuardianBackendCABundles references the secrets that hold CA bundles for guardian's backend services. Each secret
+// must be in the tigera-operator namespace and hold the PEM encoded bundle under the key ca.crt. A backend without
+// a secret is verified using the shared trusted bundle.
+type GuardianBackendCABundles struct {
+	// PacketCapture is the name of the secret holding the CA bundle for the packet capture API.
+	// +optional
+	PacketCapture string `json:"packetCapture,omitempty"`
+
+	// Prometheus is the name of the secret holding the CA bundle for Prometheus.
+	// +optional
+	Prometheus string `json:"prometheus,omitempty"`
+
+	// QueryServer is the name of the secret holding the CA bundle for the query server.
+	// +optional
+	QueryServer string `json:"queryServer,omitempty"`
+}
+
+// GuardianServiceAccountToken configures a projected service account token for guardian.
+type GuardianServiceAccountToken struct {
+	// Audience is the intended audience of the token. The recipient of the token must identify itself with
+	// this audience, or it will reject the token.
+	// +kubebuilder:validation:MinLength=1
+	Audience string `json:"audience"`
+
+	// ExpirationSeconds is the requested lifetime of the token. The kubelet refreshes the token before it
+	// expires. It must be at least 600 seconds.
+	// Default: 3600
+	// +kubebuilder:validation:Minimum=600
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
+
+// GuardianAccessLogging configures guardian's access log.
+type GuardianAccessLogging struct {
+	// State controls whether guardian logs every request it proxies.
+	// Default: Disabled
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	// +optional
+	State *AccessLoggingState `json:"state,omitempty"`
+
+	// Format is the format of the access log entries.
+	// Default: Text
+	// +kubebuilder:validation:Enum=Text;JSON
+	// +optional
+	Format *AccessLogFormat `json:"format,omitempty"`
 }
 
+// AccessLoggingState specifies whether access logging is enabled.
+//
+// One of: Enabled, Disabled
+type AccessLoggingState string
+
+const (
+	AccessLoggingEnabled  AccessLoggingState = "Enabled"
+	AccessLoggingDisabled AccessLoggingState = "Disabled"
+)
+
+// AccessLogFormat is the format of access log entries.
+//
+// One of: Text, JSON
+type AccessLogFormat string
+
+const (
+	AccessLogFormatText AccessLogFormat = "Text"
+	AccessLogFormatJSON AccessLogFormat = "JSON"
+)
+
+// GuardianService configures how the guardian Service is published.
+type GuardianService struct {
+	// Type is the type of the guardian Service. Use NodePort where the management cluster reaches
+	// guardian directly through a node port rather than through the tunnel.
+	// Default: ClusterIP
+	// +kubebuilder:validation:Enum=ClusterIP;NodePort
+	// +optional
+	Type *corev1.ServiceType `json:"type,omitempty"`
+
+	// NodePort is the node port used for guardian's linseed port (443) when Type is NodePort. The remaining
+	// ports are allocated by Kubernetes. If omitted, Kubernetes allocates this port too.
+	// +kubebuilder:validation:Minimum=30000
+	// +kubebuilder:validation:Maximum=32767
+	// +optional
+	NodePort *int32 `json:"nodePort,omitempty"`
+}
+
+// GuardianRetryPolicy configures retries of failed backend requests.
+type GuardianRetryPolicy struct {
+	// MaxRetries is the maximum number of times guardian retries a failed backend request.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// Backoff is the time guardian waits before retrying a failed backend request, e.g. 500ms.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+}
+
+// TunnelCompressionType specifies whether tunnel traffic is compressed.
+//
+// One of: Enabled, Disabled
+type TunnelCompressionType string
+
+const (
+	TunnelCompressionEnabled  TunnelCompressionType = "Enabled"
+	TunnelCompressionDisabled TunnelCompressionType = "Disabled"
+)
+
+// RequestCoalescingType specifies whether guardian coalesces identical concurrent backend requests.
+//
+// One of: Enabled, Disabled
+type RequestCoalescingType string
+
+const (
+	RequestCoalescingEnabled  RequestCoalescingType = "Enabled"
+	RequestCoalescingDisabled RequestCoalescingType = "Disabled"
+)
+
+// DebugConfigEndpointType specifies whether guardian serves its effective configuration for debugging.
+//
+// One of: Enabled, Disabled
+type DebugConfigEndpointType string
+
+const (
+	DebugConfigEndpointEnabled  DebugConfigEndpointType = "Enabled"
+	DebugConfigEndpointDisabled DebugConfigEndpointType = "Disabled"
+)
+
+// TierReadinessGateType specifies whether guardian's readiness is gated on the allow-tigera tier.
+//
+// One of: Enabled, Disabled
+type TierReadinessGateType string
+
+const (
+	TierReadinessGateEnabled  TierReadinessGateType = "Enabled"
+	TierReadinessGateDisabled TierReadinessGateType = "Disabled"
+)
+
+// IPFamily is an IP address family.
+//
+// One of: IPv4, IPv6
+type IPFamily string
+
+const (
+	IPFamilyIPv4 IPFamily = "IPv4"
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
 type ManagementClusterTLS struct {
 	// CA indicates which verification method the tunnel client should use to verify the tunnel server's identity.
 	//
@@ -88,8 +407,31 @@ type ManagementClusterConnectionStatus struct {
 	// Ready, Progressing, Degraded or other customer types.
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// TunnelState is the observed state of the tunnel to the management cluster, derived from the readiness of the
+	// guardian pods.
+	// +optional
+	TunnelState TunnelState `json:"tunnelState,omitempty"`
+
+	// LastConnectedTime is when the tunnel to the management cluster was last observed to become connected.
+	// +optional
+	LastConnectedTime *metav1.Time `json:"lastConnectedTime,omitempty"`
+
+	// CertificateNotAfter is when the management cluster's certificate in the tunnel secret expires.
+	// +optional
+	CertificateNotAfter *metav1.Time `json:"certificateNotAfter,omitempty"`
 }
 
+// TunnelState is the observed state of the tunnel to the management cluster.
+//
+// One of: Connected, Disconnected
+type TunnelState string
+
+const (
+	TunnelStateConnected    TunnelState = "Connected"
+	TunnelStateDisconnected TunnelState = "Disconnected"
+)
+
 func init() {
 	SchemeBuilder.Register(&ManagementClusterConnection{}, &ManagementClusterConnectionList{})
 }
@@ -266,6 +266,31 @@ func (in *APIServerSpec) DeepCopyInto(out *APIServerSpec) {
 		*out = new(APIServerDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(APIServerTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserWorkloadTier != nil {
+		in, out := &in.UserWorkloadTier, &out.UserWorkloadTier
+		*out = new(UserWorkloadTier)
+		**out = **in
+	}
+	if in.RequestTimeout != nil {
+		in, out := &in.RequestTimeout, &out.RequestTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRequestsInflight != nil {
+		in, out := &in.MaxRequestsInflight, &out.MaxRequestsInflight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxMutatingRequestsInflight != nil {
+		in, out := &in.MaxMutatingRequestsInflight, &out.MaxMutatingRequestsInflight
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerSpec.
@@ -300,6 +325,31 @@ func (in *APIServerStatus) DeepCopy() *APIServerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerTLS) DeepCopyInto(out *APIServerTLS) {
+	*out = *in
+	if in.MinVersion != nil {
+		in, out := &in.MinVersion, &out.MinVersion
+		*out = new(TLSVersion)
+		**out = **in
+	}
+	if in.CipherSuites != nil {
+		in, out := &in.CipherSuites, &out.CipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerTLS.
+func (in *APIServerTLS) DeepCopy() *APIServerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSEgressGateway) DeepCopyInto(out *AWSEgressGateway) {
 	*out = *in
@@ -574,6 +624,21 @@ func (in *Authentication) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationExternalDex) DeepCopyInto(out *AuthenticationExternalDex) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationExternalDex.
+func (in *AuthenticationExternalDex) DeepCopy() *AuthenticationExternalDex {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationExternalDex)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationLDAP) DeepCopyInto(out *AuthenticationLDAP) {
 	*out = *in
@@ -704,6 +769,16 @@ func (in *AuthenticationSpec) DeepCopyInto(out *AuthenticationSpec) {
 		*out = new(DexDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExternalDex != nil {
+		in, out := &in.ExternalDex, &out.ExternalDex
+		*out = new(AuthenticationExternalDex)
+		**out = **in
+	}
+	if in.SigningKeyRotationPeriod != nil {
+		in, out := &in.SigningKeyRotationPeriod, &out.SigningKeyRotationPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationSpec.
@@ -738,6 +813,91 @@ func (in *AuthenticationStatus) DeepCopy() *AuthenticationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilter) DeepCopyInto(out *BGPFilter) {
+	*out = *in
+	if in.ExportV4 != nil {
+		in, out := &in.ExportV4, &out.ExportV4
+		*out = make([]BGPFilterRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportV4 != nil {
+		in, out := &in.ImportV4, &out.ImportV4
+		*out = make([]BGPFilterRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExportV6 != nil {
+		in, out := &in.ExportV6, &out.ExportV6
+		*out = make([]BGPFilterRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportV6 != nil {
+		in, out := &in.ImportV6, &out.ImportV6
+		*out = make([]BGPFilterRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilter.
+func (in *BGPFilter) DeepCopy() *BGPFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterRule) DeepCopyInto(out *BGPFilterRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterRule.
+func (in *BGPFilterRule) DeepCopy() *BGPFilterRule {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BPFMapSizes) DeepCopyInto(out *BPFMapSizes) {
+	*out = *in
+	if in.Conntrack != nil {
+		in, out := &in.Conntrack, &out.Conntrack
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NATFrontend != nil {
+		in, out := &in.NATFrontend, &out.NATFrontend
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NATBackend != nil {
+		in, out := &in.NATBackend, &out.NATBackend
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BPFMapSizes.
+func (in *BPFMapSizes) DeepCopy() *BPFMapSizes {
+	if in == nil {
+		return nil
+	}
+	out := new(BPFMapSizes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CNILogging) DeepCopyInto(out *CNILogging) {
 	*out = *in
@@ -779,7 +939,7 @@ func (in *CNISpec) DeepCopyInto(out *CNISpec) {
 	if in.IPAM != nil {
 		in, out := &in.IPAM, &out.IPAM
 		*out = new(IPAMSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1090,6 +1250,11 @@ func (in *CalicoNetworkSpec) DeepCopyInto(out *CalicoNetworkSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaxRecommendedIPPools != nil {
+		in, out := &in.MaxRecommendedIPPools, &out.MaxRecommendedIPPools
+		*out = new(int32)
+		**out = **in
+	}
 	if in.MTU != nil {
 		in, out := &in.MTU, &out.MTU
 		*out = new(int32)
@@ -1130,6 +1295,123 @@ func (in *CalicoNetworkSpec) DeepCopyInto(out *CalicoNetworkSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.BGPReadinessGate != nil {
+		in, out := &in.BGPReadinessGate, &out.BGPReadinessGate
+		*out = new(BGPReadinessGateType)
+		**out = **in
+	}
+	if in.AdvertisedServiceClusterIPs != nil {
+		in, out := &in.AdvertisedServiceClusterIPs, &out.AdvertisedServiceClusterIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.XDPAcceleration != nil {
+		in, out := &in.XDPAcceleration, &out.XDPAcceleration
+		*out = new(XDPAccelerationType)
+		**out = **in
+	}
+	if in.BPFExternalServiceMode != nil {
+		in, out := &in.BPFExternalServiceMode, &out.BPFExternalServiceMode
+		*out = new(BPFExternalServiceModeType)
+		**out = **in
+	}
+	if in.BPFConnectTimeLoadBalancing != nil {
+		in, out := &in.BPFConnectTimeLoadBalancing, &out.BPFConnectTimeLoadBalancing
+		*out = new(BPFConnectTimeLoadBalancingType)
+		**out = **in
+	}
+	if in.DefaultEndpointToHostAction != nil {
+		in, out := &in.DefaultEndpointToHostAction, &out.DefaultEndpointToHostAction
+		*out = new(EndpointToHostActionType)
+		**out = **in
+	}
+	if in.AWSSrcDstCheck != nil {
+		in, out := &in.AWSSrcDstCheck, &out.AWSSrcDstCheck
+		*out = new(AWSSrcDstCheckType)
+		**out = **in
+	}
+	if in.FloatingIPs != nil {
+		in, out := &in.FloatingIPs, &out.FloatingIPs
+		*out = new(FloatingIPsType)
+		**out = **in
+	}
+	if in.WireguardHostEncryption != nil {
+		in, out := &in.WireguardHostEncryption, &out.WireguardHostEncryption
+		*out = new(WireguardHostEncryptionType)
+		**out = **in
+	}
+	if in.NATPortRange != nil {
+		in, out := &in.NATPortRange, &out.NATPortRange
+		*out = new(string)
+		**out = **in
+	}
+	if in.RemoveExternalRoutes != nil {
+		in, out := &in.RemoveExternalRoutes, &out.RemoveExternalRoutes
+		*out = new(RemoveExternalRoutesType)
+		**out = **in
+	}
+	if in.VXLANPort != nil {
+		in, out := &in.VXLANPort, &out.VXLANPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DNSExtraTTL != nil {
+		in, out := &in.DNSExtraTTL, &out.DNSExtraTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ConntrackCleanupInterval != nil {
+		in, out := &in.ConntrackCleanupInterval, &out.ConntrackCleanupInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BPFConntrackCleanupInterval != nil {
+		in, out := &in.BPFConntrackCleanupInterval, &out.BPFConntrackCleanupInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.IptablesRefreshInterval != nil {
+		in, out := &in.IptablesRefreshInterval, &out.IptablesRefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BPFMapSizes != nil {
+		in, out := &in.BPFMapSizes, &out.BPFMapSizes
+		*out = new(BPFMapSizes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BGPGracefulRestartTime != nil {
+		in, out := &in.BGPGracefulRestartTime, &out.BGPGracefulRestartTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BGPListenPort != nil {
+		in, out := &in.BGPListenPort, &out.BGPListenPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PolicySync != nil {
+		in, out := &in.PolicySync, &out.PolicySync
+		*out = new(PolicySyncType)
+		**out = **in
+	}
+	if in.EndpointStatusReporting != nil {
+		in, out := &in.EndpointStatusReporting, &out.EndpointStatusReporting
+		*out = new(EndpointStatusReportingType)
+		**out = **in
+	}
+	if in.BGPFilters != nil {
+		in, out := &in.BGPFilters, &out.BGPFilters
+		*out = make([]BGPFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RouteReflectors != nil {
+		in, out := &in.RouteReflectors, &out.RouteReflectors
+		*out = new(RouteReflectors)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoNetworkSpec.
@@ -1175,6 +1457,11 @@ func (in *CalicoNodeDaemonSetContainer) DeepCopyInto(out *CalicoNodeDaemonSetCon
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(CalicoNodeStartupProbe)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoNodeDaemonSetContainer.
@@ -1224,6 +1511,13 @@ func (in *CalicoNodeDaemonSetPodSpec) DeepCopyInto(out *CalicoNodeDaemonSetPodSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExtraContainers != nil {
+		in, out := &in.ExtraContainers, &out.ExtraContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
 		*out = new(corev1.Affinity)
@@ -1305,6 +1599,31 @@ func (in *CalicoNodeDaemonSetSpec) DeepCopy() *CalicoNodeDaemonSetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalicoNodeStartupProbe) DeepCopyInto(out *CalicoNodeStartupProbe) {
+	*out = *in
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoNodeStartupProbe.
+func (in *CalicoNodeStartupProbe) DeepCopy() *CalicoNodeStartupProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(CalicoNodeStartupProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CalicoNodeWindowsDaemonSet) DeepCopyInto(out *CalicoNodeWindowsDaemonSet) {
 	*out = *in
@@ -2592,6 +2911,11 @@ func (in *DexDeploymentPodSpec) DeepCopyInto(out *DexDeploymentPodSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexDeploymentPodSpec.
@@ -2632,6 +2956,16 @@ func (in *DexDeploymentSpec) DeepCopyInto(out *DexDeploymentSpec) {
 		*out = new(DexDeploymentPodTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(DexDeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexDeploymentSpec.
@@ -2644,6 +2978,26 @@ func (in *DexDeploymentSpec) DeepCopy() *DexDeploymentSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DexDeploymentStrategy) DeepCopyInto(out *DexDeploymentStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(appsv1.RollingUpdateDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DexDeploymentStrategy.
+func (in *DexDeploymentStrategy) DeepCopy() *DexDeploymentStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(DexDeploymentStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ECKOperatorStatefulSet) DeepCopyInto(out *ECKOperatorStatefulSet) {
 	*out = *in
@@ -2942,6 +3296,83 @@ func (in *EKSLogForwarderDeploymentSpec) DeepCopy() *EKSLogForwarderDeploymentSp
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ESGateway) DeepCopyInto(out *ESGateway) {
+	*out = *in
+	if in.IdleConnectionTimeout != nil {
+		in, out := &in.IdleConnectionTimeout, &out.IdleConnectionTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(ESGatewayRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ElasticHTTPVersion != nil {
+		in, out := &in.ElasticHTTPVersion, &out.ElasticHTTPVersion
+		*out = new(ESGatewayHTTPVersion)
+		**out = **in
+	}
+	if in.AccessLogSidecar != nil {
+		in, out := &in.AccessLogSidecar, &out.AccessLogSidecar
+		*out = new(corev1.Container)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MetricsPort != nil {
+		in, out := &in.MetricsPort, &out.MetricsPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRequestBodySize != nil {
+		in, out := &in.MaxRequestBodySize, &out.MaxRequestBodySize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ServiceAccountAnnotations != nil {
+		in, out := &in.ServiceAccountAnnotations, &out.ServiceAccountAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ESGateway.
+func (in *ESGateway) DeepCopy() *ESGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(ESGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ESGatewayRateLimit) DeepCopyInto(out *ESGatewayRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ESGatewayRateLimit.
+func (in *ESGatewayRateLimit) DeepCopy() *ESGatewayRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ESGatewayRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressGateway) DeepCopyInto(out *EgressGateway) {
 	*out = *in
@@ -3469,6 +3900,39 @@ func (in *ExternalPrometheus) DeepCopy() *ExternalPrometheus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailsafePorts) DeepCopyInto(out *FailsafePorts) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new([]ProtoPort)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]ProtoPort, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new([]ProtoPort)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]ProtoPort, len(*in))
+			copy(*out, *in)
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailsafePorts.
+func (in *FailsafePorts) DeepCopy() *FailsafePorts {
+	if in == nil {
+		return nil
+	}
+	out := new(FailsafePorts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FluentdDaemonSet) DeepCopyInto(out *FluentdDaemonSet) {
 	*out = *in
@@ -3618,6 +4082,46 @@ func (in *GroupSearch) DeepCopy() *GroupSearch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianAccessLogging) DeepCopyInto(out *GuardianAccessLogging) {
+	*out = *in
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(AccessLoggingState)
+		**out = **in
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(AccessLogFormat)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianAccessLogging.
+func (in *GuardianAccessLogging) DeepCopy() *GuardianAccessLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianAccessLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianBackendCABundles) DeepCopyInto(out *GuardianBackendCABundles) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianBackendCABundles.
+func (in *GuardianBackendCABundles) DeepCopy() *GuardianBackendCABundles {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianBackendCABundles)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuardianDeployment) DeepCopyInto(out *GuardianDeployment) {
 	*out = *in
@@ -3646,6 +4150,11 @@ func (in *GuardianDeploymentContainer) DeepCopyInto(out *GuardianDeploymentConta
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LivenessProbeType != nil {
+		in, out := &in.LivenessProbeType, &out.LivenessProbeType
+		*out = new(GuardianProbeType)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianDeploymentContainer.
@@ -3688,61 +4197,163 @@ func (in *GuardianDeploymentPodSpec) DeepCopyInto(out *GuardianDeploymentPodSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Containers != nil {
-		in, out := &in.Containers, &out.Containers
-		*out = make([]GuardianDeploymentContainer, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]GuardianDeploymentContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraInitContainers != nil {
+		in, out := &in.ExtraInitContainers, &out.ExtraInitContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianDeploymentPodSpec.
+func (in *GuardianDeploymentPodSpec) DeepCopy() *GuardianDeploymentPodSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianDeploymentPodSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianDeploymentPodTemplateSpec) DeepCopyInto(out *GuardianDeploymentPodTemplateSpec) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(Metadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(GuardianDeploymentPodSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianDeploymentPodTemplateSpec.
+func (in *GuardianDeploymentPodTemplateSpec) DeepCopy() *GuardianDeploymentPodTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianDeploymentPodTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianDeploymentSpec) DeepCopyInto(out *GuardianDeploymentSpec) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(GuardianDeploymentPodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianDeploymentSpec.
+func (in *GuardianDeploymentSpec) DeepCopy() *GuardianDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianEgressGateway) DeepCopyInto(out *GuardianEgressGateway) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianEgressGateway.
+func (in *GuardianEgressGateway) DeepCopy() *GuardianEgressGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardianEgressGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardianRetryPolicy) DeepCopyInto(out *GuardianRetryPolicy) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianDeploymentPodSpec.
-func (in *GuardianDeploymentPodSpec) DeepCopy() *GuardianDeploymentPodSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianRetryPolicy.
+func (in *GuardianRetryPolicy) DeepCopy() *GuardianRetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(GuardianDeploymentPodSpec)
+	out := new(GuardianRetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GuardianDeploymentPodTemplateSpec) DeepCopyInto(out *GuardianDeploymentPodTemplateSpec) {
+func (in *GuardianService) DeepCopyInto(out *GuardianService) {
 	*out = *in
-	if in.Spec != nil {
-		in, out := &in.Spec, &out.Spec
-		*out = new(GuardianDeploymentPodSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(corev1.ServiceType)
+		**out = **in
+	}
+	if in.NodePort != nil {
+		in, out := &in.NodePort, &out.NodePort
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianDeploymentPodTemplateSpec.
-func (in *GuardianDeploymentPodTemplateSpec) DeepCopy() *GuardianDeploymentPodTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianService.
+func (in *GuardianService) DeepCopy() *GuardianService {
 	if in == nil {
 		return nil
 	}
-	out := new(GuardianDeploymentPodTemplateSpec)
+	out := new(GuardianService)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GuardianDeploymentSpec) DeepCopyInto(out *GuardianDeploymentSpec) {
+func (in *GuardianServiceAccountToken) DeepCopyInto(out *GuardianServiceAccountToken) {
 	*out = *in
-	if in.Template != nil {
-		in, out := &in.Template, &out.Template
-		*out = new(GuardianDeploymentPodTemplateSpec)
-		(*in).DeepCopyInto(*out)
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianDeploymentSpec.
-func (in *GuardianDeploymentSpec) DeepCopy() *GuardianDeploymentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardianServiceAccountToken.
+func (in *GuardianServiceAccountToken) DeepCopy() *GuardianServiceAccountToken {
 	if in == nil {
 		return nil
 	}
-	out := new(GuardianDeploymentSpec)
+	out := new(GuardianServiceAccountToken)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3810,6 +4421,11 @@ func (in *ICMPProbe) DeepCopy() *ICMPProbe {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMSpec) DeepCopyInto(out *IPAMSpec) {
 	*out = *in
+	if in.StrictAffinity != nil {
+		in, out := &in.StrictAffinity, &out.StrictAffinity
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMSpec.
@@ -4091,7 +4707,22 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.DefaultPodSecurityContext != nil {
+		in, out := &in.DefaultPodSecurityContext, &out.DefaultPodSecurityContext
+		*out = new(corev1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailsafePorts != nil {
+		in, out := &in.FailsafePorts, &out.FailsafePorts
+		*out = new(FailsafePorts)
+		(*in).DeepCopyInto(*out)
+	}
 	in.NodeUpdateStrategy.DeepCopyInto(&out.NodeUpdateStrategy)
+	if in.MaxConcurrentNodeRestarts != nil {
+		in, out := &in.MaxConcurrentNodeRestarts, &out.MaxConcurrentNodeRestarts
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ComponentResources != nil {
 		in, out := &in.ComponentResources, &out.ComponentResources
 		*out = make([]ComponentResource, len(*in))
@@ -4124,6 +4755,16 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(CalicoKubeControllersDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KubeControllersConfig != nil {
+		in, out := &in.KubeControllersConfig, &out.KubeControllersConfig
+		*out = new(KubeControllersConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeLocalDNSCache != nil {
+		in, out := &in.NodeLocalDNSCache, &out.NodeLocalDNSCache
+		*out = new(NodeLocalDNSCache)
+		**out = **in
+	}
 	if in.TyphaDeployment != nil {
 		in, out := &in.TyphaDeployment, &out.TyphaDeployment
 		*out = new(TyphaDeployment)
@@ -4159,6 +4800,25 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NamespaceResourceQuotas != nil {
+		in, out := &in.NamespaceResourceQuotas, &out.NamespaceResourceQuotas
+		*out = make([]NamespaceResourceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodSecurityAdmissionModes != nil {
+		in, out := &in.PodSecurityAdmissionModes, &out.PodSecurityAdmissionModes
+		*out = make([]PodSecurityAdmissionMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccountAnnotations != nil {
+		in, out := &in.ServiceAccountAnnotations, &out.ServiceAccountAnnotations
+		*out = make([]ServiceAccountAnnotations, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationSpec.
@@ -4585,6 +5245,56 @@ func (in *KibanaSpec) DeepCopy() *KibanaSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeControllersConfig) DeepCopyInto(out *KubeControllersConfig) {
+	*out = *in
+	if in.Node != nil {
+		in, out := &in.Node, &out.Node
+		*out = new(KubeControllersNodeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeControllersConfig.
+func (in *KubeControllersConfig) DeepCopy() *KubeControllersConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeControllersConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeControllersNodeConfig) DeepCopyInto(out *KubeControllersNodeConfig) {
+	*out = *in
+	if in.ReconcilerPeriod != nil {
+		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LeakGracePeriod != nil {
+		in, out := &in.LeakGracePeriod, &out.LeakGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AutoHostEndpoints != nil {
+		in, out := &in.AutoHostEndpoints, &out.AutoHostEndpoints
+		*out = new(AutoHostEndpointsType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeControllersNodeConfig.
+func (in *KubeControllersNodeConfig) DeepCopy() *KubeControllersNodeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeControllersNodeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *L7LogCollectorDaemonSet) DeepCopyInto(out *L7LogCollectorDaemonSet) {
 	*out = *in
@@ -5125,6 +5835,16 @@ func (in *LogStorageSpec) DeepCopyInto(out *LogStorageSpec) {
 		*out = new(ElasticsearchMetricsDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ESGateway != nil {
+		in, out := &in.ESGateway, &out.ESGateway
+		*out = new(ESGateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotRepository != nil {
+		in, out := &in.SnapshotRepository, &out.SnapshotRepository
+		*out = new(SnapshotRepository)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogStorageSpec.
@@ -5277,6 +5997,125 @@ func (in *ManagementClusterConnectionSpec) DeepCopyInto(out *ManagementClusterCo
 		*out = new(GuardianDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IPFamilyPreference != nil {
+		in, out := &in.IPFamilyPreference, &out.IPFamilyPreference
+		*out = new(IPFamily)
+		**out = **in
+	}
+	if in.MaxRequestHeaderBytes != nil {
+		in, out := &in.MaxRequestHeaderBytes, &out.MaxRequestHeaderBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TunnelMaxFrameBytes != nil {
+		in, out := &in.TunnelMaxFrameBytes, &out.TunnelMaxFrameBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TunnelCompression != nil {
+		in, out := &in.TunnelCompression, &out.TunnelCompression
+		*out = new(TunnelCompressionType)
+		**out = **in
+	}
+	if in.BackendRetryPolicy != nil {
+		in, out := &in.BackendRetryPolicy, &out.BackendRetryPolicy
+		*out = new(GuardianRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSCacheTTL != nil {
+		in, out := &in.DNSCacheTTL, &out.DNSCacheTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.GuardianService != nil {
+		in, out := &in.GuardianService, &out.GuardianService
+		*out = new(GuardianService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessLogging != nil {
+		in, out := &in.AccessLogging, &out.AccessLogging
+		*out = new(GuardianAccessLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TunnelLatencyBuckets != nil {
+		in, out := &in.TunnelLatencyBuckets, &out.TunnelLatencyBuckets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TunnelHeaders != nil {
+		in, out := &in.TunnelHeaders, &out.TunnelHeaders
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TunnelPort != nil {
+		in, out := &in.TunnelPort, &out.TunnelPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(GuardianServiceAccountToken)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TunnelBandwidthLimit != nil {
+		in, out := &in.TunnelBandwidthLimit, &out.TunnelBandwidthLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ClusterLabels != nil {
+		in, out := &in.ClusterLabels, &out.ClusterLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BackendCABundles != nil {
+		in, out := &in.BackendCABundles, &out.BackendCABundles
+		*out = new(GuardianBackendCABundles)
+		**out = **in
+	}
+	if in.RequestCoalescing != nil {
+		in, out := &in.RequestCoalescing, &out.RequestCoalescing
+		*out = new(RequestCoalescingType)
+		**out = **in
+	}
+	if in.ShutdownDrainDelay != nil {
+		in, out := &in.ShutdownDrainDelay, &out.ShutdownDrainDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.EgressGateway != nil {
+		in, out := &in.EgressGateway, &out.EgressGateway
+		*out = new(GuardianEgressGateway)
+		**out = **in
+	}
+	if in.HeartbeatInterval != nil {
+		in, out := &in.HeartbeatInterval, &out.HeartbeatInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CertificateExpiryThreshold != nil {
+		in, out := &in.CertificateExpiryThreshold, &out.CertificateExpiryThreshold
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DebugConfigEndpoint != nil {
+		in, out := &in.DebugConfigEndpoint, &out.DebugConfigEndpoint
+		*out = new(DebugConfigEndpointType)
+		**out = **in
+	}
+	if in.TierReadinessGate != nil {
+		in, out := &in.TierReadinessGate, &out.TierReadinessGate
+		*out = new(TierReadinessGateType)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnectionSpec.
@@ -5299,6 +6138,14 @@ func (in *ManagementClusterConnectionStatus) DeepCopyInto(out *ManagementCluster
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastConnectedTime != nil {
+		in, out := &in.LastConnectedTime, &out.LastConnectedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CertificateNotAfter != nil {
+		in, out := &in.CertificateNotAfter, &out.CertificateNotAfter
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterConnectionStatus.
@@ -5748,6 +6595,22 @@ func (in *MonitorStatus) DeepCopy() *MonitorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceResourceQuota) DeepCopyInto(out *NamespaceResourceQuota) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceResourceQuota.
+func (in *NamespaceResourceQuota) DeepCopy() *NamespaceResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAddressAutodetection) DeepCopyInto(out *NodeAddressAutodetection) {
 	*out = *in
@@ -5805,6 +6668,21 @@ func (in *NodeAffinity) DeepCopy() *NodeAffinity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLocalDNSCache) DeepCopyInto(out *NodeLocalDNSCache) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNSCache.
+func (in *NodeLocalDNSCache) DeepCopy() *NodeLocalDNSCache {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLocalDNSCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeSet) DeepCopyInto(out *NodeSet) {
 	*out = *in
@@ -5855,6 +6733,11 @@ func (in *Nodes) DeepCopyInto(out *Nodes) {
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HeapSize != nil {
+		in, out := &in.HeapSize, &out.HeapSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Nodes.
@@ -6175,6 +7058,21 @@ func (in *PrometheusSpec) DeepCopy() *PrometheusSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtoPort) DeepCopyInto(out *ProtoPort) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtoPort.
+func (in *ProtoPort) DeepCopy() *ProtoPort {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtoPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Retention) DeepCopyInto(out *Retention) {
 	*out = *in
@@ -6220,6 +7118,28 @@ func (in *Retention) DeepCopy() *Retention {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteReflectors) DeepCopyInto(out *RouteReflectors) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteReflectors.
+func (in *RouteReflectors) DeepCopy() *RouteReflectors {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteReflectors)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3StoreSpec) DeepCopyInto(out *S3StoreSpec) {
 	*out = *in
@@ -6250,6 +7170,28 @@ func (in *SNIMatch) DeepCopy() *SNIMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountAnnotations) DeepCopyInto(out *ServiceAccountAnnotations) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountAnnotations.
+func (in *ServiceAccountAnnotations) DeepCopy() *ServiceAccountAnnotations {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountAnnotations)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceMonitor) DeepCopyInto(out *ServiceMonitor) {
 	*out = *in
@@ -6279,6 +7221,31 @@ func (in *ServiceMonitor) DeepCopy() *ServiceMonitor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotRepository) DeepCopyInto(out *SnapshotRepository) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotRepository.
+func (in *SnapshotRepository) DeepCopy() *SnapshotRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SplunkStoreSpec) DeepCopyInto(out *SplunkStoreSpec) {
 	*out = *in
@@ -7016,6 +7983,21 @@ func (in *UserSearch) DeepCopy() *UserSearch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserWorkloadTier) DeepCopyInto(out *UserWorkloadTier) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserWorkloadTier.
+func (in *UserWorkloadTier) DeepCopy() *UserWorkloadTier {
+	if in == nil {
+		return nil
+	}
+	out := new(UserWorkloadTier)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WindowsNodeSpec) DeepCopyInto(out *WindowsNodeSpec) {
 	*out = *in
@@ -103,6 +103,8 @@ func main() {
 	var sgSetup bool
 	var manageCRDs bool
 	var preDelete bool
+	var singleInstance bool
+	var expectedProvider string
 
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", true,
 		"Enable leader election for controller manager. "+
@@ -123,6 +125,12 @@ func main() {
 		"Operator should manage the projectcalico.org and operator.tigera.io CRDs.")
 	flag.BoolVar(&preDelete, "pre-delete", false,
 		"Run helm pre-deletion hook logic, then exit.")
+	flag.BoolVar(&singleInstance, "single-instance", active.SingleInstanceFromEnv(),
+		"Assert that only one operator runs in the cluster, skipping the wait to become the active operator. "+
+			"Requires --enable-leader-election=false. Can also be set with the "+active.SingleInstanceEnvVar+" environment variable.")
+	flag.StringVar(&expectedProvider, "expected-provider", os.Getenv(utils.ExpectedProviderEnvVar),
+		"Exit if the auto-detected provider differs from this one. Possible values: None, EKS, GKE, AKS, RKE2, OpenShift, DockerEnterprise, TKG. "+
+			"Can also be set with the "+utils.ExpectedProviderEnvVar+" environment variable.")
 
 	opts := zap.Options{}
 	opts.BindFlags(flag.CommandLine)
@@ -174,6 +182,14 @@ func main() {
 		}
 	}
 
+	// Skipping the wait to become active is only safe when nothing else coordinates multiple operators. With leader
+	// election enabled more than one operator may be running, and they would all skip the wait.
+	if singleInstance && enableLeaderElection {
+		setupLog.Error(fmt.Errorf("single-instance operation requires leader election to be disabled"),
+			"Terminating: --single-instance (or "+active.SingleInstanceEnvVar+") cannot be used with --enable-leader-election")
+		os.Exit(1)
+	}
+
 	printVersion()
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -230,7 +246,7 @@ func main() {
 	// there may be cleanup required. So, we will pass a separate context to our controllers.
 	// That context will be canceled after a successful cleanup.
 	sigHandler := ctrl.SetupSignalHandler()
-	active.WaitUntilActive(cs, c, sigHandler, setupLog)
+	active.EnsureActive(singleInstance, cs, c, sigHandler, setupLog)
 	log.Info("Active operator: proceeding")
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
@@ -353,6 +369,10 @@ func main() {
 		os.Exit(1)
 	}
 	setupLog.WithValues("provider", provider).Info("Checking type of cluster")
+	if err := utils.ValidateExpectedProvider(expectedProvider, provider); err != nil {
+		setupLog.Error(err, "Auto discovered Provider is not the expected one")
+		os.Exit(1)
+	}
 
 	// Determine if we're running in single or multi-tenant mode.
 	multiTenant, err := utils.MultiTenant(ctx, clientset)
@@ -434,6 +454,28 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Validate the bootstrap configmap so that malformed values fail fast and typos are visible.
+	unknownKeys, err := utils.ValidateBootstrapConfig(bootConfig)
+	if err != nil {
+		log.Error(err, "Invalid bootstrap configmap")
+		os.Exit(1)
+	}
+	for _, key := range unknownKeys {
+		log.Info("Ignoring unrecognized key in bootstrap configmap", "key", key)
+	}
+
+	featureGates, err := utils.LoadFeatureGates(bootConfig)
+	if err != nil {
+		log.Error(err, "Invalid feature gates in bootstrap configmap")
+		os.Exit(1)
+	}
+
+	expectedNodeCIDRs, err := utils.LoadExpectedNodeCIDRs(bootConfig)
+	if err != nil {
+		log.Error(err, "Invalid expected node CIDRs in bootstrap configmap")
+		os.Exit(1)
+	}
+
 	options := options.AddOptions{
 		DetectedProvider:    provider,
 		EnterpriseCRDExists: enterpriseCRDExists,
@@ -444,6 +486,11 @@ func main() {
 		ShutdownContext:     ctx,
 		MultiTenant:         multiTenant,
 		ElasticExternal:     utils.UseExternalElastic(bootConfig),
+		FeatureGates:        featureGates,
+		ExpectedNodeCIDRs:   expectedNodeCIDRs,
+
+		RequireConsistentNATOutgoing: utils.RequireConsistentNATOutgoing(bootConfig),
+		AuditLog:                     utils.UseAuditLog(bootConfig),
 	}
 
 	// Before we start any controllers, make sure our options are valid.
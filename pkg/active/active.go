@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -94,6 +95,28 @@ func GenerateMyActiveConfigMap() *corev1.ConfigMap {
 var OsExitOverride = os.Exit
 var TickerRateOverride = 1000 * time.Millisecond
 
+// SingleInstanceEnvVar can be set to "true" to assert that only a single operator runs in the cluster, as an
+// alternative to the --single-instance flag.
+const SingleInstanceEnvVar = "OPERATOR_SINGLE_INSTANCE"
+
+// SingleInstanceFromEnv returns whether single-instance operation has been asserted through SingleInstanceEnvVar.
+func SingleInstanceFromEnv() bool {
+	v, _ := strconv.ParseBool(os.Getenv(SingleInstanceEnvVar))
+	return v
+}
+
+// EnsureActive blocks until this operator is the active one, see WaitUntilActive. When singleInstance is set the user
+// has asserted that no other operator runs in the cluster, so there is nothing to wait for and the check is skipped.
+// It must only be set when leader election is disabled, as main enforces.
+func EnsureActive(singleInstance bool, cs *kubernetes.Clientset, client client.Client, ctx context.Context, log logr.Logger) {
+	if singleInstance {
+		log.Info("Single-instance operation asserted: skipping the wait to become the active operator. "+
+			"Do not run more than one operator in this cluster while this is set.", "namespace", operatorNamespace())
+		return
+	}
+	WaitUntilActive(cs, client, ctx, log)
+}
+
 func WaitUntilActive(cs *kubernetes.Clientset, client client.Client, ctx context.Context, log logr.Logger) {
 	acm := GenerateMyActiveConfigMap()
 	listWatch := cache.NewListWatchFromClient(cs.CoreV1().RESTClient(), "configmaps", acm.Namespace, fields.OneTermEqualSelector("metadata.name", acm.Name))
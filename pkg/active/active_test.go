@@ -16,10 +16,13 @@ package active
 
 import (
 	"context"
+	"fmt"
+	"os"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/go-logr/logr/funcr"
 	apps "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -121,4 +124,41 @@ var _ = Describe("test active pkg", func() {
 			Expect(ns).To(Equal(""))
 		})
 	})
+
+	Context("EnsureActive", func() {
+		AfterEach(func() {
+			OsExitOverride = os.Exit
+		})
+
+		It("should return without waiting when single-instance operation is asserted", func() {
+			OsExitOverride = func(code int) { Fail(fmt.Sprintf("unexpected exit with code %d", code)) }
+			var logged []string
+			log := funcr.New(func(prefix, args string) { logged = append(logged, args) }, funcr.Options{})
+
+			// No clientset is needed as the active-operator ConfigMap is never watched.
+			EnsureActive(true, nil, c, ctx, log)
+			Expect(logged).To(HaveLen(1))
+			Expect(logged[0]).To(ContainSubstring("skipping the wait to become the active operator"))
+		})
+	})
+
+	Context("SingleInstanceFromEnv", func() {
+		AfterEach(func() {
+			Expect(os.Unsetenv(SingleInstanceEnvVar)).To(Succeed())
+		})
+
+		It("should default to false", func() {
+			Expect(SingleInstanceFromEnv()).To(BeFalse())
+		})
+
+		It("should be true when the environment variable is set", func() {
+			Expect(os.Setenv(SingleInstanceEnvVar, "true")).To(Succeed())
+			Expect(SingleInstanceFromEnv()).To(BeTrue())
+		})
+
+		It("should be false for an unparsable value", func() {
+			Expect(os.Setenv(SingleInstanceEnvVar, "yes please")).To(Succeed())
+			Expect(SingleInstanceFromEnv()).To(BeFalse())
+		})
+	})
 })
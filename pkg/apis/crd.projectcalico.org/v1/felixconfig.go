@@ -46,6 +46,23 @@ const (
 	TPROXYModeOptionDisabled TPROXYModeOption = "Disabled"
 )
 
+// +kubebuilder:validation:Enum=TCP;Enabled;Disabled
+type BPFConnectTimeLBType string
+
+const (
+	BPFConnectTimeLBTCP      BPFConnectTimeLBType = "TCP"
+	BPFConnectTimeLBEnabled  BPFConnectTimeLBType = "Enabled"
+	BPFConnectTimeLBDisabled BPFConnectTimeLBType = "Disabled"
+)
+
+// +kubebuilder:validation:Enum=Enabled;Disabled
+type FloatingIPType string
+
+const (
+	FloatingIPsEnabled  FloatingIPType = "Enabled"
+	FloatingIPsDisabled FloatingIPType = "Disabled"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -117,6 +134,10 @@ type FelixConfigurationSpec struct {
 	// disable XDP refresh. [Default: 90s]
 	XDPRefreshInterval *metav1.Duration `json:"xdpRefreshInterval,omitempty" configv1timescale:"seconds"`
 
+	// ConntrackCleanupInterval is the period at which Felix scans the conntrack table and removes stale
+	// entries for workloads that no longer exist. [Default: 10s]
+	ConntrackCleanupInterval *metav1.Duration `json:"conntrackCleanupInterval,omitempty" configv1timescale:"seconds"`
+
 	NetlinkTimeout *metav1.Duration `json:"netlinkTimeout,omitempty" configv1timescale:"seconds" confignamev1:"NetlinkTimeoutSecs"`
 
 	// MetadataAddr is the IP address or domain name of the server that can answer VM queries for
@@ -326,12 +347,35 @@ type FelixConfigurationSpec struct {
 	// and it improves the performance of pod-to-service connections.  The only reason to disable it is for debugging
 	// purposes.  [Default: true]
 	BPFConnectTimeLoadBalancingEnabled *bool `json:"bpfConnectTimeLoadBalancingEnabled,omitempty" validate:"omitempty"`
+	// BPFConnectTimeLoadBalancing when in BPF mode, controls whether Felix installs the connect-time load
+	// balancer. The connect-time load balancer is required for the host to be able to reach Kubernetes services
+	// and it improves the performance of pod-to-service connections. When set to TCP, connect time load balancing
+	// is available only for services with TCP ports. [Default: TCP]
+	BPFConnectTimeLoadBalancing *BPFConnectTimeLBType `json:"bpfConnectTimeLoadBalancing,omitempty" validate:"omitempty,oneof=TCP Enabled Disabled"`
 	// BPFExternalServiceMode in BPF mode, controls how connections from outside the cluster to services (node ports
 	// and cluster IPs) are forwarded to remote workloads.  If set to "Tunnel" then both request and response traffic
 	// is tunneled to the remote node.  If set to "DSR", the request traffic is tunneled but the response traffic
 	// is sent directly from the remote node.  In "DSR" mode, the remote node appears to use the IP of the ingress
 	// node; this requires a permissive L2 network.  [Default: Tunnel]
 	BPFExternalServiceMode string `json:"bpfExternalServiceMode,omitempty" validate:"omitempty,bpfServiceMode"`
+	// BPFConntrackCleanupInterval in BPF mode, is the period at which Felix scans the BPF conntrack map and
+	// removes expired entries.  [Default: 10s]
+	BPFConntrackCleanupInterval *metav1.Duration `json:"bpfConntrackCleanupInterval,omitempty" configv1timescale:"seconds"`
+	// BPFMapSizeConntrack sets the size for the conntrack map.  This map must be large enough to hold
+	// an entry for each active connection.  Warning: changing the size of the conntrack map can cause disruption.
+	BPFMapSizeConntrack *int `json:"bpfMapSizeConntrack,omitempty"`
+	// BPFMapSizeNATFrontend sets the size for nat front end map.
+	// FrontendMap should be large enough to hold an entry for each nodeport,
+	// external IP and each port in each service.
+	BPFMapSizeNATFrontend *int `json:"bpfMapSizeNATFrontend,omitempty"`
+	// BPFMapSizeNATBackend sets the size for nat back end map.
+	// This is the total number of endpoints. This is mostly
+	// more than the size of the number of services.
+	BPFMapSizeNATBackend *int `json:"bpfMapSizeNATBackend,omitempty"`
+	// BPFMapSizeRoute sets the size for the routes map.  The routes map should be large enough
+	// to hold one entry per workload and a handful of entries per host (enough to cover its own IPs and
+	// tunnel IPs).
+	BPFMapSizeRoute *int `json:"bpfMapSizeRoute,omitempty"`
 	// BPFKubeProxyIptablesCleanupEnabled, if enabled in BPF mode, Felix will proactively clean up the upstream
 	// Kubernetes kube-proxy's iptables chains.  Should only be enabled if kube-proxy is not running.  [Default: true]
 	BPFKubeProxyIptablesCleanupEnabled *bool `json:"bpfKubeProxyIptablesCleanupEnabled,omitempty" validate:"omitempty"`
@@ -383,6 +427,11 @@ type FelixConfigurationSpec struct {
 	// [Default: Disabled]
 	TPROXYMode *TPROXYModeOption `json:"tproxyMode,omitempty"`
 
+	// FloatingIPs configures whether or not Felix will program non-OpenStack floating IP addresses. (OpenStack-derived
+	// floating IPs are always programmed, regardless of this setting.)
+	// [Default: Disabled]
+	FloatingIPs *FloatingIPType `json:"floatingIPs,omitempty" validate:"omitempty,oneof=Enabled Disabled"`
+
 	// EgressIPVXLANPort is the port number of vxlan tunnel device for egress traffic. [Default: 4790]
 	EgressIPVXLANPort *int `json:"egressIPVXLANPort,omitempty"`
 	// EgressIPVXLANVNI is the VNI ID of vxlan tunnel device for egress traffic. [Default: 4097]
@@ -395,6 +444,9 @@ type FelixConfigurationSpec struct {
 	// `[fd00:83a6::12]:5353`.Note that Felix (calico-node) will need RBAC permission to read the details of
 	// each service specified by a `k8s-service:...` form. [Default: "k8s-service:kube-dns"].
 	DNSTrustedServers *[]string `json:"dnsTrustedServers,omitempty"`
+	// Extra time to keep IPs and alias names that are learnt from DNS, in addition to each name
+	// or IP's advertised TTL. [Default: 0s].
+	DNSExtraTTL *metav1.Duration `json:"dnsExtraTTL,omitempty" configv1timescale:"seconds"`
 }
 
 type RouteTableRange struct {
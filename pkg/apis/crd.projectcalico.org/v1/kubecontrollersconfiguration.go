@@ -28,8 +28,113 @@ type KubeControllersConfiguration struct {
 
 // KubeControllersConfigurationSpec contains the values of the Kubernetes controllers configuration.
 type KubeControllersConfigurationSpec struct {
+	// LogSeverityScreen is the log severity above which logs are sent to the stdout. [Default: Info]
+	LogSeverityScreen string `json:"logSeverityScreen,omitempty" validate:"omitempty,logLevel"`
+
+	// HealthChecks enables or disables support for health checks [Default: Enabled]
+	HealthChecks string `json:"healthChecks,omitempty" validate:"omitempty,oneof=Enabled Disabled"`
+
+	// EtcdV3CompactionPeriod is the period between etcdv3 compaction requests. Set to 0 to disable. [Default: 10m]
+	EtcdV3CompactionPeriod *metav1.Duration `json:"etcdV3CompactionPeriod,omitempty" validate:"omitempty"`
+
 	// PrometheusMetricsPort is the TCP port that the Prometheus metrics server should bind to. Set to 0 to disable. [Default: 9094]
 	PrometheusMetricsPort *int `json:"prometheusMetricsPort,omitempty"`
+
+	// Controllers enables and configures individual Kubernetes controllers
+	Controllers ControllersConfig `json:"controllers"`
+}
+
+// ControllersConfig enables and configures individual Kubernetes controllers
+type ControllersConfig struct {
+	// Node enables and configures the node controller. Enabled by default, set to nil to disable.
+	Node *NodeControllerConfig `json:"node,omitempty"`
+
+	// Policy enables and configures the policy controller. Enabled by default, set to nil to disable.
+	Policy *PolicyControllerConfig `json:"policy,omitempty"`
+
+	// WorkloadEndpoint enables and configures the workload endpoint controller. Enabled by default, set to nil to disable.
+	WorkloadEndpoint *WorkloadEndpointControllerConfig `json:"workloadEndpoint,omitempty"`
+
+	// ServiceAccount enables and configures the service account controller. Enabled by default, set to nil to disable.
+	ServiceAccount *ServiceAccountControllerConfig `json:"serviceAccount,omitempty"`
+
+	// Namespace enables and configures the namespace controller. Enabled by default, set to nil to disable.
+	Namespace *NamespaceControllerConfig `json:"namespace,omitempty"`
+}
+
+// NodeControllerConfig configures the node controller, which automatically cleans up configuration
+// for nodes that no longer exist. Optionally, it can create host endpoints for all Kubernetes nodes.
+type NodeControllerConfig struct {
+	// ReconcilerPeriod is the period to perform reconciliation with the Calico datastore. [Default: 5m]
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
+
+	// SyncLabels controls whether to copy Kubernetes node labels to Calico nodes. [Default: Enabled]
+	SyncLabels string `json:"syncLabels,omitempty" validate:"omitempty,oneof=Enabled Disabled"`
+
+	// HostEndpoint controls syncing nodes to host endpoints. Disabled by default, set to nil to disable.
+	HostEndpoint *AutoHostEndpointConfig `json:"hostEndpoint,omitempty"`
+
+	// LeakGracePeriod is the period used by the controller to determine if an IP address has been leaked.
+	// Set to 0 to disable IP garbage collection. [Default: 15m]
+	LeakGracePeriod *metav1.Duration `json:"leakGracePeriod,omitempty"`
+}
+
+type AutoHostEndpointConfig struct {
+	// AutoCreate enables automatic creation of host endpoints for every node. [Default: Disabled]
+	AutoCreate string `json:"autoCreate,omitempty" validate:"omitempty,oneof=Enabled Disabled"`
+
+	// CreateDefaultHostEndpoint controls whether the default host endpoint, which covers all of a node's
+	// interfaces, is created for every node. [Default: Enabled]
+	CreateDefaultHostEndpoint string `json:"createDefaultHostEndpoint,omitempty" validate:"omitempty,oneof=Enabled Disabled"`
+
+	// Templates contains definitions for the host endpoints created for nodes in addition to the default one.
+	Templates []Template `json:"templates,omitempty" validate:"omitempty"`
+}
+
+type Template struct {
+	// GenerateName is appended to the end of the generated host endpoint name.
+	GenerateName string `json:"generateName,omitempty" validate:"omitempty,name"`
+
+	// InterfaceCIDRs contains a list of CIDRs used for matching nodeIPs to the host endpoint.
+	InterfaceCIDRs []string `json:"interfaceCIDRs,omitempty" validate:"omitempty,cidrs"`
+
+	// InterfacePattern contains a regex string to match node interface names. If set, the host endpoint
+	// is created for the matching interfaces.
+	InterfacePattern string `json:"interfacePattern,omitempty" validate:"omitempty,regexp"`
+
+	// Labels adds the specified labels to the generated host endpoint.
+	Labels map[string]string `json:"labels,omitempty" validate:"omitempty,labels"`
+
+	// NodeSelector allows the user to select the nodes that should have the host endpoint created.
+	NodeSelector string `json:"nodeSelector,omitempty" validate:"omitempty,selector"`
+}
+
+// PolicyControllerConfig configures the network policy controller, which syncs Kubernetes policies
+// to Calico policies (only used for etcdv3 datastore).
+type PolicyControllerConfig struct {
+	// ReconcilerPeriod is the period to perform reconciliation with the Calico datastore. [Default: 5m]
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
+}
+
+// WorkloadEndpointControllerConfig configures the workload endpoint controller, which syncs Kubernetes
+// labels to Calico workload endpoints (only used for etcdv3 datastore).
+type WorkloadEndpointControllerConfig struct {
+	// ReconcilerPeriod is the period to perform reconciliation with the Calico datastore. [Default: 5m]
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
+}
+
+// ServiceAccountControllerConfig configures the service account controller, which syncs Kubernetes
+// service accounts to Calico profiles (only used for etcdv3 datastore).
+type ServiceAccountControllerConfig struct {
+	// ReconcilerPeriod is the period to perform reconciliation with the Calico datastore. [Default: 5m]
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
+}
+
+// NamespaceControllerConfig configures the namespace controller, which syncs Kubernetes
+// labels to Calico profiles (only used for etcdv3 datastore).
+type NamespaceControllerConfig struct {
+	// ReconcilerPeriod is the period to perform reconciliation with the Calico datastore. [Default: 5m]
+	ReconcilerPeriod *metav1.Duration `json:"reconcilerPeriod,omitempty" validate:"omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -42,6 +42,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&KubeControllersConfigurationList{},
 		&BGPConfiguration{},
 		&BGPConfigurationList{},
+		&IPAMConfig{},
+		&IPAMConfigList{},
+		&BGPFilter{},
+		&BGPFilterList{},
+		&BGPPeer{},
+		&BGPPeerList{},
 		&ExternalNetwork{},
 		&ExternalNetworkList{},
 	)
@@ -29,6 +29,28 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoHostEndpointConfig) DeepCopyInto(out *AutoHostEndpointConfig) {
+	*out = *in
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]Template, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoHostEndpointConfig.
+func (in *AutoHostEndpointConfig) DeepCopy() *AutoHostEndpointConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoHostEndpointConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPConfiguration) DeepCopyInto(out *BGPConfiguration) {
 	*out = *in
@@ -149,6 +171,129 @@ func (in *BGPConfigurationSpec) DeepCopy() *BGPConfigurationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilter) DeepCopyInto(out *BGPFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilter.
+func (in *BGPFilter) DeepCopy() *BGPFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterList) DeepCopyInto(out *BGPFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BGPFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterList.
+func (in *BGPFilterList) DeepCopy() *BGPFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterRuleV4) DeepCopyInto(out *BGPFilterRuleV4) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterRuleV4.
+func (in *BGPFilterRuleV4) DeepCopy() *BGPFilterRuleV4 {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterRuleV4)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterRuleV6) DeepCopyInto(out *BGPFilterRuleV6) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterRuleV6.
+func (in *BGPFilterRuleV6) DeepCopy() *BGPFilterRuleV6 {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterRuleV6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterSpec) DeepCopyInto(out *BGPFilterSpec) {
+	*out = *in
+	if in.ExportV4 != nil {
+		in, out := &in.ExportV4, &out.ExportV4
+		*out = make([]BGPFilterRuleV4, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportV4 != nil {
+		in, out := &in.ImportV4, &out.ImportV4
+		*out = make([]BGPFilterRuleV4, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExportV6 != nil {
+		in, out := &in.ExportV6, &out.ExportV6
+		*out = make([]BGPFilterRuleV6, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportV6 != nil {
+		in, out := &in.ImportV6, &out.ImportV6
+		*out = make([]BGPFilterRuleV6, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterSpec.
+func (in *BGPFilterSpec) DeepCopy() *BGPFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPPassword) DeepCopyInto(out *BGPPassword) {
 	*out = *in
@@ -169,6 +314,84 @@ func (in *BGPPassword) DeepCopy() *BGPPassword {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeer) DeepCopyInto(out *BGPPeer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeer.
+func (in *BGPPeer) DeepCopy() *BGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPPeer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerList) DeepCopyInto(out *BGPPeerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BGPPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerList.
+func (in *BGPPeerList) DeepCopy() *BGPPeerList {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPPeerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerSpec) DeepCopyInto(out *BGPPeerSpec) {
+	*out = *in
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerSpec.
+func (in *BGPPeerSpec) DeepCopy() *BGPPeerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Community) DeepCopyInto(out *Community) {
 	*out = *in
@@ -184,6 +407,46 @@ func (in *Community) DeepCopy() *Community {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllersConfig) DeepCopyInto(out *ControllersConfig) {
+	*out = *in
+	if in.Node != nil {
+		in, out := &in.Node, &out.Node
+		*out = new(NodeControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(PolicyControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadEndpoint != nil {
+		in, out := &in.WorkloadEndpoint, &out.WorkloadEndpoint
+		*out = new(WorkloadEndpointControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(ServiceAccountControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(NamespaceControllerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllersConfig.
+func (in *ControllersConfig) DeepCopy() *ControllersConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllersConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalNetwork) DeepCopyInto(out *ExternalNetwork) {
 	*out = *in
@@ -383,6 +646,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.ConntrackCleanupInterval != nil {
+		in, out := &in.ConntrackCleanupInterval, &out.ConntrackCleanupInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.NetlinkTimeout != nil {
 		in, out := &in.NetlinkTimeout, &out.NetlinkTimeout
 		*out = new(metav1.Duration)
@@ -609,6 +877,36 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.BPFConnectTimeLoadBalancing != nil {
+		in, out := &in.BPFConnectTimeLoadBalancing, &out.BPFConnectTimeLoadBalancing
+		*out = new(BPFConnectTimeLBType)
+		**out = **in
+	}
+	if in.BPFConntrackCleanupInterval != nil {
+		in, out := &in.BPFConntrackCleanupInterval, &out.BPFConntrackCleanupInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BPFMapSizeConntrack != nil {
+		in, out := &in.BPFMapSizeConntrack, &out.BPFMapSizeConntrack
+		*out = new(int)
+		**out = **in
+	}
+	if in.BPFMapSizeNATFrontend != nil {
+		in, out := &in.BPFMapSizeNATFrontend, &out.BPFMapSizeNATFrontend
+		*out = new(int)
+		**out = **in
+	}
+	if in.BPFMapSizeNATBackend != nil {
+		in, out := &in.BPFMapSizeNATBackend, &out.BPFMapSizeNATBackend
+		*out = new(int)
+		**out = **in
+	}
+	if in.BPFMapSizeRoute != nil {
+		in, out := &in.BPFMapSizeRoute, &out.BPFMapSizeRoute
+		*out = new(int)
+		**out = **in
+	}
 	if in.BPFKubeProxyIptablesCleanupEnabled != nil {
 		in, out := &in.BPFKubeProxyIptablesCleanupEnabled, &out.BPFKubeProxyIptablesCleanupEnabled
 		*out = new(bool)
@@ -684,6 +982,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(TPROXYModeOption)
 		**out = **in
 	}
+	if in.FloatingIPs != nil {
+		in, out := &in.FloatingIPs, &out.FloatingIPs
+		*out = new(FloatingIPType)
+		**out = **in
+	}
 	if in.EgressIPVXLANPort != nil {
 		in, out := &in.EgressIPVXLANPort, &out.EgressIPVXLANPort
 		*out = new(int)
@@ -703,6 +1006,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 			copy(*out, *in)
 		}
 	}
+	if in.DNSExtraTTL != nil {
+		in, out := &in.DNSExtraTTL, &out.DNSExtraTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FelixConfigurationSpec.
@@ -715,6 +1023,79 @@ func (in *FelixConfigurationSpec) DeepCopy() *FelixConfigurationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMConfig) DeepCopyInto(out *IPAMConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMConfig.
+func (in *IPAMConfig) DeepCopy() *IPAMConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMConfigList) DeepCopyInto(out *IPAMConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAMConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMConfigList.
+func (in *IPAMConfigList) DeepCopy() *IPAMConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMConfigSpec) DeepCopyInto(out *IPAMConfigSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMConfigSpec.
+func (in *IPAMConfigSpec) DeepCopy() *IPAMConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPool) DeepCopyInto(out *IPPool) {
 	*out = *in
@@ -854,11 +1235,17 @@ func (in *KubeControllersConfigurationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeControllersConfigurationSpec) DeepCopyInto(out *KubeControllersConfigurationSpec) {
 	*out = *in
+	if in.EtcdV3CompactionPeriod != nil {
+		in, out := &in.EtcdV3CompactionPeriod, &out.EtcdV3CompactionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.PrometheusMetricsPort != nil {
 		in, out := &in.PrometheusMetricsPort, &out.PrometheusMetricsPort
 		*out = new(int)
 		**out = **in
 	}
+	in.Controllers.DeepCopyInto(&out.Controllers)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeControllersConfigurationSpec.
@@ -871,6 +1258,76 @@ func (in *KubeControllersConfigurationSpec) DeepCopy() *KubeControllersConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceControllerConfig) DeepCopyInto(out *NamespaceControllerConfig) {
+	*out = *in
+	if in.ReconcilerPeriod != nil {
+		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceControllerConfig.
+func (in *NamespaceControllerConfig) DeepCopy() *NamespaceControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeControllerConfig) DeepCopyInto(out *NodeControllerConfig) {
+	*out = *in
+	if in.ReconcilerPeriod != nil {
+		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.HostEndpoint != nil {
+		in, out := &in.HostEndpoint, &out.HostEndpoint
+		*out = new(AutoHostEndpointConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LeakGracePeriod != nil {
+		in, out := &in.LeakGracePeriod, &out.LeakGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeControllerConfig.
+func (in *NodeControllerConfig) DeepCopy() *NodeControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyControllerConfig) DeepCopyInto(out *PolicyControllerConfig) {
+	*out = *in
+	if in.ReconcilerPeriod != nil {
+		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyControllerConfig.
+func (in *PolicyControllerConfig) DeepCopy() *PolicyControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrefixAdvertisement) DeepCopyInto(out *PrefixAdvertisement) {
 	*out = *in
@@ -921,6 +1378,26 @@ func (in *RouteTableRange) DeepCopy() *RouteTableRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountControllerConfig) DeepCopyInto(out *ServiceAccountControllerConfig) {
+	*out = *in
+	if in.ReconcilerPeriod != nil {
+		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountControllerConfig.
+func (in *ServiceAccountControllerConfig) DeepCopy() *ServiceAccountControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceClusterIPBlock) DeepCopyInto(out *ServiceClusterIPBlock) {
 	*out = *in
@@ -965,3 +1442,50 @@ func (in *ServiceLoadBalancerIPBlock) DeepCopy() *ServiceLoadBalancerIPBlock {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Template) DeepCopyInto(out *Template) {
+	*out = *in
+	if in.InterfaceCIDRs != nil {
+		in, out := &in.InterfaceCIDRs, &out.InterfaceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Template.
+func (in *Template) DeepCopy() *Template {
+	if in == nil {
+		return nil
+	}
+	out := new(Template)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadEndpointControllerConfig) DeepCopyInto(out *WorkloadEndpointControllerConfig) {
+	*out = *in
+	if in.ReconcilerPeriod != nil {
+		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadEndpointControllerConfig.
+func (in *WorkloadEndpointControllerConfig) DeepCopy() *WorkloadEndpointControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadEndpointControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -15,9 +15,13 @@
 package validation
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 
+	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common/k8svalidation"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -33,6 +37,46 @@ func ValidateCalicoNodeDaemonSetInitContainer(container corev1.Container) error
 	return errs.ToAggregate()
 }
 
+// ValidateCalicoNodeStartupProbe validates the startup probe configured for the calico-node container, if any.
+func ValidateCalicoNodeStartupProbe(probe *operatorv1.CalicoNodeStartupProbe) error {
+	if probe == nil {
+		return nil
+	}
+	if probe.PeriodSeconds != nil && *probe.PeriodSeconds < 1 {
+		return fmt.Errorf("spec.Template.Spec.Containers[%q].StartupProbe.PeriodSeconds must be at least 1, got %d", "calico-node", *probe.PeriodSeconds)
+	}
+	if probe.FailureThreshold != nil && *probe.FailureThreshold < 1 {
+		return fmt.Errorf("spec.Template.Spec.Containers[%q].StartupProbe.FailureThreshold must be at least 1, got %d", "calico-node", *probe.FailureThreshold)
+	}
+	return nil
+}
+
+// ValidateCalicoNodeDaemonSetExtraContainers validates the given user-provided sidecar containers. reservedNames
+// are the names of the containers managed by the operator, which the user-provided containers must not reuse.
+func ValidateCalicoNodeDaemonSetExtraContainers(containers []corev1.Container, reservedNames ...string) error {
+	names := map[string]bool{}
+	for _, n := range reservedNames {
+		names[n] = true
+	}
+	for _, c := range containers {
+		if errs := validation.IsDNS1123Label(c.Name); len(errs) > 0 {
+			return fmt.Errorf("spec.Template.Spec.ExtraContainers name %q is invalid: %v", c.Name, errs)
+		}
+		if names[c.Name] {
+			return fmt.Errorf("spec.Template.Spec.ExtraContainers name %q is not unique", c.Name)
+		}
+		names[c.Name] = true
+		if c.Image == "" {
+			return fmt.Errorf("spec.Template.Spec.ExtraContainers[%q] must specify an image", c.Name)
+		}
+		errs := k8svalidation.ValidateResourceRequirements(&c.Resources, field.NewPath("spec", "template", "spec", "extraContainers"))
+		if err := errs.ToAggregate(); err != nil {
+			return fmt.Errorf("spec.Template.Spec.ExtraContainers[%q] is invalid: %w", c.Name, err)
+		}
+	}
+	return nil
+}
+
 // ValidateCalicoNodeWindowsDaemonSetContainer validates the given container is a valid calico-node-windows DaemonSet container.
 func ValidateCalicoNodeWindowsDaemonSetContainer(container corev1.Container) error {
 	errs := k8svalidation.ValidateResourceRequirements(&container.Resources, field.NewPath("spec", "template", "spec", "containers"))
@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/tigera/operator/pkg/common/k8svalidation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateGuardianDeploymentContainer validates the given container is a valid guardian Deployment container.
+func ValidateGuardianDeploymentContainer(container corev1.Container) error {
+	errs := k8svalidation.ValidateResourceRequirements(&container.Resources, field.NewPath("spec", "template", "spec", "containers"))
+	return errs.ToAggregate()
+}
+
+// ValidateGuardianDeploymentInitContainer validates the given container is a valid guardian Deployment init container.
+func ValidateGuardianDeploymentInitContainer(container corev1.Container) error {
+	errs := k8svalidation.ValidateResourceRequirements(&container.Resources, field.NewPath("spec", "template", "spec", "initContainers"))
+	return errs.ToAggregate()
+}
+
+// ValidateGuardianDeploymentExtraInitContainers validates the given user-provided init containers. reservedNames
+// are the names of the containers managed by the operator, which the user-provided containers must not reuse.
+func ValidateGuardianDeploymentExtraInitContainers(containers []corev1.Container, reservedNames ...string) error {
+	names := map[string]bool{}
+	for _, n := range reservedNames {
+		names[n] = true
+	}
+	for _, c := range containers {
+		if errs := validation.IsDNS1123Label(c.Name); len(errs) > 0 {
+			return fmt.Errorf("spec.Template.Spec.ExtraInitContainers name %q is invalid: %v", c.Name, errs)
+		}
+		if names[c.Name] {
+			return fmt.Errorf("spec.Template.Spec.ExtraInitContainers name %q is not unique", c.Name)
+		}
+		names[c.Name] = true
+		if c.Image == "" {
+			return fmt.Errorf("spec.Template.Spec.ExtraInitContainers[%q] must specify an image", c.Name)
+		}
+		errs := k8svalidation.ValidateResourceRequirements(&c.Resources, field.NewPath("spec", "template", "spec", "extraInitContainers"))
+		if err := errs.ToAggregate(); err != nil {
+			return fmt.Errorf("spec.Template.Spec.ExtraInitContainers[%q] is invalid: %w", c.Name, err)
+		}
+	}
+	return nil
+}
@@ -46,6 +46,11 @@ func ValidateReplicatedPodResourceOverrides(overrides components.ReplicatedPodRe
 			return fmt.Errorf("spec.MinReadySeconds must be greater than or equal to 0")
 		}
 	}
+	if o, ok := overrides.(components.RevisionHistoryLimitOverrides); ok {
+		if limit := o.GetRevisionHistoryLimit(); limit != nil && *limit < 0 {
+			return fmt.Errorf("spec.RevisionHistoryLimit must be greater than or equal to 0")
+		}
+	}
 	if md := overrides.GetPodTemplateMetadata(); md != nil {
 		if err := validateMetadata(md); err != nil {
 			return fmt.Errorf("spec.Template.Metadata is invalid: %w", err)
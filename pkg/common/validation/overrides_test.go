@@ -27,6 +27,7 @@ import (
 
 	opv1 "github.com/tigera/operator/api/v1"
 	node "github.com/tigera/operator/pkg/common/validation/calico-node"
+	guardian "github.com/tigera/operator/pkg/common/validation/guardian"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -263,3 +264,28 @@ var _ = Describe("Test overrides validation (TyphaDeployment)", func() {
 		Expect(err.Error()).Should(HavePrefix("spec.Template.Spec.TopologySpreadConstraints is invalid: spec.template.spec.topologySpreadConstraints[0].topologyKey: Required value: can not be empty"))
 	})
 })
+
+var _ = Describe("Test overrides validation (GuardianDeployment)", func() {
+	var overrides *opv1.GuardianDeployment
+
+	BeforeEach(func() {
+		overrides = &opv1.GuardianDeployment{
+			Spec: &opv1.GuardianDeploymentSpec{},
+		}
+	})
+
+	It("should accept a revisionHistoryLimit of 0", func() {
+		var limit int32 = 0
+		overrides.Spec.RevisionHistoryLimit = &limit
+		err := ValidateReplicatedPodResourceOverrides(overrides, guardian.ValidateGuardianDeploymentContainer, guardian.ValidateGuardianDeploymentInitContainer)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should return an error if revisionHistoryLimit is negative", func() {
+		var limit int32 = -1
+		overrides.Spec.RevisionHistoryLimit = &limit
+		err := ValidateReplicatedPodResourceOverrides(overrides, guardian.ValidateGuardianDeploymentContainer, guardian.ValidateGuardianDeploymentInitContainer)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("spec.RevisionHistoryLimit must be greater than or equal to 0"))
+	})
+})
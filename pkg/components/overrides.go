@@ -58,3 +58,10 @@ type ReplicatedPodResourceOverrides interface {
 	// GetPriorityClassName() returns the value used to override a DaemonSet/Deployment's priorityClassName.
 	GetPriorityClassName() string
 }
+
+// RevisionHistoryLimitOverrides is implemented by Deployment overrides that also allow the Deployment's
+// revisionHistoryLimit to be overridden.
+type RevisionHistoryLimitOverrides interface {
+	// GetRevisionHistoryLimit returns the value used to override a Deployment's revisionHistoryLimit.
+	GetRevisionHistoryLimit() *int32
+}
@@ -16,7 +16,9 @@ package apiserver
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -97,6 +99,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions) *ReconcileAPISe
 		enterpriseCRDsExist: opts.EnterpriseCRDExists,
 		status:              status.New(mgr.GetClient(), "apiserver", opts.KubernetesVersion),
 		clusterDomain:       opts.ClusterDomain,
+		auditLog:            opts.AuditLog,
 		usePSP:              opts.UsePSP,
 		tierWatchReady:      &utils.ReadyFlag{},
 		multiTenant:         opts.MultiTenant,
@@ -149,6 +152,10 @@ func add(c ctrlruntime.Controller, r *ReconcileAPIServer) error {
 		if err != nil {
 			return fmt.Errorf("apiserver-controller failed to watch resource: %w", err)
 		}
+
+		if err = utils.AddExternalDexRootCAWatch(c, r.client, &handler.EnqueueRequestForObject{}); err != nil {
+			return fmt.Errorf("apiserver-controller failed to watch the external Dex CA secret: %w", err)
+		}
 	}
 
 	// Watch for the namespace(s) managed by this controller.
@@ -175,6 +182,11 @@ func add(c ctrlruntime.Controller, r *ReconcileAPIServer) error {
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("apiserver-controller failed to watch apiserver Tigerastatus: %w", err)
 	}
+	// Perform periodic reconciliation. This makes sure we spot changes to a user-provided serving certificate secret,
+	// whose name is not known when the watches are set up.
+	if err = utils.AddPeriodicReconcile(c, utils.PeriodicReconcileTime, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("apiserver-controller failed to create periodic reconcile watch: %w", err)
+	}
 	log.V(5).Info("Controller created and Watches setup")
 	return nil
 }
@@ -192,6 +204,7 @@ type ReconcileAPIServer struct {
 	enterpriseCRDsExist bool
 	status              status.StatusManager
 	clusterDomain       string
+	auditLog            bool
 	usePSP              bool
 	tierWatchReady      *utils.ReadyFlag
 	multiTenant         bool
@@ -266,10 +279,24 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 
 	// We need separate certificates for OSS vs Enterprise.
 	secretName := render.ProjectCalicoAPIServerTLSSecretName(installationSpec.Variant)
-	tlsSecret, err := certificateManager.GetOrCreateKeyPair(r.client, secretName, common.OperatorNamespace(), dns.GetServiceDNSNames(render.ProjectCalicoAPIServerServiceName(installationSpec.Variant), rmeta.APIServerNamespace(installationSpec.Variant), r.clusterDomain))
-	if err != nil {
-		r.status.SetDegraded(operatorv1.ResourceCreateError, "Unable to get or create tls key pair", err, reqLogger)
-		return reconcile.Result{}, err
+	var tlsSecret certificatemanagement.KeyPairInterface
+	if instance.Spec.TLS != nil && instance.Spec.TLS.SecretName != "" {
+		// The user has provided their own serving certificate. Copy it into the API server namespace under the
+		// usual name rather than issuing one from the operator CA.
+		tlsSecret, err = getUserProvidedKeyPair(ctx, r.client, instance.Spec.TLS.SecretName, secretName, installationSpec.Variant)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceValidationError, "User-provided API server TLS secret is invalid", err, reqLogger)
+			return reconcile.Result{}, err
+		} else if tlsSecret == nil {
+			r.status.SetDegraded(operatorv1.ResourceNotFound, fmt.Sprintf("Waiting for user-provided API server TLS secret %s", instance.Spec.TLS.SecretName), nil, reqLogger)
+			return reconcile.Result{}, nil
+		}
+	} else {
+		tlsSecret, err = certificateManager.GetOrCreateKeyPair(r.client, secretName, common.OperatorNamespace(), dns.GetServiceDNSNames(render.ProjectCalicoAPIServerServiceName(installationSpec.Variant), rmeta.APIServerNamespace(installationSpec.Variant), r.clusterDomain))
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceCreateError, "Unable to get or create tls key pair", err, reqLogger)
+			return reconcile.Result{}, err
+		}
 	}
 
 	certificateManager.AddToStatusManager(r.status, ns)
@@ -364,7 +391,7 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 	}
 
 	// Create a component handler to manage the rendered component.
-	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance, utils.WithInstallation(installationSpec), utils.WithAuditLog(r.auditLog, "apiserver-controller"))
 
 	// Render the desired objects from the CRD and create or update them.
 	reqLogger.V(3).Info("rendering components")
@@ -444,6 +471,7 @@ func (r *ReconcileAPIServer) Reconcile(ctx context.Context, request reconcile.Re
 			Openshift:                   r.provider == operatorv1.ProviderOpenShift,
 			Installation:                installationSpec,
 			KeyValidatorConfig:          keyValidatorConfig,
+			Authentication:              authenticationCR,
 			ServerCertSecret:            packetCaptureCertSecret,
 			ClusterDomain:               r.clusterDomain,
 			ManagementClusterConnection: managementClusterConnection,
@@ -511,6 +539,83 @@ func validateAPIServerResource(instance *operatorv1.APIServer) error {
 			return fmt.Errorf("APIServer spec.APIServerDeployment is not valid: %w", err)
 		}
 	}
+	if t := instance.Spec.TLS; t != nil {
+		if v := t.MinVersion; v != nil && *v != operatorv1.TLSVersion12 && *v != operatorv1.TLSVersion13 {
+			return fmt.Errorf("APIServer spec.tls.minVersion %q is not supported, should be one of VersionTLS12, VersionTLS13", *v)
+		}
+		if len(t.CipherSuites) > 0 {
+			if t.MinVersion != nil && *t.MinVersion == operatorv1.TLSVersion13 {
+				return fmt.Errorf("APIServer spec.tls.cipherSuites cannot be combined with a minVersion of VersionTLS13, the TLS 1.3 cipher suites are not configurable")
+			}
+			// Only the TLS 1.2 cipher suites that Go does not consider insecure are accepted.
+			supported := map[string]bool{}
+			for _, cs := range tls.CipherSuites() {
+				for _, v := range cs.SupportedVersions {
+					if v == tls.VersionTLS12 {
+						supported[cs.Name] = true
+					}
+				}
+			}
+			for _, name := range t.CipherSuites {
+				if !supported[name] {
+					return fmt.Errorf("APIServer spec.tls.cipherSuites contains unsupported cipher suite %q", name)
+				}
+			}
+		}
+	}
+	if t := instance.Spec.RequestTimeout; t != nil && t.Duration <= 0 {
+		return fmt.Errorf("APIServer spec.requestTimeout must be positive, got %s", t.Duration)
+	}
+	if n := instance.Spec.MaxRequestsInflight; n != nil && *n <= 0 {
+		return fmt.Errorf("APIServer spec.maxRequestsInflight must be positive, got %d", *n)
+	}
+	if n := instance.Spec.MaxMutatingRequestsInflight; n != nil && *n <= 0 {
+		return fmt.Errorf("APIServer spec.maxMutatingRequestsInflight must be positive, got %d", *n)
+	}
+	return nil
+}
+
+// getUserProvidedKeyPair loads and validates the user-provided API server serving certificate from the named secret in the
+// operator namespace. The returned KeyPair is named keyPairName, so that it is rendered in place of the operator-issued
+// certificate. Returns nil if the secret does not exist.
+func getUserProvidedKeyPair(ctx context.Context, cli client.Client, userSecretName, keyPairName string, variant operatorv1.ProductVariant) (certificatemanagement.KeyPairInterface, error) {
+	secret, err := utils.GetSecret(ctx, cli, userSecretName, common.OperatorNamespace())
+	if err != nil || secret == nil {
+		return nil, err
+	}
+	serviceDNSName := fmt.Sprintf("%s.%s.svc", render.ProjectCalicoAPIServerServiceName(variant), rmeta.APIServerNamespace(variant))
+	if err = validateAPIServerTLSSecret(secret, serviceDNSName); err != nil {
+		return nil, err
+	}
+	keyPEM, certPEM := certificatemanagement.GetKeyCertPEM(secret)
+	return &certificatemanagement.KeyPair{
+		Name:           keyPairName,
+		Namespace:      common.OperatorNamespace(),
+		PrivateKeyPEM:  keyPEM,
+		CertificatePEM: certPEM,
+	}, nil
+}
+
+// validateAPIServerTLSSecret verifies that the secret contains a matching certificate and private key, and that the
+// certificate is currently valid for the given DNS name.
+func validateAPIServerTLSSecret(secret *corev1.Secret, dnsName string) error {
+	keyPEM, certPEM := certificatemanagement.GetKeyCertPEM(secret)
+	if len(keyPEM) == 0 || len(certPEM) == 0 {
+		return fmt.Errorf("secret %s/%s must contain %s and %s", secret.Namespace, secret.Name, corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+	}
+	if _, err := tls.X509KeyPair(certPEM, keyPEM); err != nil {
+		return fmt.Errorf("secret %s/%s does not contain a valid certificate and key pair: %w", secret.Namespace, secret.Name, err)
+	}
+	cert, err := certificatemanagement.ParseCertificate(certPEM)
+	if err != nil {
+		return fmt.Errorf("secret %s/%s does not contain a valid certificate: %w", secret.Namespace, secret.Name, err)
+	}
+	if now := time.Now(); now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return fmt.Errorf("secret %s/%s is not valid at this date", secret.Namespace, secret.Name)
+	}
+	if err = cert.VerifyHostname(dnsName); err != nil {
+		return fmt.Errorf("secret %s/%s is not valid for the API server: %w", secret.Namespace, secret.Name, err)
+	}
 	return nil
 }
 
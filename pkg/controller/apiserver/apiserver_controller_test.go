@@ -22,9 +22,12 @@ import (
 	kerror "k8s.io/apimachinery/pkg/api/errors"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/openshift/library-go/pkg/crypto"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
@@ -46,6 +49,7 @@ import (
 	"github.com/tigera/operator/pkg/controller/utils"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
 	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/ptr"
 	"github.com/tigera/operator/pkg/render"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 	"github.com/tigera/operator/pkg/render/common/secret"
@@ -357,6 +361,91 @@ var _ = Describe("apiserver controller tests", func() {
 			Expect(packetCaptureSecret2.GetOwnerReferences()).To(HaveLen(0))
 		})
 
+		Context("with a user-provided serving certificate", func() {
+			var r ReconcileAPIServer
+			var cryptoCA *crypto.CA
+
+			setUserSecretName := func(name string) {
+				apiserver := &operatorv1.APIServer{}
+				Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, apiserver)).NotTo(HaveOccurred())
+				apiserver.Spec.TLS = &operatorv1.APIServerTLS{SecretName: name}
+				Expect(cli.Update(ctx, apiserver)).NotTo(HaveOccurred())
+			}
+
+			BeforeEach(func() {
+				Expect(cli.Create(ctx, installation)).To(BeNil())
+				var err error
+				cryptoCA, err = tls.MakeCA("corporate-ca")
+				Expect(err).NotTo(HaveOccurred())
+				r = ReconcileAPIServer{
+					client:              cli,
+					scheme:              scheme,
+					provider:            operatorv1.ProviderNone,
+					enterpriseCRDsExist: true,
+					status:              mockStatus,
+					clusterDomain:       dns.DefaultClusterDomain,
+					tierWatchReady:      ready,
+				}
+			})
+
+			It("should serve with the user-provided certificate", func() {
+				userSecret, err := secret.CreateTLSSecret(cryptoCA, "corporate-apiserver-cert", common.OperatorNamespace(), corev1.TLSPrivateKeyKey, corev1.TLSCertKey, time.Hour, nil, dns.GetServiceDNSNames(render.ProjectCalicoAPIServerServiceName(operatorv1.TigeraSecureEnterprise), "tigera-system", dns.DefaultClusterDomain)...)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cli.Create(ctx, userSecret)).NotTo(HaveOccurred())
+				setUserSecretName("corporate-apiserver-cert")
+
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				// The certificate is copied into the API server namespace under the usual name.
+				secretName := render.ProjectCalicoAPIServerTLSSecretName(operatorv1.TigeraSecureEnterprise)
+				servingSecret := &corev1.Secret{}
+				Expect(cli.Get(ctx, client.ObjectKey{Namespace: "tigera-system", Name: secretName}, servingSecret)).ShouldNot(HaveOccurred())
+				Expect(servingSecret.Data[corev1.TLSCertKey]).To(Equal(userSecret.Data[corev1.TLSCertKey]))
+				Expect(servingSecret.Data[corev1.TLSPrivateKeyKey]).To(Equal(userSecret.Data[corev1.TLSPrivateKeyKey]))
+
+				// The operator does not issue its own certificate.
+				Expect(cli.Get(ctx, client.ObjectKey{Namespace: common.OperatorNamespace(), Name: secretName}, &corev1.Secret{})).To(HaveOccurred())
+			})
+
+			It("should degrade if the user-provided secret does not exist", func() {
+				setUserSecretName("corporate-apiserver-cert")
+				mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, "Waiting for user-provided API server TLS secret corporate-apiserver-cert", mock.Anything, mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, "Waiting for user-provided API server TLS secret corporate-apiserver-cert", mock.Anything, mock.Anything)
+			})
+
+			It("should reject a user-provided certificate that is not valid for the API server", func() {
+				userSecret, err := secret.CreateTLSSecret(cryptoCA, "corporate-apiserver-cert", common.OperatorNamespace(), corev1.TLSPrivateKeyKey, corev1.TLSCertKey, time.Hour, nil, "example.com")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cli.Create(ctx, userSecret)).NotTo(HaveOccurred())
+				setUserSecretName("corporate-apiserver-cert")
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "User-provided API server TLS secret is invalid", mock.Anything, mock.Anything).Return()
+
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).Should(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "User-provided API server TLS secret is invalid", mock.Anything, mock.Anything)
+			})
+
+			It("should reject a user-provided certificate with a mismatched key", func() {
+				dnsNames := dns.GetServiceDNSNames(render.ProjectCalicoAPIServerServiceName(operatorv1.TigeraSecureEnterprise), "tigera-system", dns.DefaultClusterDomain)
+				userSecret, err := secret.CreateTLSSecret(cryptoCA, "corporate-apiserver-cert", common.OperatorNamespace(), corev1.TLSPrivateKeyKey, corev1.TLSCertKey, time.Hour, nil, dnsNames...)
+				Expect(err).NotTo(HaveOccurred())
+				otherSecret, err := secret.CreateTLSSecret(cryptoCA, "other", common.OperatorNamespace(), corev1.TLSPrivateKeyKey, corev1.TLSCertKey, time.Hour, nil, dnsNames...)
+				Expect(err).NotTo(HaveOccurred())
+				userSecret.Data[corev1.TLSPrivateKeyKey] = otherSecret.Data[corev1.TLSPrivateKeyKey]
+				Expect(cli.Create(ctx, userSecret)).NotTo(HaveOccurred())
+				setUserSecretName("corporate-apiserver-cert")
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "User-provided API server TLS secret is invalid", mock.Anything, mock.Anything).Return()
+
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).Should(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceValidationError, "User-provided API server TLS secret is invalid", mock.Anything, mock.Anything)
+			})
+		})
+
 		It("should add OwnerReference apiserver and packetcapture TLS cert operator managed secrets", func() {
 			Expect(cli.Create(ctx, installation)).To(BeNil())
 
@@ -908,4 +997,48 @@ var _ = Describe("apiserver controller tests", func() {
 			})
 		})
 	})
+
+	Context("validation", func() {
+		tls12 := operatorv1.TLSVersion12
+		tls13 := operatorv1.TLSVersion13
+		tls11 := operatorv1.TLSVersion("VersionTLS11")
+
+		DescribeTable("should validate the TLS settings", func(tlsSpec *operatorv1.APIServerTLS, expectedErr string) {
+			err := validateAPIServerResource(&operatorv1.APIServer{Spec: operatorv1.APIServerSpec{TLS: tlsSpec}})
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			}
+		},
+			Entry("no TLS settings", nil, ""),
+			Entry("TLS 1.2 minimum version", &operatorv1.APIServerTLS{MinVersion: &tls12}, ""),
+			Entry("TLS 1.3 minimum version", &operatorv1.APIServerTLS{MinVersion: &tls13}, ""),
+			Entry("unsupported minimum version", &operatorv1.APIServerTLS{MinVersion: &tls11}, "spec.tls.minVersion"),
+			Entry("TLS 1.2 cipher suites", &operatorv1.APIServerTLS{MinVersion: &tls12, CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"}}, ""),
+			Entry("an unknown cipher suite", &operatorv1.APIServerTLS{CipherSuites: []string{"TLS_MADE_UP"}}, "unsupported cipher suite \"TLS_MADE_UP\""),
+			Entry("an insecure cipher suite", &operatorv1.APIServerTLS{CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}}, "unsupported cipher suite"),
+			Entry("a TLS 1.3 cipher suite", &operatorv1.APIServerTLS{CipherSuites: []string{"TLS_AES_128_GCM_SHA256"}}, "unsupported cipher suite"),
+			Entry("cipher suites with a TLS 1.3 minimum version", &operatorv1.APIServerTLS{MinVersion: &tls13, CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}}, "cannot be combined"),
+		)
+
+		DescribeTable("should validate the request limits", func(spec operatorv1.APIServerSpec, expectedErr string) {
+			err := validateAPIServerResource(&operatorv1.APIServer{Spec: spec})
+			if expectedErr == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expectedErr))
+			}
+		},
+			Entry("no request limits", operatorv1.APIServerSpec{}, ""),
+			Entry("valid request limits", operatorv1.APIServerSpec{
+				RequestTimeout:              &metav1.Duration{Duration: 2 * time.Minute},
+				MaxRequestsInflight:         ptr.Int32ToPtr(800),
+				MaxMutatingRequestsInflight: ptr.Int32ToPtr(400),
+			}, ""),
+			Entry("zero request timeout", operatorv1.APIServerSpec{RequestTimeout: &metav1.Duration{}}, "APIServer spec.requestTimeout must be positive, got 0s"),
+			Entry("negative max requests in flight", operatorv1.APIServerSpec{MaxRequestsInflight: ptr.Int32ToPtr(-1)}, "APIServer spec.maxRequestsInflight must be positive, got -1"),
+			Entry("zero max mutating requests in flight", operatorv1.APIServerSpec{MaxMutatingRequestsInflight: ptr.Int32ToPtr(0)}, "APIServer spec.maxMutatingRequestsInflight must be positive, got 0"),
+		)
+	})
 })
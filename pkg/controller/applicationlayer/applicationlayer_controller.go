@@ -88,6 +88,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, licenseAPIReady
 		provider:        opts.DetectedProvider,
 		status:          status.New(mgr.GetClient(), "applicationlayer", opts.KubernetesVersion),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		licenseAPIReady: licenseAPIReady,
 		usePSP:          opts.UsePSP,
 	}
@@ -160,6 +161,7 @@ type ReconcileApplicationLayer struct {
 	provider        operatorv1.Provider
 	status          status.StatusManager
 	clusterDomain   string
+	auditLog        bool
 	licenseAPIReady *utils.ReadyFlag
 	usePSP          bool
 }
@@ -283,7 +285,7 @@ func (r *ReconcileApplicationLayer) Reconcile(ctx context.Context, request recon
 	}
 	component := applicationlayer.ApplicationLayer(config)
 
-	ch := utils.NewComponentHandler(log, r.client, r.scheme, instance)
+	ch := utils.NewComponentHandler(log, r.client, r.scheme, instance, utils.WithInstallation(installation), utils.WithAuditLog(r.auditLog, "applicationlayer-controller"))
 
 	if err = imageset.ApplyImageSet(ctx, r.client, variant, component); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error with images from ImageSet", err, reqLogger)
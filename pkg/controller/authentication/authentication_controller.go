@@ -17,6 +17,9 @@ package authentication
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strings"
+	"time"
 
 	"k8s.io/client-go/kubernetes"
 
@@ -27,6 +30,8 @@ import (
 	"github.com/go-ldap/ldap"
 	oprv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
+	commonvalidation "github.com/tigera/operator/pkg/common/validation"
+	dex "github.com/tigera/operator/pkg/common/validation/dex"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/status"
@@ -42,6 +47,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -58,6 +64,11 @@ const (
 	defaultNameAttribute string = "uid"
 
 	ResourceName = "authentication"
+
+	// Rotating the Dex signing keys more often than the 15m lifetime of the ID tokens it issues gains nothing,
+	// while keeping a key for longer than 90 days defeats the purpose of rotating it.
+	minSigningKeyRotationPeriod = 15 * time.Minute
+	maxSigningKeyRotationPeriod = 90 * 24 * time.Hour
 )
 
 // Add creates a new authentication Controller and adds it to the Manager. The Manager will set fields on the Controller
@@ -84,12 +95,13 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	}
 
 	go utils.WaitToAddTierWatch(networkpolicy.TigeraComponentTierName, c, k8sClient, log, tierWatchReady)
+	// The Dex namespace is configurable on the Authentication, so Dex's policies are matched by name in any namespace.
 	go utils.WaitToAddNetworkPolicyWatches(c, k8sClient, log, []types.NamespacedName{
-		{Name: render.DexPolicyName, Namespace: render.DexNamespace},
-		{Name: networkpolicy.TigeraComponentDefaultDenyPolicyName, Namespace: render.DexNamespace},
+		{Name: render.DexPolicyName},
+		{Name: networkpolicy.TigeraComponentDefaultDenyPolicyName},
 	})
 
-	// Watch for changes to the dex namespace.
+	// Watch for changes to namespaces, so that a user provided dex namespace is picked up once it is created.
 	if err = c.WatchObject(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: render.DexObjectName}}, &handler.EnqueueRequestForObject{}); err != nil {
 		return fmt.Errorf("%s failed to watch dex namespace: %w", controllerName, err)
 	}
@@ -105,6 +117,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, tierWatchReady
 		provider:       opts.DetectedProvider,
 		status:         status.New(mgr.GetClient(), "authentication", opts.KubernetesVersion),
 		clusterDomain:  opts.ClusterDomain,
+		auditLog:       opts.AuditLog,
 		tierWatchReady: tierWatchReady,
 		usePSP:         opts.UsePSP,
 		multiTenant:    opts.MultiTenant,
@@ -129,7 +142,8 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 		return fmt.Errorf("%s failed to watch resource: %w", controllerName, err)
 	}
 
-	for _, namespace := range []string{common.OperatorNamespace(), render.DexNamespace} {
+	// An empty namespace matches the secrets in whichever namespace Dex is configured to run in.
+	for _, namespace := range []string{common.OperatorNamespace(), ""} {
 		for _, secretName := range []string{
 			render.DexTLSSecretName, render.OIDCSecretName, render.OpenshiftSecretName,
 			render.DexObjectName, certificatemanagement.CASecretName,
@@ -140,6 +154,10 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 		}
 	}
 
+	if err = utils.AddExternalDexRootCAWatch(c, mgr.GetClient(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("%s failed to watch the external Dex CA secret: %w", controllerName, err)
+	}
+
 	if err = imageset.AddImageSetWatch(c); err != nil {
 		return fmt.Errorf("%s failed to watch ImageSet: %w", controllerName, err)
 	}
@@ -165,6 +183,7 @@ type ReconcileAuthentication struct {
 	tierWatchReady *utils.ReadyFlag
 	usePSP         bool
 	multiTenant    bool
+	auditLog       bool
 }
 
 // Reconcile the cluster state with the Authentication object that is found in the cluster.
@@ -236,13 +255,14 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, nil
 	}
 
-	// Make sure the tigera-dex namespace exists, before rendering any objects there.
-	if err := r.client.Get(ctx, client.ObjectKey{Name: render.DexObjectName}, &corev1.Namespace{}); err != nil {
+	// Make sure the dex namespace exists, before rendering any objects there.
+	dexNamespace := render.GetDexNamespace(authentication)
+	if err := r.client.Get(ctx, client.ObjectKey{Name: dexNamespace}, &corev1.Namespace{}); err != nil {
 		if errors.IsNotFound(err) {
-			r.status.SetDegraded(oprv1.ResourceNotFound, "Waiting for namespace tigera-dex to be created", err, reqLogger)
+			r.status.SetDegraded(oprv1.ResourceNotFound, fmt.Sprintf("Waiting for namespace %s to be created", dexNamespace), err, reqLogger)
 			return reconcile.Result{}, nil
 		} else {
-			r.status.SetDegraded(oprv1.ResourceReadError, "Error querying tigera-dex namespace", err, reqLogger)
+			r.status.SetDegraded(oprv1.ResourceReadError, fmt.Sprintf("Error querying %s namespace", dexNamespace), err, reqLogger)
 			return reconcile.Result{}, err
 		}
 	}
@@ -281,7 +301,7 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 		r.status.SetDegraded(oprv1.ResourceCreateError, "Unable to create the Tigera CA", err, reqLogger)
 		return reconcile.Result{}, err
 	}
-	dnsNames := dns.GetServiceDNSNames(render.DexObjectName, render.DexNamespace, r.clusterDomain)
+	dnsNames := dns.GetServiceDNSNames(render.DexObjectName, dexNamespace, r.clusterDomain)
 	tlsKeyPair, err := certificateManager.GetOrCreateKeyPair(r.client, render.DexTLSSecretName, common.OperatorNamespace(), dnsNames)
 	if err != nil {
 		r.status.SetDegraded(oprv1.ResourceReadError, "Unable to get or create tls key pair", err, reqLogger)
@@ -295,11 +315,20 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
-	// Dex will be configured with the contents of this secret, such as clientID and clientSecret.
-	idpSecret, err := utils.GetIDPSecret(ctx, r.client, authentication)
-	if err != nil {
-		r.status.SetDegraded(oprv1.ResourceValidationError, "Invalid or missing identity provider secret", err, reqLogger)
-		return reconcile.Result{}, err
+	// Dex will be configured with the contents of this secret, such as clientID and clientSecret. When an external
+	// Dex is used, the identity provider is configured there instead, and we only need to trust its CA.
+	var idpSecret *corev1.Secret
+	if authentication.Spec.ExternalDex != nil {
+		if _, err := utils.GetExternalDexRootCA(ctx, r.client, authentication); err != nil {
+			r.status.SetDegraded(oprv1.ResourceValidationError, "Invalid or missing external Dex CA secret", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+	} else {
+		idpSecret, err = utils.GetIDPSecret(ctx, r.client, authentication)
+		if err != nil {
+			r.status.SetDegraded(oprv1.ResourceValidationError, "Invalid or missing identity provider secret", err, reqLogger)
+			return reconcile.Result{}, err
+		}
 	}
 
 	dexSecret := &corev1.Secret{}
@@ -325,7 +354,7 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 	dexCfg := render.NewDexConfig(install.CertificateManagement, authentication, dexSecret, idpSecret, r.clusterDomain)
 
 	// Create a component handler to manage the rendered component.
-	hlr := utils.NewComponentHandler(log, r.client, r.scheme, authentication)
+	hlr := utils.NewComponentHandler(log, r.client, r.scheme, authentication, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, controllerName))
 
 	dexComponentCfg := &render.DexComponentConfiguration{
 		PullSecrets:    pullSecrets,
@@ -352,7 +381,7 @@ func (r *ReconcileAuthentication) Reconcile(ctx context.Context, request reconci
 	components := []render.Component{
 		component,
 		rcertificatemanagement.CertificateManagement(&rcertificatemanagement.Config{
-			Namespace:       render.DexNamespace,
+			Namespace:       dexNamespace,
 			ServiceAccounts: []string{render.DexObjectName},
 			KeyPairOptions: []rcertificatemanagement.KeyPairOption{
 				rcertificatemanagement.NewKeyPairOption(tlsKeyPair, true, true),
@@ -406,6 +435,35 @@ func updateAuthenticationWithDefaults(authentication *oprv1.Authentication) {
 	}
 }
 
+// reservedPrefix is the prefix Kubernetes reserves for its own users and groups. Prefixing users or groups from the
+// identity provider with it would let them impersonate system identities.
+const reservedPrefix = "system:"
+
+// validatePrefixes checks that the username and groups prefixes do not use the prefix Kubernetes reserves for
+// system users and groups, and that users and groups from the identity provider can be told apart.
+func validatePrefixes(authentication *oprv1.Authentication) error {
+	usernamePrefix, groupsPrefix := authentication.Spec.UsernamePrefix, authentication.Spec.GroupsPrefix
+	if oidc := authentication.Spec.OIDC; oidc != nil {
+		if usernamePrefix == "" {
+			usernamePrefix = oidc.UsernamePrefix
+		}
+		if groupsPrefix == "" {
+			groupsPrefix = oidc.GroupsPrefix
+		}
+	}
+
+	if strings.HasPrefix(usernamePrefix, reservedPrefix) {
+		return fmt.Errorf("Authentication.Spec.UsernamePrefix %q must not start with the reserved prefix %q", usernamePrefix, reservedPrefix)
+	}
+	if strings.HasPrefix(groupsPrefix, reservedPrefix) {
+		return fmt.Errorf("Authentication.Spec.GroupsPrefix %q must not start with the reserved prefix %q", groupsPrefix, reservedPrefix)
+	}
+	if usernamePrefix != "" && usernamePrefix == groupsPrefix {
+		return fmt.Errorf("Authentication.Spec.UsernamePrefix and Authentication.Spec.GroupsPrefix must not be the same, got %q", usernamePrefix)
+	}
+	return nil
+}
+
 // validateAuthentication makes sure that the authentication spec is ready for use.
 func validateAuthentication(authentication *oprv1.Authentication, multiTenant bool) error {
 	oidc := authentication.Spec.OIDC
@@ -422,12 +480,25 @@ func validateAuthentication(authentication *oprv1.Authentication, multiTenant bo
 		numConnectors++
 	}
 
-	if numConnectors == 0 {
+	if ext := authentication.Spec.ExternalDex; ext != nil {
+		if numConnectors > 0 {
+			return fmt.Errorf("an identity provider connector cannot be combined with Authentication.Spec.ExternalDex, please configure it in the external Dex instead")
+		}
+		if u, err := url.Parse(ext.IssuerURL); err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("Authentication.Spec.ExternalDex.IssuerURL %q must be a valid https URL", ext.IssuerURL)
+		}
+	} else if numConnectors == 0 {
 		return fmt.Errorf("no identity provider connector was specified, please add a connector to the Authentication spec")
 	} else if numConnectors > 1 {
 		return fmt.Errorf("multiple identity provider connectors were specified, but only 1 is allowed in the Authentication spec")
 	}
 
+	if ns := authentication.Spec.DexNamespace; ns != "" {
+		if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+			return fmt.Errorf("invalid Authentication.Spec.DexNamespace %q: %s", ns, strings.Join(errs, ", "))
+		}
+	}
+
 	// If the user has specified the deprecated and the new prefix field, but with different values, we cannot proceed.
 	if oidc != nil {
 		if multiTenant && authentication.Spec.OIDC.Type != oprv1.OIDCTypeTigera {
@@ -452,6 +523,10 @@ func validateAuthentication(authentication *oprv1.Authentication, multiTenant bo
 
 	}
 
+	if err := validatePrefixes(authentication); err != nil {
+		return err
+	}
+
 	if ldp != nil {
 		if _, err := ldap.ParseDN(ldp.UserSearch.BaseDN); err != nil {
 			return fmt.Errorf("invalid dn for LDAP user search: %w", err)
@@ -473,5 +548,21 @@ func validateAuthentication(authentication *oprv1.Authentication, multiTenant bo
 		}
 	}
 
+	if p := authentication.Spec.SigningKeyRotationPeriod; p != nil {
+		if authentication.Spec.ExternalDex != nil {
+			return fmt.Errorf("Authentication.Spec.SigningKeyRotationPeriod cannot be combined with Authentication.Spec.ExternalDex, please configure it in the external Dex instead")
+		}
+		if p.Duration < minSigningKeyRotationPeriod || p.Duration > maxSigningKeyRotationPeriod {
+			return fmt.Errorf("Authentication.Spec.SigningKeyRotationPeriod must be between %s and %s, got %s", minSigningKeyRotationPeriod, maxSigningKeyRotationPeriod, p.Duration)
+		}
+	}
+
+	// Verify the DexDeployment overrides, if specified, are valid.
+	if d := authentication.Spec.DexDeployment; d != nil {
+		if err := commonvalidation.ValidateReplicatedPodResourceOverrides(d, dex.ValidateDexDeploymentContainer, dex.ValidateDexDeploymentInitContainer); err != nil {
+			return fmt.Errorf("Authentication spec.DexDeployment is not valid: %w", err)
+		}
+	}
+
 	return nil
 }
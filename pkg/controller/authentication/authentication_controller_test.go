@@ -43,6 +43,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -169,7 +170,7 @@ var _ = Describe("authentication controller tests", func() {
 				},
 			}
 			Expect(cli.Create(ctx, ts)).NotTo(HaveOccurred())
-			r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false}
+			r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false, false}
 			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{
 				Name:      "authentication",
 				Namespace: "",
@@ -194,7 +195,7 @@ var _ = Describe("authentication controller tests", func() {
 
 			Expect(cli.Create(ctx, ts)).NotTo(HaveOccurred())
 
-			r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false}
+			r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false, false}
 			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{
 				Name:      "authentication",
 				Namespace: "",
@@ -235,7 +236,7 @@ var _ = Describe("authentication controller tests", func() {
 				},
 			}
 			Expect(cli.Create(ctx, ts)).NotTo(HaveOccurred())
-			r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false}
+			r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false, false}
 			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{
 				Name:      "authentication",
 				Namespace: "",
@@ -295,7 +296,7 @@ var _ = Describe("authentication controller tests", func() {
 				},
 			}
 			Expect(cli.Create(ctx, ts)).NotTo(HaveOccurred())
-			r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false}
+			r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false, false}
 			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{
 				Name:      "authentication",
 				Namespace: "",
@@ -340,7 +341,7 @@ var _ = Describe("authentication controller tests", func() {
 			Expect(cli.Create(ctx, auth)).ToNot(HaveOccurred())
 
 			// Reconcile
-			r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false}
+			r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false, false}
 			_, err := r.Reconcile(ctx, reconcile.Request{})
 			Expect(err).ShouldNot(HaveOccurred())
 			authentication, err := utils.GetAuthentication(ctx, cli)
@@ -508,6 +509,70 @@ var _ = Describe("authentication controller tests", func() {
 		invalidFilter = "(objectClass=posixGroup)pancake"
 		attribute     = "uid"
 	)
+	Context("external Dex", func() {
+		var r *ReconcileAuthentication
+		dex := appsv1.Deployment{
+			TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      render.DexObjectName,
+				Namespace: render.DexNamespace,
+			},
+		}
+
+		BeforeEach(func() {
+			mockStatus.On("RemoveDeployments", mock.Anything).Return()
+			r = &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false, false}
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "external-dex-ca", Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{render.RootCASecretField: []byte(validCert)},
+			})).ToNot(HaveOccurred())
+			auth.Spec.ExternalDex = &operatorv1.AuthenticationExternalDex{
+				IssuerURL:    "https://dex.example.com/dex",
+				CASecretName: "external-dex-ca",
+			}
+		})
+
+		It("should not render a Dex deployment", func() {
+			Expect(cli.Create(ctx, auth)).ToNot(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(test.GetResource(cli, &dex)).To(HaveOccurred())
+
+			authentication, err := utils.GetAuthentication(ctx, cli)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(authentication.Status.State).To(Equal(operatorv1.TigeraStatusReady))
+		})
+
+		It("should remove a previously rendered Dex deployment", func() {
+			Expect(cli.Create(ctx, idpSecret)).ToNot(HaveOccurred())
+			externalDex := auth.Spec.ExternalDex
+			auth.Spec.ExternalDex = nil
+			auth.Spec.OIDC = &operatorv1.AuthenticationOIDC{IssuerURL: "https://example.com", UsernameClaim: "email"}
+			Expect(cli.Create(ctx, auth)).ToNot(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(test.GetResource(cli, &dex)).To(BeNil())
+
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, auth)).ToNot(HaveOccurred())
+			auth.Spec.OIDC = nil
+			auth.Spec.ExternalDex = externalDex
+			Expect(cli.Update(ctx, auth)).ToNot(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(test.GetResource(cli, &dex)).To(HaveOccurred())
+		})
+
+		It("should require the external Dex CA secret", func() {
+			auth.Spec.ExternalDex.CASecretName = "does-not-exist"
+			Expect(cli.Create(ctx, auth)).ToNot(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("does-not-exist"))
+		})
+	})
+
 	DescribeTable("LDAP connector config options should be validated", func(ldap *operatorv1.AuthenticationLDAP, secretDN, secretPW, secretCA []byte, expectReconcilePass bool) {
 		nameAttrEmpty := ldap.UserSearch.NameAttribute == ""
 		auth.Spec.LDAP = ldap
@@ -519,7 +584,7 @@ var _ = Describe("authentication controller tests", func() {
 		}
 		Expect(cli.Create(ctx, idpSecret)).ToNot(HaveOccurred())
 		Expect(cli.Create(ctx, auth)).ToNot(HaveOccurred())
-		r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false}
+		r := &ReconcileAuthentication{cli, scheme, operatorv1.ProviderNone, mockStatus, "", readyFlag, true, false, false}
 		_, err := r.Reconcile(ctx, reconcile.Request{})
 		if expectReconcilePass {
 			Expect(err).ToNot(HaveOccurred())
@@ -630,9 +695,40 @@ var _ = Describe("authentication controller tests", func() {
 		Entry("Expect prompt type to be used without other values", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: copyAndAddPromptTypes(oidc, []operatorv1.PromptType{operatorv1.PromptTypeNone})}}, false, true),
 		Entry("Expect prompt type to fail when none is combined", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: copyAndAddPromptTypes(oidc, []operatorv1.PromptType{operatorv1.PromptTypeNone, operatorv1.PromptTypeLogin})}}, false, false),
 		Entry("Expect prompt type to be able to be combined", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: copyAndAddPromptTypes(oidc, []operatorv1.PromptType{operatorv1.PromptTypeSelectAccount, operatorv1.PromptTypeLogin})}}, false, true),
+		Entry("Expect a custom dex namespace to pass validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, DexNamespace: "tenant-a-dex"}}, false, true),
+		Entry("Expect an invalid dex namespace to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, DexNamespace: "Tenant_A"}}, false, false),
+		Entry("Expect dex strategy and grace period overrides to pass validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, DexDeployment: dexDeployment(intstr.FromInt(0), intstr.FromInt(1), 60)}}, false, true),
+		Entry("Expect a negative dex grace period to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, DexDeployment: dexDeployment(intstr.FromInt(0), intstr.FromInt(1), -1)}}, false, false),
+		Entry("Expect an external Dex to pass validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{ExternalDex: &operatorv1.AuthenticationExternalDex{IssuerURL: "https://dex.example.com/dex"}}}, false, true),
+		Entry("Expect an external Dex combined with a connector to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, ExternalDex: &operatorv1.AuthenticationExternalDex{IssuerURL: "https://dex.example.com/dex"}}}, false, false),
+		Entry("Expect an external Dex without https to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{ExternalDex: &operatorv1.AuthenticationExternalDex{IssuerURL: "http://dex.example.com/dex"}}}, false, false),
+		Entry("Expect a signing key rotation period to pass validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, SigningKeyRotationPeriod: &metav1.Duration{Duration: 24 * time.Hour}}}, false, true),
+		Entry("Expect a signing key rotation period shorter than the ID token lifetime to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, SigningKeyRotationPeriod: &metav1.Duration{Duration: time.Minute}}}, false, false),
+		Entry("Expect a signing key rotation period longer than 90 days to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, SigningKeyRotationPeriod: &metav1.Duration{Duration: 91 * 24 * time.Hour}}}, false, false),
+		Entry("Expect a signing key rotation period with an external Dex to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{ExternalDex: &operatorv1.AuthenticationExternalDex{IssuerURL: "https://dex.example.com/dex"}, SigningKeyRotationPeriod: &metav1.Duration{Duration: 24 * time.Hour}}}, false, false),
+		Entry("Expect a dex strategy that cannot make progress to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, DexDeployment: dexDeployment(intstr.FromInt(0), intstr.FromInt(0), 60)}}, false, false),
+		Entry("Expect distinct username and groups prefixes to pass validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, UsernamePrefix: "oidc-user:", GroupsPrefix: "oidc-group:"}}, false, true),
+		Entry("Expect a reserved username prefix to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{LDAP: ldap, UsernamePrefix: "system:"}}, false, false),
+		Entry("Expect a reserved groups prefix to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{LDAP: ldap, GroupsPrefix: "system:masters:"}}, false, false),
+		Entry("Expect a reserved deprecated OIDC username prefix to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: &operatorv1.AuthenticationOIDC{IssuerURL: iss, UsernameClaim: "email", UsernamePrefix: "system:"}}}, false, false),
+		Entry("Expect identical username and groups prefixes to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: oidc, UsernamePrefix: "oidc:", GroupsPrefix: "oidc:"}}, false, false),
+		Entry("Expect identical prefixes across the deprecated OIDC fields to fail validation", &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{OIDC: &operatorv1.AuthenticationOIDC{IssuerURL: iss, UsernameClaim: "email", GroupsPrefix: "oidc:"}, UsernamePrefix: "oidc:"}}, false, false),
 	)
 })
 
+func dexDeployment(maxUnavailable, maxSurge intstr.IntOrString, gracePeriod int64) *operatorv1.DexDeployment {
+	return &operatorv1.DexDeployment{
+		Spec: &operatorv1.DexDeploymentSpec{
+			Strategy: &operatorv1.DexDeploymentStrategy{
+				RollingUpdate: &appsv1.RollingUpdateDeployment{MaxUnavailable: &maxUnavailable, MaxSurge: &maxSurge},
+			},
+			Template: &operatorv1.DexDeploymentPodTemplateSpec{
+				Spec: &operatorv1.DexDeploymentPodSpec{TerminationGracePeriodSeconds: &gracePeriod},
+			},
+		},
+	}
+}
+
 func copyAndAddPromptTypes(auth *operatorv1.AuthenticationOIDC, promptTypes []operatorv1.PromptType) *operatorv1.AuthenticationOIDC {
 	copy := auth.DeepCopy()
 	copy.PromptTypes = promptTypes
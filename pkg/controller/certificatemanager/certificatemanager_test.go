@@ -518,6 +518,27 @@ var _ = Describe("Test CertificateManagement suite", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(singleTenantCM.KeyPair().GetName()).To(Equal(certificatemanagement.TenantCASecretName))
 		})
+
+		It("should use a separate CA for each tenant in a multi-tenant configuration", func() {
+			tenantA := operatorv1.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "tenant-a", Namespace: "tenant-namespace-a"}}
+			tenantB := operatorv1.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "tenant-b", Namespace: "tenant-namespace-b"}}
+
+			cmA, err := certificatemanager.Create(cli, installation, clusterDomain, tenantA.Namespace, certificatemanager.AllowCACreation(), certificatemanager.WithTenant(&tenantA))
+			Expect(err).NotTo(HaveOccurred())
+			cmB, err := certificatemanager.Create(cli, installation, clusterDomain, tenantB.Namespace, certificatemanager.AllowCACreation(), certificatemanager.WithTenant(&tenantB))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmA.KeyPair().GetCertificatePEM()).NotTo(Equal(cmB.KeyPair().GetCertificatePEM()))
+
+			By("persisting the CA of one tenant and verifying that it is reused for that tenant only")
+			Expect(cli.Create(ctx, cmA.KeyPair().Secret(tenantA.Namespace))).NotTo(HaveOccurred())
+			cmA2, err := certificatemanager.Create(cli, installation, clusterDomain, tenantA.Namespace, certificatemanager.WithTenant(&tenantA))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cmA2.KeyPair().GetCertificatePEM()).To(Equal(cmA.KeyPair().GetCertificatePEM()))
+
+			// Tenant B's CA has not been persisted, so tenant A's CA must not be picked up in its place.
+			_, err = certificatemanager.Create(cli, installation, clusterDomain, tenantB.Namespace, certificatemanager.WithTenant(&tenantB))
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 	Describe("test TrustedBundle interface", func() {
@@ -18,13 +18,23 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/kubernetes"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,11 +42,15 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 	operatorv1 "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
 	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/common/validation"
+	guardian "github.com/tigera/operator/pkg/common/validation/guardian"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/status"
@@ -52,6 +66,13 @@ import (
 const (
 	controllerName = "clusterconnection-controller"
 	ResourceName   = "management-cluster-connection"
+
+	// managementClusterCertKey is the key of the management cluster's certificate in the tunnel secret.
+	managementClusterCertKey = "management-cluster.crt"
+
+	// defaultCertificateExpiryThreshold is how long before the management cluster's certificate expires that the
+	// ManagementClusterConnection is reported as degraded, unless spec.certificateExpiryThreshold is set.
+	defaultCertificateExpiryThreshold = 30 * 24 * time.Hour
 )
 
 var log = logf.Log.WithName(controllerName)
@@ -119,6 +140,7 @@ func newReconciler(
 		Provider:       p,
 		status:         statusMgr,
 		clusterDomain:  opts.ClusterDomain,
+		auditLog:       opts.AuditLog,
 		tierWatchReady: tierWatchReady,
 		usePSP:         opts.UsePSP,
 	}
@@ -158,6 +180,15 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 		return fmt.Errorf("%s failed to watch Secret resource %s: %w", controllerName, certificatemanagement.CASecretName, err)
 	}
 
+	// Watch for changes to the secrets referenced by the backend CA bundles. Their names are configured on the
+	// ManagementClusterConnection, so watch secrets in the operator namespace and filter on the referenced names.
+	isBackendCABundle := func(o client.Object) bool {
+		return o.GetNamespace() == common.OperatorNamespace() && isBackendCABundleSecret(mgr.GetClient(), o.GetName())
+	}
+	if err = c.WatchObject(&corev1.Secret{}, &handler.EnqueueRequestForObject{}, predicate.NewPredicateFuncs(isBackendCABundle)); err != nil {
+		return fmt.Errorf("%s failed to watch backend CA bundle secrets: %w", controllerName, err)
+	}
+
 	if err = utils.AddInstallationWatch(c); err != nil {
 		return fmt.Errorf("%s failed to watch Installation resource: %w", controllerName, err)
 	}
@@ -166,6 +197,40 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 		return fmt.Errorf("%s failed to watch ImageSet: %w", controllerName, err)
 	}
 
+	// Watch for changes to FelixConfiguration, as its trusted DNS servers determine whether domain-based policy works.
+	if err = c.WatchObject(&crdv1.FelixConfiguration{}, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("%s failed to watch FelixConfiguration resource: %w", controllerName, err)
+	}
+
+	// Watch for changes to the services of the DNS servers trusted by Felix, as domain-based policy for the management
+	// cluster address relies on them.
+	isDNSTrusted := func(o client.Object) bool {
+		return isDNSTrustedService(mgr.GetClient(), types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()})
+	}
+	if err = c.WatchObject(&corev1.Service{}, &handler.EnqueueRequestForObject{}, predicate.NewPredicateFuncs(isDNSTrusted)); err != nil {
+		return fmt.Errorf("%s failed to watch trusted DNS Service resources: %w", controllerName, err)
+	}
+
+	// Watch for changes to the guardian Deployment, as its readiness determines the reported tunnel state.
+	if err = utils.AddDeploymentWatch(c, render.GuardianDeploymentName, render.GuardianNamespace); err != nil {
+		return fmt.Errorf("%s failed to watch Deployment resource %s: %w", controllerName, render.GuardianDeploymentName, err)
+	}
+
+	// Watch for the services that route Elasticsearch and Kibana traffic through guardian, as they determine which
+	// of guardian's ports are exposed.
+	if err = utils.AddServiceWatch(c, render.ESGatewayServiceName, render.ElasticsearchNamespace); err != nil {
+		return fmt.Errorf("%s failed to watch Service resource %s: %w", controllerName, render.ESGatewayServiceName, err)
+	}
+	if err = utils.AddServiceWatch(c, render.KibanaServiceName, render.KibanaNamespace); err != nil {
+		return fmt.Errorf("%s failed to watch Service resource %s: %w", controllerName, render.KibanaServiceName, err)
+	}
+
+	// Watch for guardian pods that are waiting on the tier readiness gate, so that the condition is set on new pods.
+	// Other pod events, including our own condition updates, don't need a reconcile.
+	if err = c.WatchObject(&corev1.Pod{}, &handler.EnqueueRequestForObject{}, predicate.NewPredicateFuncs(guardianPodNeedsTierReadyCondition)); err != nil {
+		return fmt.Errorf("%s failed to watch guardian pods: %w", controllerName, err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("clusterconnection-controller failed to watch management-cluster-connection Tigerastatus: %w", err)
@@ -184,6 +249,7 @@ type ReconcileConnection struct {
 	Provider       operatorv1.Provider
 	status         status.StatusManager
 	clusterDomain  string
+	auditLog       bool
 	tierWatchReady *utils.ReadyFlag
 	usePSP         bool
 }
@@ -241,6 +307,17 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 		return reconcile.Result{}, err
 	}
 
+	if err := validateManagementClusterConnection(managementClusterConnection); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Invalid ManagementClusterConnection provided", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	if tierReadinessGateEnabled(managementClusterConnection) && variant != operatorv1.TigeraSecureEnterprise {
+		err = fmt.Errorf("ManagementClusterConnection spec.tierReadinessGate is only supported with the %s variant", operatorv1.TigeraSecureEnterprise)
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Invalid ManagementClusterConnection provided", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	preDefaultPatchFrom := client.MergeFrom(managementClusterConnection.DeepCopy())
 	fillDefaults(managementClusterConnection)
 
@@ -269,10 +346,24 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 	tunnelSecret := &corev1.Secret{}
 	err = r.Client.Get(ctx, types.NamespacedName{Name: render.GuardianSecretName, Namespace: common.OperatorNamespace()}, tunnelSecret)
 	if err != nil {
-		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving secrets from guardian namespace", err, reqLogger)
-		if !k8serrors.IsNotFound(err) {
+		if k8serrors.IsNotFound(err) {
+			// Guardian cannot establish the tunnel without this secret and would crash-loop, so don't render it until
+			// the secret exists. The secret is watched, so we reconcile again once it is created.
+			r.status.SetDegraded(operatorv1.ResourceNotFound, fmt.Sprintf("Waiting for secret '%s' to become available", render.GuardianSecretName), nil, reqLogger)
 			return result, nil
 		}
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving secrets from guardian namespace", err, reqLogger)
+		return result, err
+	}
+
+	packetCaptureCABundle, prometheusCABundle, queryServerCABundle, err := getBackendCABundles(ctx, r.Client, managementClusterConnection.Spec.BackendCABundles)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error retrieving guardian backend CA bundles", err, reqLogger)
+		return result, err
+	}
+	backendCABundleCopies, err := getBackendCABundleCopies(ctx, r.Client)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error listing guardian backend CA bundle copies", err, reqLogger)
 		return result, err
 	}
 
@@ -321,6 +412,7 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 
 	// Ensure the allow-tigera tier exists, before rendering any network policies within it.
 	includeV3NetworkPolicy := false
+	var dnsErr error
 	if err := r.Client.Get(ctx, client.ObjectKey{Name: networkpolicy.TigeraComponentTierName}, &v3.Tier{}); err != nil {
 		// The creation of the Tier depends on this controller to reconcile it's non-NetworkPolicy resources so that the
 		// License becomes available. Therefore, if we fail to query the Tier, we exclude NetworkPolicy from reconciliation
@@ -350,10 +442,35 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 				r.status.SetDegraded(operatorv1.ResourceReadError, "Feature is not active - License does not support feature: egress-access-control", nil, reqLogger)
 				return reconcile.Result{}, nil
 			}
+
+			// The domain-based egress rule in the guardian policy only takes effect once Felix has learned the IPs of
+			// the domain from a DNS server it trusts. The policy is still rendered, but we report when it cannot work.
+			var trusted bool
+			trusted, dnsErr = dnsTrustedServerAvailable(ctx, r.Client)
+			if dnsErr != nil {
+				r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying DNS servers trusted by Felix", dnsErr, reqLogger)
+				return reconcile.Result{}, dnsErr
+			}
+			if !trusted {
+				dnsErr = fmt.Errorf("none of the DNS servers in FelixConfiguration spec.dnsTrustedServers are available")
+			}
 		}
 	}
 
-	ch := utils.NewComponentHandler(log, r.Client, r.Scheme, managementClusterConnection)
+	// Only expose guardian's Elasticsearch and Kibana ports if their services in this cluster are routed through
+	// guardian to the management cluster.
+	esProxied, err := serviceRoutesToGuardian(ctx, r.Client, render.ESGatewayServiceName, render.ElasticsearchNamespace, r.clusterDomain)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying the Elasticsearch gateway service", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	kibanaProxied, err := serviceRoutesToGuardian(ctx, r.Client, render.KibanaServiceName, render.KibanaNamespace, r.clusterDomain)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying the Kibana service", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	ch := utils.NewComponentHandler(log, r.Client, r.Scheme, managementClusterConnection, utils.WithInstallation(instl), utils.WithAuditLog(r.auditLog, controllerName))
 	guardianCfg := &render.GuardianConfiguration{
 		URL:                         managementClusterConnection.Spec.ManagementClusterAddr,
 		TunnelCAType:                managementClusterConnection.Spec.TLS.CA,
@@ -362,8 +479,14 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 		Installation:                instl,
 		TunnelSecret:                tunnelSecret,
 		TrustedCertBundle:           trustedCertBundle,
+		PacketCaptureCABundle:       packetCaptureCABundle,
+		PrometheusCABundle:          prometheusCABundle,
+		QueryServerCABundle:         queryServerCABundle,
+		BackendCABundleCopies:       backendCABundleCopies,
 		UsePSP:                      r.usePSP,
 		ManagementClusterConnection: managementClusterConnection,
+		ElasticsearchProxyEnabled:   esProxied,
+		KibanaProxyEnabled:          kibanaProxied,
 	}
 
 	components := []render.Component{render.Guardian(guardianCfg)}
@@ -393,12 +516,162 @@ func (r *ReconcileConnection) Reconcile(ctx context.Context, request reconcile.R
 		}
 	}
 
+	if tierReadinessGateEnabled(managementClusterConnection) {
+		if err = r.updateTierReadyCondition(ctx, includeV3NetworkPolicy); err != nil {
+			r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error updating the tier readiness gate of guardian pods", err, reqLogger)
+			return result, err
+		}
+	}
+
+	if err = r.updateTunnelStatus(ctx, managementClusterConnection); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error updating ManagementClusterConnection status", err, reqLogger)
+		return result, err
+	}
+
+	notAfter, err := managementClusterCertNotAfter(tunnelSecret)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.CertificateError, fmt.Sprintf("Unable to parse the management cluster certificate in secret '%s'", render.GuardianSecretName), err, reqLogger)
+		return result, err
+	}
+	if err = r.updateCertificateStatus(ctx, managementClusterConnection, notAfter); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error updating ManagementClusterConnection status", err, reqLogger)
+		return result, err
+	}
+
+	if dnsErr != nil {
+		r.status.SetDegraded(operatorv1.ResourceNotFound, "Domain-based policy for the management cluster address requires a DNS server trusted by Felix", dnsErr, reqLogger)
+		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+	}
+
+	if managementClusterConnection.Spec.InsecureSkipTLSVerify {
+		// Never report an insecure tunnel as healthy, so that the bootstrap setting is not left enabled.
+		r.status.SetDegraded(operatorv1.InvalidConfigurationError, "ManagementClusterConnection spec.insecureSkipTLSVerify is enabled, guardian does not verify the management cluster certificate", nil, reqLogger)
+		return result, nil
+	}
+
+	if notAfter != nil {
+		threshold := defaultCertificateExpiryThreshold
+		if t := managementClusterConnection.Spec.CertificateExpiryThreshold; t != nil {
+			threshold = t.Duration
+		}
+		if time.Until(notAfter.Time) < threshold {
+			// Report the connection as degraded ahead of the expiry, so that the certificate can be rotated before the
+			// tunnel breaks.
+			r.status.SetDegraded(operatorv1.CertificateError, fmt.Sprintf("The management cluster certificate in secret '%s' expires at %s", render.GuardianSecretName, notAfter.UTC().Format(time.RFC3339)), nil, reqLogger)
+			return result, nil
+		}
+		// Reconcile again once the threshold is reached.
+		result.RequeueAfter = time.Until(notAfter.Time) - threshold
+	}
+
 	r.status.ClearDegraded()
 
 	// We should create the Guardian deployment.
 	return result, nil
 }
 
+// updateTierReadyCondition sets the tier ready condition, used as a readiness gate, on the guardian pods to reflect
+// whether the allow-tigera tier exists.
+func (r *ReconcileConnection) updateTierReadyCondition(ctx context.Context, tierReady bool) error {
+	pods := &corev1.PodList{}
+	if err := r.Client.List(ctx, pods, client.InNamespace(render.GuardianNamespace), client.MatchingLabels{"k8s-app": render.GuardianName}); err != nil {
+		return err
+	}
+
+	conditionStatus := corev1.ConditionFalse
+	reason := "TierNotFound"
+	if tierReady {
+		conditionStatus = corev1.ConditionTrue
+		reason = "TierFound"
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		idx := -1
+		for j, c := range pod.Status.Conditions {
+			if c.Type == render.GuardianTierReadyConditionType {
+				idx = j
+				break
+			}
+		}
+		if idx >= 0 && pod.Status.Conditions[idx].Status == conditionStatus {
+			continue
+		}
+		condition := corev1.PodCondition{
+			Type:               render.GuardianTierReadyConditionType,
+			Status:             conditionStatus,
+			Reason:             reason,
+			LastTransitionTime: metav1.Now(),
+		}
+		// Patch rather than update the status, so that only our condition is written and the kubelet's own
+		// status updates are left alone. Pod conditions are merged by type, so the strategic merge patch only
+		// contains this one condition. This requires the operator's ClusterRole to allow patching pods/status.
+		orig := pod.DeepCopy()
+		if idx >= 0 {
+			pod.Status.Conditions[idx] = condition
+		} else {
+			pod.Status.Conditions = append(pod.Status.Conditions, condition)
+		}
+		if err := r.Client.Status().Patch(ctx, pod, client.StrategicMergeFrom(orig)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateTunnelStatus records the state of the tunnel to the management cluster on the ManagementClusterConnection
+// status. The tunnel is considered connected while at least one guardian pod is ready. The status is only written when
+// the state changes, so that reconciles triggered by the update do not write it again.
+func (r *ReconcileConnection) updateTunnelStatus(ctx context.Context, mcc *operatorv1.ManagementClusterConnection) error {
+	d := &appsv1.Deployment{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: render.GuardianDeploymentName, Namespace: render.GuardianNamespace}, d)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	state := operatorv1.TunnelStateDisconnected
+	if err == nil && d.Status.ReadyReplicas > 0 {
+		state = operatorv1.TunnelStateConnected
+	}
+	if mcc.Status.TunnelState == state {
+		return nil
+	}
+	if state == operatorv1.TunnelStateConnected {
+		now := metav1.Now()
+		mcc.Status.LastConnectedTime = &now
+	}
+	mcc.Status.TunnelState = state
+	return r.Client.Status().Update(ctx, mcc)
+}
+
+// managementClusterCertNotAfter returns when the management cluster's certificate in the given tunnel secret expires,
+// or nil if the secret does not contain a certificate.
+func managementClusterCertNotAfter(secret *corev1.Secret) (*metav1.Time, error) {
+	certPEM := secret.Data[managementClusterCertKey]
+	if len(certPEM) == 0 {
+		_, certPEM = certificatemanagement.GetKeyCertPEM(secret)
+	}
+	if len(certPEM) == 0 {
+		return nil, nil
+	}
+	cert, err := certificatemanagement.ParseCertificate(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	notAfter := metav1.NewTime(cert.NotAfter)
+	return &notAfter, nil
+}
+
+// updateCertificateStatus records when the management cluster's certificate expires on the ManagementClusterConnection
+// status. Like the tunnel state, the status is only written when it changes.
+func (r *ReconcileConnection) updateCertificateStatus(ctx context.Context, mcc *operatorv1.ManagementClusterConnection, notAfter *metav1.Time) error {
+	current := mcc.Status.CertificateNotAfter
+	if (current == nil && notAfter == nil) || (current != nil && notAfter != nil && current.Equal(notAfter)) {
+		return nil
+	}
+	mcc.Status.CertificateNotAfter = notAfter
+	return r.Client.Status().Update(ctx, mcc)
+}
+
 func fillDefaults(mcc *operatorv1.ManagementClusterConnection) {
 	if mcc.Spec.TLS == nil {
 		mcc.Spec.TLS = &operatorv1.ManagementClusterTLS{}
@@ -408,6 +681,285 @@ func fillDefaults(mcc *operatorv1.ManagementClusterConnection) {
 	}
 }
 
+// getBackendCABundles retrieves the secrets referenced by the given backend CA bundles. A secret is nil if its
+// backend has no CA bundle configured.
+func getBackendCABundles(ctx context.Context, cli client.Client, bundles *operatorv1.GuardianBackendCABundles) (packetCapture, prometheus, queryServer *corev1.Secret, err error) {
+	if bundles == nil {
+		return nil, nil, nil, nil
+	}
+	get := func(name string) (*corev1.Secret, error) {
+		if name == "" {
+			return nil, nil
+		}
+		s := &corev1.Secret{}
+		if err := cli.Get(ctx, types.NamespacedName{Name: name, Namespace: common.OperatorNamespace()}, s); err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle secret %s/%s: %w", common.OperatorNamespace(), name, err)
+		}
+		if len(s.Data[render.GuardianBackendCABundleKey]) == 0 {
+			return nil, fmt.Errorf("CA bundle secret %s/%s does not contain the key %s", common.OperatorNamespace(), name, render.GuardianBackendCABundleKey)
+		}
+		return s, nil
+	}
+	if packetCapture, err = get(bundles.PacketCapture); err != nil {
+		return nil, nil, nil, err
+	}
+	if prometheus, err = get(bundles.Prometheus); err != nil {
+		return nil, nil, nil, err
+	}
+	if queryServer, err = get(bundles.QueryServer); err != nil {
+		return nil, nil, nil, err
+	}
+	return packetCapture, prometheus, queryServer, nil
+}
+
+// isBackendCABundleSecret returns whether the named secret in the operator namespace is referenced by the backend CA
+// bundles of the ManagementClusterConnection.
+func isBackendCABundleSecret(cli client.Client, name string) bool {
+	mcc, err := utils.GetManagementClusterConnection(context.Background(), cli)
+	if err != nil {
+		log.Error(err, "Failed to query ManagementClusterConnection")
+		return false
+	}
+	if mcc == nil || mcc.Spec.BackendCABundles == nil {
+		return false
+	}
+	b := mcc.Spec.BackendCABundles
+	return name == b.PacketCapture || name == b.Prometheus || name == b.QueryServer
+}
+
+// getBackendCABundleCopies returns the names of the backend CA bundle copies in the guardian namespace.
+func getBackendCABundleCopies(ctx context.Context, cli client.Client) ([]string, error) {
+	secrets := &corev1.SecretList{}
+	if err := cli.List(ctx, secrets, client.InNamespace(render.GuardianNamespace), client.HasLabels{render.GuardianBackendCABundleLabel}); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, s := range secrets.Items {
+		names = append(names, s.Name)
+	}
+	return names, nil
+}
+
+// validateManagementClusterConnection validates the given ManagementClusterConnection.
+func validateManagementClusterConnection(mcc *operatorv1.ManagementClusterConnection) error {
+	if f := mcc.Spec.IPFamilyPreference; f != nil && *f != operatorv1.IPFamilyIPv4 && *f != operatorv1.IPFamilyIPv6 {
+		return fmt.Errorf("ManagementClusterConnection spec.ipFamilyPreference %q is not supported", *f)
+	}
+	if b := mcc.Spec.MaxRequestHeaderBytes; b != nil && *b <= 0 {
+		return fmt.Errorf("ManagementClusterConnection spec.maxRequestHeaderBytes must be positive, got %d", *b)
+	}
+	if b := mcc.Spec.TunnelMaxFrameBytes; b != nil && *b <= 0 {
+		return fmt.Errorf("ManagementClusterConnection spec.tunnelMaxFrameBytes must be positive, got %d", *b)
+	}
+	if p := mcc.Spec.BackendRetryPolicy; p != nil {
+		if p.MaxRetries != nil && *p.MaxRetries < 0 {
+			return fmt.Errorf("ManagementClusterConnection spec.backendRetryPolicy.maxRetries must not be negative, got %d", *p.MaxRetries)
+		}
+		if p.Backoff != nil && p.Backoff.Duration <= 0 {
+			return fmt.Errorf("ManagementClusterConnection spec.backendRetryPolicy.backoff must be positive, got %s", p.Backoff.Duration)
+		}
+	}
+	if w := mcc.Spec.Workers; w != nil && *w <= 0 {
+		return fmt.Errorf("ManagementClusterConnection spec.workers must be positive, got %d", *w)
+	}
+	if l := mcc.Spec.TunnelBandwidthLimit; l != nil && l.Value() <= 0 {
+		return fmt.Errorf("ManagementClusterConnection spec.tunnelBandwidthLimit must be a positive rate, got %s", l.String())
+	}
+	if t := mcc.Spec.DNSCacheTTL; t != nil && t.Duration < 0 {
+		return fmt.Errorf("ManagementClusterConnection spec.dnsCacheTTL must not be negative, got %s", t.Duration)
+	}
+	if d := mcc.Spec.ShutdownDrainDelay; d != nil && d.Duration < 0 {
+		return fmt.Errorf("ManagementClusterConnection spec.shutdownDrainDelay must not be negative, got %s", d.Duration)
+	}
+	if i := mcc.Spec.HeartbeatInterval; i != nil && i.Duration <= 0 {
+		return fmt.Errorf("ManagementClusterConnection spec.heartbeatInterval must be positive, got %s", i.Duration)
+	}
+	if t := mcc.Spec.CertificateExpiryThreshold; t != nil && t.Duration <= 0 {
+		return fmt.Errorf("ManagementClusterConnection spec.certificateExpiryThreshold must be positive, got %s", t.Duration)
+	}
+	if egw := mcc.Spec.EgressGateway; egw != nil {
+		if strings.TrimSpace(egw.Selector) == "" {
+			return fmt.Errorf("ManagementClusterConnection spec.egressGateway.selector must be set")
+		}
+		if egw.NamespaceSelector != "" && strings.TrimSpace(egw.NamespaceSelector) == "" {
+			return fmt.Errorf("ManagementClusterConnection spec.egressGateway.namespaceSelector must not be blank")
+		}
+	}
+	if s := mcc.Spec.GuardianService; s != nil {
+		if s.Type != nil && *s.Type != corev1.ServiceTypeClusterIP && *s.Type != corev1.ServiceTypeNodePort {
+			return fmt.Errorf("ManagementClusterConnection spec.guardianService.type %q is not supported", *s.Type)
+		}
+		if s.NodePort != nil {
+			if s.Type == nil || *s.Type != corev1.ServiceTypeNodePort {
+				return fmt.Errorf("ManagementClusterConnection spec.guardianService.nodePort requires type NodePort")
+			}
+			if *s.NodePort < 30000 || *s.NodePort > 32767 {
+				return fmt.Errorf("ManagementClusterConnection spec.guardianService.nodePort %d is outside the range 30000-32767", *s.NodePort)
+			}
+		}
+	}
+	if t := mcc.Spec.TunnelCompression; t != nil && *t != operatorv1.TunnelCompressionEnabled && *t != operatorv1.TunnelCompressionDisabled {
+		return fmt.Errorf("ManagementClusterConnection spec.tunnelCompression %q is not supported", *t)
+	}
+	if rc := mcc.Spec.RequestCoalescing; rc != nil && *rc != operatorv1.RequestCoalescingEnabled && *rc != operatorv1.RequestCoalescingDisabled {
+		return fmt.Errorf("ManagementClusterConnection spec.requestCoalescing %q is not supported", *rc)
+	}
+	if e := mcc.Spec.DebugConfigEndpoint; e != nil && *e != operatorv1.DebugConfigEndpointEnabled && *e != operatorv1.DebugConfigEndpointDisabled {
+		return fmt.Errorf("ManagementClusterConnection spec.debugConfigEndpoint %q is not supported", *e)
+	}
+	if g := mcc.Spec.TierReadinessGate; g != nil && *g != operatorv1.TierReadinessGateEnabled && *g != operatorv1.TierReadinessGateDisabled {
+		return fmt.Errorf("ManagementClusterConnection spec.tierReadinessGate %q is not supported", *g)
+	}
+	if l := mcc.Spec.AccessLogging; l != nil {
+		if l.State != nil && *l.State != operatorv1.AccessLoggingEnabled && *l.State != operatorv1.AccessLoggingDisabled {
+			return fmt.Errorf("ManagementClusterConnection spec.accessLogging.state %q is not supported", *l.State)
+		}
+		if l.Format != nil && *l.Format != operatorv1.AccessLogFormatText && *l.Format != operatorv1.AccessLogFormatJSON {
+			return fmt.Errorf("ManagementClusterConnection spec.accessLogging.format %q is not supported", *l.Format)
+		}
+	}
+	var prev float64
+	for i, b := range mcc.Spec.TunnelLatencyBuckets {
+		v, err := strconv.ParseFloat(b, 64)
+		if err != nil {
+			return fmt.Errorf("ManagementClusterConnection spec.tunnelLatencyBuckets[%d] %q is not a number", i, b)
+		}
+		if i > 0 && v <= prev {
+			return fmt.Errorf("ManagementClusterConnection spec.tunnelLatencyBuckets must be in strictly ascending order, got %q after %q", b, mcc.Spec.TunnelLatencyBuckets[i-1])
+		}
+		prev = v
+	}
+	if p := mcc.Spec.TunnelPort; p != nil {
+		if *p < 1 || *p > 65535 {
+			return fmt.Errorf("ManagementClusterConnection spec.tunnelPort must be between 1 and 65535, got %d", *p)
+		}
+		if *p == render.GuardianTargetPort || *p == render.GuardianHealthPort || (*p == render.GuardianDebugPort && debugConfigEndpointEnabled(mcc)) {
+			return fmt.Errorf("ManagementClusterConnection spec.tunnelPort %d collides with a port guardian already uses", *p)
+		}
+	}
+	if t := mcc.Spec.ServiceAccountToken; t != nil {
+		if t.Audience == "" {
+			return fmt.Errorf("ManagementClusterConnection spec.serviceAccountToken.audience must be set")
+		}
+		if t.ExpirationSeconds != nil && *t.ExpirationSeconds < 600 {
+			return fmt.Errorf("ManagementClusterConnection spec.serviceAccountToken.expirationSeconds must be at least 600, got %d", *t.ExpirationSeconds)
+		}
+	}
+	for name := range mcc.Spec.TunnelHeaders {
+		if !httpHeaderNameRegexp.MatchString(name) {
+			return fmt.Errorf("ManagementClusterConnection spec.tunnelHeaders %q is not a valid header name", name)
+		}
+		if isReservedTunnelHeader(name) {
+			return fmt.Errorf("ManagementClusterConnection spec.tunnelHeaders %q is managed by guardian and cannot be set", name)
+		}
+	}
+
+	if errs := metav1validation.ValidateLabels(mcc.Spec.ClusterLabels, field.NewPath("spec", "clusterLabels")); len(errs) > 0 {
+		return fmt.Errorf("ManagementClusterConnection %s", errs.ToAggregate().Error())
+	}
+	if b := mcc.Spec.BackendCABundles; b != nil {
+		for _, ref := range []struct{ field, name string }{
+			{"packetCapture", b.PacketCapture},
+			{"prometheus", b.Prometheus},
+			{"queryServer", b.QueryServer},
+		} {
+			if ref.name == "" {
+				continue
+			}
+			if errs := k8svalidation.IsDNS1123Subdomain(ref.name); len(errs) > 0 {
+				return fmt.Errorf("ManagementClusterConnection spec.backendCABundles.%s %q is not a valid secret name: %s", ref.field, ref.name, strings.Join(errs, ", "))
+			}
+		}
+	}
+
+	// Verify the GuardianDeployment overrides, if specified, are valid.
+	if d := mcc.Spec.GuardianDeployment; d != nil {
+		err := validation.ValidateReplicatedPodResourceOverrides(d, guardian.ValidateGuardianDeploymentContainer, guardian.ValidateGuardianDeploymentInitContainer)
+		if err != nil {
+			return fmt.Errorf("ManagementClusterConnection spec.GuardianDeployment is not valid: %w", err)
+		}
+		if err := guardian.ValidateGuardianDeploymentExtraInitContainers(d.GetExtraInitContainers(), render.GuardianDeploymentName); err != nil {
+			return fmt.Errorf("ManagementClusterConnection spec.GuardianDeployment is not valid: %w", err)
+		}
+		if t := d.GetLivenessProbeType(render.GuardianDeploymentName); t != nil {
+			if *t != operatorv1.GuardianProbeTypeHTTP && *t != operatorv1.GuardianProbeTypeTCP {
+				return fmt.Errorf("ManagementClusterConnection spec.GuardianDeployment livenessProbeType %q is not supported", *t)
+			}
+		}
+	}
+	return nil
+}
+
+// debugConfigEndpointEnabled returns whether guardian serves its effective configuration on the debug port.
+func debugConfigEndpointEnabled(mcc *operatorv1.ManagementClusterConnection) bool {
+	return mcc.Spec.DebugConfigEndpoint != nil && *mcc.Spec.DebugConfigEndpoint == operatorv1.DebugConfigEndpointEnabled
+}
+
+// serviceRoutesToGuardian returns whether the given service exists and resolves to the guardian service, which is
+// how components in a managed cluster reach backends in the management cluster.
+func serviceRoutesToGuardian(ctx context.Context, cli client.Client, name, namespace, clusterDomain string) (bool, error) {
+	svc := &corev1.Service{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, svc); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	guardianHost := fmt.Sprintf("%s.%s.svc.%s", render.GuardianServiceName, render.GuardianNamespace, clusterDomain)
+	return svc.Spec.Type == corev1.ServiceTypeExternalName && svc.Spec.ExternalName == guardianHost, nil
+}
+
+// guardianPodNeedsTierReadyCondition returns whether obj is a guardian pod with the tier readiness gate that doesn't
+// have the gate's condition set yet.
+func guardianPodNeedsTierReadyCondition(obj client.Object) bool {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.Namespace != render.GuardianNamespace {
+		return false
+	}
+	gated := false
+	for _, gate := range pod.Spec.ReadinessGates {
+		if gate.ConditionType == render.GuardianTierReadyConditionType {
+			gated = true
+			break
+		}
+	}
+	if !gated {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == render.GuardianTierReadyConditionType {
+			return false
+		}
+	}
+	return true
+}
+
+// tierReadinessGateEnabled returns whether guardian's readiness is gated on the allow-tigera tier.
+func tierReadinessGateEnabled(mcc *operatorv1.ManagementClusterConnection) bool {
+	return mcc.Spec.TierReadinessGate != nil && *mcc.Spec.TierReadinessGate == operatorv1.TierReadinessGateEnabled
+}
+
+// httpHeaderNameRegexp matches an HTTP header field name, which must be a token as defined by RFC 7230.
+var httpHeaderNameRegexp = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+// reservedTunnelHeaders are the headers guardian sets on tunnel requests itself.
+var reservedTunnelHeaders = []string{"Host", "Authorization", "Connection", "Content-Length", "Transfer-Encoding", "Upgrade"}
+
+// isReservedTunnelHeader returns whether the header with the given name is set by guardian and so cannot be
+// configured through spec.tunnelHeaders.
+func isReservedTunnelHeader(name string) bool {
+	name = http.CanonicalHeaderKey(name)
+	if strings.HasPrefix(name, "Impersonate-") {
+		return true
+	}
+	for _, h := range reservedTunnelHeaders {
+		if name == h {
+			return true
+		}
+	}
+	return false
+}
+
 func networkPolicyRequiresEgressAccessControl(connection *operatorv1.ManagementClusterConnection, log logr.Logger) bool {
 	if clusterAddrHasDomain, err := managementClusterAddrHasDomain(connection); err == nil && clusterAddrHasDomain {
 		return true
@@ -423,6 +975,74 @@ func networkPolicyRequiresEgressAccessControl(connection *operatorv1.ManagementC
 	}
 }
 
+// dnsTrustedServerAvailable returns whether any of the DNS servers trusted by Felix is available. Entries of the form
+// k8s-service:[<namespace>/]<name> must refer to an existing Service; IP address entries are assumed to be reachable.
+func dnsTrustedServerAvailable(ctx context.Context, cli client.Client) (bool, error) {
+	services, otherServers, err := dnsTrustedServices(ctx, cli)
+	if err != nil {
+		return false, err
+	}
+	if otherServers {
+		return true, nil
+	}
+	for _, key := range services {
+		err := cli.Get(ctx, key, &corev1.Service{})
+		if err == nil {
+			return true, nil
+		}
+		if !k8serrors.IsNotFound(err) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// dnsTrustedServices returns the Kubernetes services among the DNS servers trusted by Felix, and whether any of the
+// trusted servers is not a Kubernetes service.
+func dnsTrustedServices(ctx context.Context, cli client.Client) ([]types.NamespacedName, bool, error) {
+	// Felix trusts kube-dns if dnsTrustedServers is not configured.
+	servers := []string{"k8s-service:kube-dns"}
+	fc := &crdv1.FelixConfiguration{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: "default"}, fc); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, false, err
+		}
+	} else if fc.Spec.DNSTrustedServers != nil {
+		servers = *fc.Spec.DNSTrustedServers
+	}
+
+	var services []types.NamespacedName
+	otherServers := false
+	for _, server := range servers {
+		svc, ok := strings.CutPrefix(server, "k8s-service:")
+		if !ok {
+			otherServers = true
+			continue
+		}
+		namespace, name := "kube-system", svc
+		if ns, n, found := strings.Cut(svc, "/"); found {
+			namespace, name = ns, n
+		}
+		services = append(services, types.NamespacedName{Namespace: namespace, Name: name})
+	}
+	return services, otherServers, nil
+}
+
+// isDNSTrustedService returns whether the given service is one of the DNS servers trusted by Felix.
+func isDNSTrustedService(cli client.Client, key types.NamespacedName) bool {
+	services, _, err := dnsTrustedServices(context.Background(), cli)
+	if err != nil {
+		log.Error(err, "Failed to query the DNS servers trusted by Felix")
+		return false
+	}
+	for _, s := range services {
+		if s == key {
+			return true
+		}
+	}
+	return false
+}
+
 func managementClusterAddrHasDomain(connection *operatorv1.ManagementClusterConnection) (bool, error) {
 	host, _, err := net.SplitHostPort(connection.Spec.ManagementClusterAddr)
 	if err != nil {
@@ -17,14 +17,20 @@ package clusterconnection_test
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
 	"github.com/stretchr/testify/mock"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -34,6 +40,7 @@ import (
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/apis"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
@@ -44,6 +51,7 @@ import (
 	"github.com/tigera/operator/pkg/dns"
 	"github.com/tigera/operator/pkg/render"
 	"github.com/tigera/operator/pkg/render/monitor"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
 	"github.com/tigera/operator/test"
 )
 
@@ -158,6 +166,633 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(dpl.Labels["k8s-app"]).To(Equal(render.GuardianName))
 		})
+
+		It("should report the tunnel state on the ManagementClusterConnection status", func() {
+			setReadyReplicas := func(n int32) {
+				Expect(c.Get(ctx, client.ObjectKey{Name: render.GuardianDeploymentName, Namespace: render.GuardianNamespace}, dpl)).NotTo(HaveOccurred())
+				dpl.Status.ReadyReplicas = n
+				Expect(c.Status().Update(ctx, dpl)).NotTo(HaveOccurred())
+			}
+
+			By("reporting the tunnel as disconnected before guardian is ready")
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			Expect(cfg.Status.TunnelState).To(Equal(operatorv1.TunnelStateDisconnected))
+			Expect(cfg.Status.LastConnectedTime).To(BeNil())
+
+			By("reporting the tunnel as connected once a guardian pod is ready")
+			setReadyReplicas(1)
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			Expect(cfg.Status.TunnelState).To(Equal(operatorv1.TunnelStateConnected))
+			Expect(cfg.Status.LastConnectedTime).NotTo(BeNil())
+			connectedAt := *cfg.Status.LastConnectedTime
+
+			By("keeping the last connected time while the tunnel stays connected")
+			time.Sleep(time.Second)
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			Expect(cfg.Status.TunnelState).To(Equal(operatorv1.TunnelStateConnected))
+			Expect(cfg.Status.LastConnectedTime.Equal(&connectedAt)).To(BeTrue())
+
+			By("reporting the tunnel as disconnected and keeping the last connected time once guardian is no longer ready")
+			setReadyReplicas(0)
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			Expect(cfg.Status.TunnelState).To(Equal(operatorv1.TunnelStateDisconnected))
+			Expect(cfg.Status.LastConnectedTime).NotTo(BeNil())
+			Expect(cfg.Status.LastConnectedTime.Equal(&connectedAt)).To(BeTrue())
+		})
+
+		It("should report the management cluster certificate expiry on the ManagementClusterConnection status", func() {
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: render.GuardianSecretName, Namespace: common.OperatorNamespace()}, secret)).NotTo(HaveOccurred())
+			cert, err := certificatemanagement.ParseCertificate(secret.Data[corev1.TLSCertKey])
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			Expect(cfg.Status.CertificateNotAfter).NotTo(BeNil())
+			Expect(cfg.Status.CertificateNotAfter.Time.Equal(cert.NotAfter)).To(BeTrue())
+			Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			mockStatus.AssertCalled(GinkgoT(), "ClearDegraded")
+		})
+
+		It("should degrade once the management cluster certificate is within the expiry threshold", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.CertificateExpiryThreshold = &metav1.Duration{Duration: 100 * 365 * 24 * time.Hour}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.CertificateError, mock.MatchedBy(func(msg string) bool {
+				return strings.Contains(msg, "expires at")
+			}), mock.Anything, mock.Anything)
+			mockStatus.AssertNotCalled(GinkgoT(), "ClearDegraded")
+		})
+
+		It("should prefer the management cluster certificate in the tunnel secret", func() {
+			certPEM, _, err := test.MakeTestCA("management-cluster").Config.GetPEMBytes()
+			Expect(err).NotTo(HaveOccurred())
+			cert, err := certificatemanagement.ParseCertificate(certPEM)
+			Expect(err).NotTo(HaveOccurred())
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: render.GuardianSecretName, Namespace: common.OperatorNamespace()}, secret)).NotTo(HaveOccurred())
+			secret.Data["management-cluster.crt"] = certPEM
+			Expect(c.Update(ctx, secret)).NotTo(HaveOccurred())
+
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			Expect(cfg.Status.CertificateNotAfter).NotTo(BeNil())
+			Expect(cfg.Status.CertificateNotAfter.Time.Equal(cert.NotAfter)).To(BeTrue())
+		})
+
+		It("should set an owner reference to the ManagementClusterConnection on guardian's namespaced objects", func() {
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "tigera-pull-secret", Namespace: common.OperatorNamespace()},
+			})).NotTo(HaveOccurred())
+			installation := &operatorv1.Installation{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, installation)).NotTo(HaveOccurred())
+			installation.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "tigera-pull-secret"}}
+			Expect(c.Update(ctx, installation)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+
+			expectOwnedByMCC := func(obj client.Object) {
+				Expect(c.Get(ctx, client.ObjectKey{Name: obj.GetName(), Namespace: render.GuardianNamespace}, obj)).NotTo(HaveOccurred())
+				refs := obj.GetOwnerReferences()
+				Expect(refs).To(HaveLen(1), "%T %s has no owner reference", obj, obj.GetName())
+				Expect(refs[0].Kind).To(Equal("ManagementClusterConnection"))
+				Expect(refs[0].Name).To(Equal(cfg.Name))
+				Expect(refs[0].UID).To(Equal(cfg.UID))
+				Expect(refs[0].Controller).NotTo(BeNil())
+				Expect(*refs[0].Controller).To(BeTrue())
+			}
+			expectOwnedByMCC(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: render.GuardianDeploymentName}})
+			expectOwnedByMCC(&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: render.GuardianServiceAccountName}})
+			expectOwnedByMCC(&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: render.GuardianServiceName}})
+			expectOwnedByMCC(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: render.GuardianSecretName}})
+			expectOwnedByMCC(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "tigera-pull-secret"}})
+			expectOwnedByMCC(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: certificatemanagement.TrustedCertConfigMapName}})
+		})
+	})
+
+	Context("missing tunnel secret", func() {
+		It("should degrade and not render guardian until the tunnel secret exists", func() {
+			Expect(c.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: render.GuardianSecretName, Namespace: common.OperatorNamespace()}})).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, "Waiting for secret 'tigera-managed-cluster-connection' to become available", mock.Anything, mock.Anything)
+			mockStatus.AssertNotCalled(GinkgoT(), "ClearDegraded", mock.Anything)
+			err = c.Get(ctx, client.ObjectKey{Name: render.GuardianDeploymentName, Namespace: render.GuardianNamespace}, dpl)
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Context("backend CA bundles", func() {
+		BeforeEach(func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.BackendCABundles = &operatorv1.GuardianBackendCABundles{QueryServer: "queryserver-ca"}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+		})
+
+		It("should degrade when a referenced CA bundle secret is missing", func() {
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceReadError, "Error retrieving guardian backend CA bundles", mock.Anything, mock.Anything)
+		})
+
+		It("should degrade when a referenced CA bundle secret has no CA bundle", func() {
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "queryserver-ca", Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{"tls.crt": []byte("cert")},
+			})).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(MatchError(ContainSubstring("does not contain the key ca.crt")))
+		})
+
+		It("should point guardian at the configured CA bundle", func() {
+			Expect(c.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "queryserver-ca", Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{"ca.crt": []byte("ca")},
+			})).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: "queryserver-ca", Namespace: render.GuardianNamespace}, &corev1.Secret{})).NotTo(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: render.GuardianDeploymentName, Namespace: render.GuardianNamespace}, dpl)).NotTo(HaveOccurred())
+			container := test.GetContainer(dpl.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			Expect(container).NotTo(BeNil())
+			Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "GUARDIAN_QUERYSERVER_CA_BUNDLE_PATH", Value: "/etc/pki/guardian/queryserver/ca.crt"}))
+		})
+
+		It("should remove the copy of a CA bundle secret that is no longer referenced", func() {
+			for _, name := range []string{"queryserver-ca", "queryserver-ca-2"} {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.OperatorNamespace()},
+					Data:       map[string][]byte{"ca.crt": []byte("ca")},
+				})).NotTo(HaveOccurred())
+			}
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "queryserver-ca", Namespace: render.GuardianNamespace}, &corev1.Secret{})).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.BackendCABundles.QueryServer = "queryserver-ca-2"
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: "queryserver-ca-2", Namespace: render.GuardianNamespace}, &corev1.Secret{})).NotTo(HaveOccurred())
+			err = c.Get(ctx, client.ObjectKey{Name: "queryserver-ca", Namespace: render.GuardianNamespace}, &corev1.Secret{})
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should only match secrets referenced by the backend CA bundles", func() {
+			Expect(clusterconnection.IsBackendCABundleSecret(c, "queryserver-ca")).To(BeTrue())
+			Expect(clusterconnection.IsBackendCABundleSecret(c, render.GuardianSecretName)).To(BeFalse())
+		})
+	})
+
+	Context("guardian service ports", func() {
+		servicePorts := func() []string {
+			svc := &corev1.Service{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: render.GuardianServiceName, Namespace: render.GuardianNamespace}, svc)).NotTo(HaveOccurred())
+			var ports []string
+			for _, p := range svc.Spec.Ports {
+				ports = append(ports, p.Name)
+			}
+			return ports
+		}
+
+		guardianHost := fmt.Sprintf("%s.%s.svc.%s", render.GuardianServiceName, render.GuardianNamespace, dns.DefaultClusterDomain)
+
+		DescribeTable("should only expose the Elasticsearch and Kibana ports when their services route through guardian",
+			func(services []*corev1.Service, expected []string) {
+				for _, svc := range services {
+					Expect(c.Create(ctx, svc)).NotTo(HaveOccurred())
+				}
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(servicePorts()).To(Equal(expected))
+			},
+			Entry("no services", nil, []string{"linseed"}),
+			Entry("Elasticsearch gateway routed through guardian", []*corev1.Service{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: render.ESGatewayServiceName, Namespace: render.ElasticsearchNamespace},
+					Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName, ExternalName: guardianHost},
+				},
+			}, []string{"linseed", "elasticsearch"}),
+			Entry("Elasticsearch and Kibana routed through guardian", []*corev1.Service{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: render.ESGatewayServiceName, Namespace: render.ElasticsearchNamespace},
+					Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName, ExternalName: guardianHost},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: render.KibanaServiceName, Namespace: render.KibanaNamespace},
+					Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeExternalName, ExternalName: guardianHost},
+				},
+			}, []string{"linseed", "elasticsearch", "kibana"}),
+			Entry("Kibana served in this cluster", []*corev1.Service{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: render.KibanaServiceName, Namespace: render.KibanaNamespace},
+					Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+				},
+			}, []string{"linseed"}),
+		)
+
+		It("should omit the Elasticsearch and Kibana ports for Calico", func() {
+			installation := &operatorv1.Installation{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, installation)).NotTo(HaveOccurred())
+			installation.Spec.Variant = operatorv1.Calico
+			Expect(c.Update(ctx, installation)).NotTo(HaveOccurred())
+			installation.Status.Variant = operatorv1.Calico
+			Expect(c.Status().Update(ctx, installation)).NotTo(HaveOccurred())
+
+			certificateManager, err := certificatemanager.Create(c, nil, dns.DefaultClusterDomain, common.OperatorNamespace(), certificatemanager.AllowCACreation())
+			Expect(err).NotTo(HaveOccurred())
+			apiServerSecret, err := certificateManager.GetOrCreateKeyPair(c, render.ProjectCalicoAPIServerTLSSecretName(operatorv1.Calico), common.OperatorNamespace(), []string{"a"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, apiServerSecret.Secret(common.OperatorNamespace()))).NotTo(HaveOccurred())
+
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(servicePorts()).To(Equal([]string{"linseed"}))
+		})
+	})
+
+	Context("insecure bootstrap", func() {
+		It("should configure guardian and stay degraded while insecureSkipTLSVerify is enabled", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.InsecureSkipTLSVerify = true
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: render.GuardianDeploymentName, Namespace: render.GuardianNamespace}, dpl)).NotTo(HaveOccurred())
+			Expect(dpl.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "GUARDIAN_INSECURE_SKIP_TLS_VERIFY", Value: "true"}))
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.InvalidConfigurationError, mock.MatchedBy(func(msg string) bool {
+				return strings.Contains(msg, "insecureSkipTLSVerify")
+			}), mock.Anything, mock.Anything)
+			mockStatus.AssertNotCalled(GinkgoT(), "ClearDegraded")
+		})
+
+		It("should clear the degraded condition once insecureSkipTLSVerify is disabled", func() {
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: render.GuardianDeploymentName, Namespace: render.GuardianNamespace}, dpl)).NotTo(HaveOccurred())
+			for _, env := range dpl.Spec.Template.Spec.Containers[0].Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_INSECURE_SKIP_TLS_VERIFY"))
+			}
+			mockStatus.AssertCalled(GinkgoT(), "ClearDegraded")
+		})
+	})
+
+	Context("validation", func() {
+		setExtraInitContainers := func(containers ...corev1.Container) {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.GuardianDeployment = &operatorv1.GuardianDeployment{
+				Spec: &operatorv1.GuardianDeploymentSpec{
+					Template: &operatorv1.GuardianDeploymentPodTemplateSpec{
+						Spec: &operatorv1.GuardianDeploymentPodSpec{
+							ExtraInitContainers: containers,
+						},
+					},
+				},
+			}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+		}
+
+		It("should render valid user-provided init containers", func() {
+			setExtraInitContainers(corev1.Container{Name: "fetch-token", Image: "example.com/fetch-token:v1"})
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c.Get(ctx, client.ObjectKey{Name: render.GuardianDeploymentName, Namespace: render.GuardianNamespace}, dpl)).NotTo(HaveOccurred())
+			Expect(dpl.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+			Expect(dpl.Spec.Template.Spec.InitContainers[0].Name).To(Equal("fetch-token"))
+		})
+
+		It("should reject user-provided init containers with duplicate names", func() {
+			setExtraInitContainers(
+				corev1.Container{Name: "fetch-token", Image: "example.com/fetch-token:v1"},
+				corev1.Container{Name: "fetch-token", Image: "example.com/fetch-token:v2"},
+			)
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not unique"))
+			Expect(c.Get(ctx, client.ObjectKey{Name: render.GuardianDeploymentName, Namespace: render.GuardianNamespace}, dpl)).To(HaveOccurred())
+		})
+
+		It("should reject an unsupported liveness probe type", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			probeType := operatorv1.GuardianProbeType("Exec")
+			cfg.Spec.GuardianDeployment = &operatorv1.GuardianDeployment{
+				Spec: &operatorv1.GuardianDeploymentSpec{
+					Template: &operatorv1.GuardianDeploymentPodTemplateSpec{
+						Spec: &operatorv1.GuardianDeploymentPodSpec{
+							Containers: []operatorv1.GuardianDeploymentContainer{{
+								Name:              render.GuardianDeploymentName,
+								LivenessProbeType: &probeType,
+							}},
+						},
+					},
+				},
+			}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("livenessProbeType"))
+		})
+
+		It("should reject an unsupported IP family preference", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			family := operatorv1.IPFamily("DualStack")
+			cfg.Spec.IPFamilyPreference = &family
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ipFamilyPreference"))
+		})
+
+		It("should reject a non-positive max request header size", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			maxHeaderBytes := int32(0)
+			cfg.Spec.MaxRequestHeaderBytes = &maxHeaderBytes
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("maxRequestHeaderBytes"))
+		})
+
+		It("should reject a non-positive tunnel max frame size", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			maxFrameBytes := int32(-1)
+			cfg.Spec.TunnelMaxFrameBytes = &maxFrameBytes
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("tunnelMaxFrameBytes"))
+		})
+
+		It("should reject a negative backend retry count", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			maxRetries := int32(-1)
+			cfg.Spec.BackendRetryPolicy = &operatorv1.GuardianRetryPolicy{MaxRetries: &maxRetries}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("backendRetryPolicy.maxRetries"))
+		})
+
+		It("should reject a non-positive backend retry backoff", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.BackendRetryPolicy = &operatorv1.GuardianRetryPolicy{Backoff: &metav1.Duration{}}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("backendRetryPolicy.backoff"))
+		})
+
+		It("should reject a negative DNS cache TTL", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.DNSCacheTTL = &metav1.Duration{Duration: -time.Second}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("dnsCacheTTL"))
+		})
+
+		It("should reject a non-positive heartbeat interval", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.HeartbeatInterval = &metav1.Duration{}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("heartbeatInterval"))
+		})
+
+		It("should reject a non-positive certificate expiry threshold", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.CertificateExpiryThreshold = &metav1.Duration{}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("certificateExpiryThreshold"))
+		})
+
+		It("should reject a negative shutdown drain delay", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.ShutdownDrainDelay = &metav1.Duration{Duration: -time.Second}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("shutdownDrainDelay"))
+		})
+
+		It("should reject an egress gateway without a selector", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.EgressGateway = &operatorv1.GuardianEgressGateway{Selector: " ", NamespaceSelector: "all()"}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("egressGateway.selector must be set"))
+		})
+
+		It("should reject a guardian node port outside the NodePort range", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			svcType := corev1.ServiceTypeNodePort
+			nodePort := int32(8443)
+			cfg.Spec.GuardianService = &operatorv1.GuardianService{Type: &svcType, NodePort: &nodePort}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("guardianService.nodePort"))
+		})
+
+		It("should reject a guardian node port without the NodePort service type", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			nodePort := int32(30443)
+			cfg.Spec.GuardianService = &operatorv1.GuardianService{NodePort: &nodePort}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("requires type NodePort"))
+		})
+
+		It("should reject an unsupported tunnel compression setting", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			compression := operatorv1.TunnelCompressionType("Gzip")
+			cfg.Spec.TunnelCompression = &compression
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("tunnelCompression"))
+		})
+
+		It("should reject an unsupported request coalescing setting", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			coalescing := operatorv1.RequestCoalescingType("Always")
+			cfg.Spec.RequestCoalescing = &coalescing
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("requestCoalescing"))
+		})
+
+		It("should reject an unsupported access log format", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			state := operatorv1.AccessLoggingEnabled
+			format := operatorv1.AccessLogFormat("CSV")
+			cfg.Spec.AccessLogging = &operatorv1.GuardianAccessLogging{State: &state, Format: &format}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("accessLogging.format"))
+		})
+
+		It("should reject tunnel latency buckets that are not ascending numbers", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.TunnelLatencyBuckets = []string{"0.1", "0.5", "0.5"}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("strictly ascending"))
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.TunnelLatencyBuckets = []string{"0.1", "fast"}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not a number"))
+		})
+
+		DescribeTable("should reject an invalid tunnel port", func(port int32, expected string) {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.TunnelPort = &port
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expected))
+		},
+			Entry("out of range", int32(70000), "must be between 1 and 65535"),
+			Entry("proxy port", int32(8080), "collides with a port"),
+			Entry("health port", int32(9080), "collides with a port"),
+		)
+
+		It("should reject a tunnel port that collides with the debug port while the debug config endpoint is enabled", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			enabled := operatorv1.DebugConfigEndpointEnabled
+			cfg.Spec.DebugConfigEndpoint = &enabled
+			port := int32(render.GuardianDebugPort)
+			cfg.Spec.TunnelPort = &port
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("collides with a port"))
+		})
+
+		It("should reject an unsupported debug config endpoint setting", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			e := operatorv1.DebugConfigEndpointType("Sometimes")
+			cfg.Spec.DebugConfigEndpoint = &e
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("debugConfigEndpoint"))
+		})
+
+		It("should reject a non-positive number of workers", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			workers := int32(0)
+			cfg.Spec.Workers = &workers
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.workers must be positive"))
+		})
+
+		DescribeTable("should reject a non-positive tunnel bandwidth limit", func(limit string) {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			q := resource.MustParse(limit)
+			cfg.Spec.TunnelBandwidthLimit = &q
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.tunnelBandwidthLimit must be a positive rate"))
+		},
+			Entry("zero", "0"),
+			Entry("negative", "-1Mi"),
+		)
+
+		It("should reject an invalid service account token configuration", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.ServiceAccountToken = &operatorv1.GuardianServiceAccountToken{}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("serviceAccountToken.audience must be set"))
+
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			expirationSeconds := int64(60)
+			cfg.Spec.ServiceAccountToken = &operatorv1.GuardianServiceAccountToken{Audience: "sts.amazonaws.com", ExpirationSeconds: &expirationSeconds}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("expirationSeconds must be at least 600"))
+		})
+
+		DescribeTable("should reject invalid tunnel headers", func(name, expected string) {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.TunnelHeaders = map[string]string{name: "value"}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expected))
+		},
+			Entry("reserved header", "authorization", "is managed by guardian"),
+			Entry("impersonation header", "Impersonate-User", "is managed by guardian"),
+			Entry("invalid header name", "X Tenant", "is not a valid header name"),
+		)
+
+		DescribeTable("should reject invalid cluster labels", func(key, value, expected string) {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.ClusterLabels = map[string]string{key: value}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expected))
+		},
+			Entry("invalid key", "region name", "us-east", "spec.clusterLabels: Invalid value: \"region name\""),
+			Entry("invalid value", "region", "us east", "spec.clusterLabels: Invalid value: \"us east\""),
+		)
+
+		It("should reject an invalid backend CA bundle secret name", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+			cfg.Spec.BackendCABundles = &operatorv1.GuardianBackendCABundles{Prometheus: "Prometheus_CA"}
+			Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.backendCABundles.prometheus "Prometheus_CA" is not a valid secret name`))
+		})
+
+		It("should reject user-provided init containers that reuse the guardian container name", func() {
+			setExtraInitContainers(corev1.Container{Name: render.GuardianDeploymentName, Image: "example.com/fetch-token:v1"})
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not unique"))
+		})
 	})
 
 	Context("image reconciliation", func() {
@@ -245,6 +880,79 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 				Expect(policies.Items[1].Name).To(Equal("allow-tigera.guardian-access"))
 			})
 
+			It("should satisfy the tier readiness gate of guardian pods only when the tier exists", func() {
+				gate := operatorv1.TierReadinessGateEnabled
+				Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+				cfg.Spec.TierReadinessGate = &gate
+				Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+				pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+					Name:      "tigera-guardian-abcde",
+					Namespace: render.GuardianNamespace,
+					Labels:    map[string]string{"k8s-app": render.GuardianName},
+				}}
+				pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.ContainersReady, Status: corev1.ConditionTrue}}
+				Expect(c.Create(ctx, pod)).NotTo(HaveOccurred())
+
+				tierReady := func() corev1.ConditionStatus {
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(pod), pod)).NotTo(HaveOccurred())
+					for _, cond := range pod.Status.Conditions {
+						if cond.Type == render.GuardianTierReadyConditionType {
+							return cond.Status
+						}
+					}
+					return ""
+				}
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Get(ctx, client.ObjectKey{Name: render.GuardianDeploymentName, Namespace: render.GuardianNamespace}, dpl)).NotTo(HaveOccurred())
+				Expect(dpl.Spec.Template.Spec.ReadinessGates).To(ConsistOf(corev1.PodReadinessGate{ConditionType: render.GuardianTierReadyConditionType}))
+				Expect(tierReady()).To(Equal(corev1.ConditionTrue))
+
+				Expect(c.Delete(ctx, &v3.Tier{ObjectMeta: metav1.ObjectMeta{Name: "allow-tigera"}})).NotTo(HaveOccurred())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(tierReady()).To(Equal(corev1.ConditionFalse))
+
+				// Conditions written by the kubelet are left alone.
+				Expect(pod.Status.Conditions).To(ContainElement(corev1.PodCondition{Type: corev1.ContainersReady, Status: corev1.ConditionTrue}))
+			})
+
+			DescribeTable("should only reconcile on guardian pods that are waiting on the tier readiness gate",
+				func(namespace string, gated bool, conditions []corev1.PodCondition, expected bool) {
+					pod := &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{Name: "tigera-guardian-abcde", Namespace: namespace},
+						Status:     corev1.PodStatus{Conditions: conditions},
+					}
+					if gated {
+						pod.Spec.ReadinessGates = []corev1.PodReadinessGate{{ConditionType: render.GuardianTierReadyConditionType}}
+					}
+					Expect(clusterconnection.GuardianPodNeedsTierReadyCondition(pod)).To(Equal(expected))
+				},
+				Entry("gated pod without the condition", render.GuardianNamespace, true, nil, true),
+				Entry("gated pod with the condition", render.GuardianNamespace, true,
+					[]corev1.PodCondition{{Type: render.GuardianTierReadyConditionType, Status: corev1.ConditionFalse}}, false),
+				Entry("pod without the gate", render.GuardianNamespace, false, nil, false),
+				Entry("gated pod in another namespace", "default", true, nil, false),
+			)
+
+			It("should reject the tier readiness gate for Calico", func() {
+				installation := &operatorv1.Installation{}
+				Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, installation)).NotTo(HaveOccurred())
+				installation.Spec.Variant = operatorv1.Calico
+				Expect(c.Update(ctx, installation)).NotTo(HaveOccurred())
+				installation.Status.Variant = operatorv1.Calico
+				Expect(c.Status().Update(ctx, installation)).NotTo(HaveOccurred())
+
+				gate := operatorv1.TierReadinessGateEnabled
+				Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, cfg)).NotTo(HaveOccurred())
+				cfg.Spec.TierReadinessGate = &gate
+				Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).To(MatchError(ContainSubstring("spec.tierReadinessGate is only supported with the TigeraSecureEnterprise variant")))
+			})
+
 			It("should omit allow-tigera policy and not degrade when tier is not ready", func() {
 				Expect(c.Delete(ctx, &v3.Tier{ObjectMeta: metav1.ObjectMeta{Name: "allow-tigera"}})).NotTo(HaveOccurred())
 				_, err := r.Reconcile(ctx, reconcile.Request{})
@@ -274,6 +982,7 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 			BeforeEach(func() {
 				cfg.Spec.ManagementClusterAddr = "mydomain.io:443"
 				Expect(c.Update(ctx, cfg)).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-dns", Namespace: "kube-system"}})).NotTo(HaveOccurred())
 			})
 
 			It("should render allow-tigera policy when license and tier are ready", func() {
@@ -286,6 +995,63 @@ var _ = Describe("ManagementClusterConnection controller tests", func() {
 				Expect(policies.Items).To(HaveLen(2))
 				Expect(policies.Items[0].Name).To(Equal("allow-tigera.default-deny"))
 				Expect(policies.Items[1].Name).To(Equal("allow-tigera.guardian-access"))
+				mockStatus.AssertCalled(GinkgoT(), "ClearDegraded", mock.Anything)
+			})
+
+			It("should render allow-tigera policy but degrade when no DNS server trusted by Felix is available", func() {
+				Expect(c.Delete(ctx, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-dns", Namespace: "kube-system"}})).NotTo(HaveOccurred())
+				result, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.RequeueAfter).NotTo(BeZero())
+
+				policies := v3.NetworkPolicyList{}
+				Expect(c.List(ctx, &policies)).ToNot(HaveOccurred())
+				Expect(policies.Items).To(HaveLen(2))
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, "Domain-based policy for the management cluster address requires a DNS server trusted by Felix", mock.Anything, mock.Anything)
+				mockStatus.AssertNotCalled(GinkgoT(), "ClearDegraded", mock.Anything)
+			})
+
+			It("should degrade when the DNS servers trusted by Felix refer to missing services", func() {
+				Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "default"},
+					Spec:       crdv1.FelixConfigurationSpec{DNSTrustedServers: &[]string{"k8s-service:openshift-dns/dns-default"}},
+				})).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, "Domain-based policy for the management cluster address requires a DNS server trusted by Felix", mock.Anything, mock.Anything)
+			})
+
+			It("should not degrade when a trusted DNS service in another namespace exists", func() {
+				Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "default"},
+					Spec:       crdv1.FelixConfigurationSpec{DNSTrustedServers: &[]string{"k8s-service:openshift-dns/dns-default"}},
+				})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "dns-default", Namespace: "openshift-dns"}})).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "ClearDegraded", mock.Anything)
+			})
+
+			It("should only watch the services of the DNS servers trusted by Felix", func() {
+				Expect(clusterconnection.IsDNSTrustedService(c, types.NamespacedName{Name: "kube-dns", Namespace: "kube-system"})).To(BeTrue())
+
+				Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "default"},
+					Spec:       crdv1.FelixConfigurationSpec{DNSTrustedServers: &[]string{"k8s-service:openshift-dns/dns-default", "10.96.0.10"}},
+				})).NotTo(HaveOccurred())
+				Expect(clusterconnection.IsDNSTrustedService(c, types.NamespacedName{Name: "dns-default", Namespace: "openshift-dns"})).To(BeTrue())
+				Expect(clusterconnection.IsDNSTrustedService(c, types.NamespacedName{Name: "kube-dns", Namespace: "kube-system"})).To(BeFalse())
+			})
+
+			It("should not degrade when Felix trusts a DNS server by IP", func() {
+				Expect(c.Delete(ctx, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-dns", Namespace: "kube-system"}})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "default"},
+					Spec:       crdv1.FelixConfigurationSpec{DNSTrustedServers: &[]string{"10.96.0.10"}},
+				})).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertCalled(GinkgoT(), "ClearDegraded", mock.Anything)
 			})
 
 			It("should degrade and wait when tier is ready, but license is not sufficient", func() {
@@ -25,6 +25,7 @@ import (
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/status"
+	"github.com/tigera/operator/pkg/dns"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -39,7 +40,14 @@ func NewReconcilerWithShims(
 ) reconcile.Reconciler {
 	opts := options.AddOptions{
 		ShutdownContext: context.Background(),
+		ClusterDomain:   dns.DefaultClusterDomain,
 	}
 
 	return newReconciler(cli, schema, status, provider, tierWatchReady, opts)
 }
+
+var GuardianPodNeedsTierReadyCondition = guardianPodNeedsTierReadyCondition
+
+var IsBackendCABundleSecret = isBackendCABundleSecret
+
+var IsDNSTrustedService = isDNSTrustedService
@@ -142,6 +142,9 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	if err = complianceController.WatchObject(&operatorv1.Authentication{}, eventHandler); err != nil {
 		return fmt.Errorf("compliance-controller failed to watch resource: %w", err)
 	}
+	if err = utils.AddExternalDexRootCAWatch(complianceController, mgr.GetClient(), eventHandler); err != nil {
+		return fmt.Errorf("compliance-controller failed to watch the external Dex CA secret: %w", err)
+	}
 
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(complianceController, ResourceName); err != nil {
@@ -159,6 +162,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, licenseAPIReady
 		provider:        opts.DetectedProvider,
 		status:          status.New(mgr.GetClient(), "compliance", opts.KubernetesVersion),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		licenseAPIReady: licenseAPIReady,
 		tierWatchReady:  tierWatchReady,
 		usePSP:          opts.UsePSP,
@@ -181,6 +185,7 @@ type ReconcileCompliance struct {
 	provider        operatorv1.Provider
 	status          status.StatusManager
 	clusterDomain   string
+	auditLog        bool
 	licenseAPIReady *utils.ReadyFlag
 	tierWatchReady  *utils.ReadyFlag
 	usePSP          bool
@@ -427,7 +432,7 @@ func (r *ReconcileCompliance) Reconcile(ctx context.Context, request reconcile.R
 	}
 
 	// Create a component handler to manage the rendered component.
-	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance, utils.WithInstallation(network), utils.WithAuditLog(r.auditLog, "compliance-controller"))
 
 	keyValidatorConfig, err := utils.GetKeyValidatorConfig(ctx, r.client, authenticationCR, r.clusterDomain)
 	if err != nil {
@@ -437,7 +442,7 @@ func (r *ReconcileCompliance) Reconcile(ctx context.Context, request reconcile.R
 
 	reqLogger.V(3).Info("rendering components")
 
-	namespaceComp := render.NewPassthrough(render.CreateNamespace(helper.InstallNamespace(), network.KubernetesProvider, render.PSSPrivileged))
+	namespaceComp := render.NewPassthrough(render.CreateNamespace(helper.InstallNamespace(), network, render.PSSPrivileged))
 
 	hasNoLicense := !utils.IsFeatureActive(license, common.ComplianceFeature)
 	openshift := r.provider == operatorv1.ProviderOpenShift
@@ -454,6 +459,7 @@ func (r *ReconcileCompliance) Reconcile(ctx context.Context, request reconcile.R
 		ManagementCluster:           managementCluster,
 		ManagementClusterConnection: managementClusterConnection,
 		KeyValidatorConfig:          keyValidatorConfig,
+		Authentication:              authenticationCR,
 		ClusterDomain:               r.clusterDomain,
 		HasNoLicense:                hasNoLicense,
 		UsePSP:                      r.usePSP,
@@ -115,6 +115,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions) reconcile.Recon
 		scheme:              mgr.GetScheme(),
 		provider:            opts.DetectedProvider,
 		clusterDomain:       opts.ClusterDomain,
+		auditLog:            opts.AuditLog,
 		allowedTLSAssets:    allowedAssets(opts.ClusterDomain),
 		enterpriseCRDExists: opts.EnterpriseCRDExists,
 	}
@@ -150,6 +151,7 @@ type reconcileCSR struct {
 	scheme              *runtime.Scheme
 	provider            operatorv1.Provider
 	clusterDomain       string
+	auditLog            bool
 	allowedTLSAssets    map[string]tlsAsset
 	enterpriseCRDExists bool
 }
@@ -179,7 +181,7 @@ func (r *reconcileCSR) Reconcile(ctx context.Context, request reconcile.Request)
 		needsCSRRole = monitorCR.Spec.ExternalPrometheus != nil
 	}
 
-	componentHandler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
+	componentHandler := utils.NewComponentHandler(log, r.client, r.scheme, instance, utils.WithInstallation(&instance.Spec), utils.WithAuditLog(r.auditLog, controllerName))
 	var passthrough render.Component
 	if needsCSRRole {
 		// This controller creates the cluster role for any pod in the cluster that requires certificate management.
@@ -90,6 +90,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, licenseAPIReady
 		provider:        opts.DetectedProvider,
 		status:          status.New(mgr.GetClient(), "egressgateway", opts.KubernetesVersion),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		licenseAPIReady: licenseAPIReady,
 		usePSP:          opts.UsePSP,
 	}
@@ -140,6 +141,7 @@ type ReconcileEgressGateway struct {
 	provider        operatorv1.Provider
 	status          status.StatusManager
 	clusterDomain   string
+	auditLog        bool
 	licenseAPIReady *utils.ReadyFlag
 	usePSP          bool
 }
@@ -159,7 +161,7 @@ func (r *ReconcileEgressGateway) Reconcile(ctx context.Context, request reconcil
 	}
 
 	// If there are no Egress Gateway resources, return.
-	ch := utils.NewComponentHandler(log, r.client, r.scheme, nil)
+	ch := utils.NewComponentHandler(log, r.client, r.scheme, nil, utils.WithAuditLog(r.auditLog, "egressgateway-controller"))
 	if len(egws) == 0 {
 		var objects []client.Object
 		if r.provider == operatorv1.ProviderOpenShift {
@@ -412,7 +414,7 @@ func (r *ReconcileEgressGateway) reconcileEgressGateway(ctx context.Context, egw
 	}
 
 	component := egressgateway.EgressGateway(config)
-	ch := utils.NewComponentHandler(log, r.client, r.scheme, egw)
+	ch := utils.NewComponentHandler(log, r.client, r.scheme, egw, utils.WithInstallation(installation), utils.WithAuditLog(r.auditLog, "egressgateway-controller"))
 
 	if err = imageset.ApplyImageSet(ctx, r.client, variant, component); err != nil {
 		reqLogger.Error(err, "Error with images from ImageSet")
@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installation
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operator "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+)
+
+const (
+	// This label is used to track which BGPFilters are managed by the operator. Any BGPFilter with this
+	// label key/value pair that is no longer in the Installation is deleted.
+	bgpFilterManagedByLabel = "app.kubernetes.io/managed-by"
+	bgpFilterManagedByValue = "tigera-operator"
+)
+
+// bgpFilters returns the BGPFilters to create or update from the Installation, along with the BGPFilters previously
+// created by the operator that are no longer in the Installation and should be deleted. An error is returned if a
+// BGPFilter in the Installation has the same name as an existing BGPFilter that the operator does not manage.
+func bgpFilters(install *operator.Installation, current []crdv1.BGPFilter) ([]client.Object, []client.Object, error) {
+	var desired []operator.BGPFilter
+	if install.Spec.CalicoNetwork != nil {
+		desired = install.Spec.CalicoNetwork.BGPFilters
+	}
+
+	managed := map[string]bool{}
+	for _, f := range current {
+		managed[f.Name] = f.Labels[bgpFilterManagedByLabel] == bgpFilterManagedByValue
+	}
+
+	toCreateOrUpdate := []client.Object{}
+	wanted := map[string]bool{}
+	for _, f := range desired {
+		if ours, exists := managed[f.Name]; exists && !ours {
+			return nil, nil, fmt.Errorf("BGPFilter %s already exists and is not managed by the operator", f.Name)
+		}
+		wanted[f.Name] = true
+		toCreateOrUpdate = append(toCreateOrUpdate, &crdv1.BGPFilter{
+			TypeMeta: metav1.TypeMeta{Kind: "BGPFilter", APIVersion: "crd.projectcalico.org/v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   f.Name,
+				Labels: map[string]string{bgpFilterManagedByLabel: bgpFilterManagedByValue},
+			},
+			Spec: crdv1.BGPFilterSpec{
+				ExportV4: bgpFilterRulesV4(f.ExportV4),
+				ImportV4: bgpFilterRulesV4(f.ImportV4),
+				ExportV6: bgpFilterRulesV6(f.ExportV6),
+				ImportV6: bgpFilterRulesV6(f.ImportV6),
+			},
+		})
+	}
+
+	toDelete := []client.Object{}
+	for i := range current {
+		if managed[current[i].Name] && !wanted[current[i].Name] {
+			toDelete = append(toDelete, &crdv1.BGPFilter{ObjectMeta: metav1.ObjectMeta{Name: current[i].Name}})
+		}
+	}
+
+	return toCreateOrUpdate, toDelete, nil
+}
+
+func bgpFilterRulesV4(rules []operator.BGPFilterRule) []crdv1.BGPFilterRuleV4 {
+	if len(rules) == 0 {
+		return nil
+	}
+	out := make([]crdv1.BGPFilterRuleV4, len(rules))
+	for i, r := range rules {
+		out[i] = crdv1.BGPFilterRuleV4{
+			CIDR:          r.CIDR,
+			MatchOperator: crdv1.BGPFilterMatchOperator(r.MatchOperator),
+			Action:        crdv1.BGPFilterAction(r.Action),
+		}
+	}
+	return out
+}
+
+func bgpFilterRulesV6(rules []operator.BGPFilterRule) []crdv1.BGPFilterRuleV6 {
+	if len(rules) == 0 {
+		return nil
+	}
+	out := make([]crdv1.BGPFilterRuleV6, len(rules))
+	for i, r := range rules {
+		out[i] = crdv1.BGPFilterRuleV6{
+			CIDR:          r.CIDR,
+			MatchOperator: crdv1.BGPFilterMatchOperator(r.MatchOperator),
+			Action:        crdv1.BGPFilterAction(r.Action),
+		}
+	}
+	return out
+}
@@ -55,6 +55,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	"github.com/tigera/api/pkg/lib/numorstring"
 	operator "github.com/tigera/operator/api/v1"
 	v1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/active"
@@ -74,8 +75,10 @@ import (
 	"github.com/tigera/operator/pkg/dns"
 	"github.com/tigera/operator/pkg/render"
 	rcertificatemanagement "github.com/tigera/operator/pkg/render/certificatemanagement"
+	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	"github.com/tigera/operator/pkg/render/common/resourcequota"
+	"github.com/tigera/operator/pkg/render/intrusiondetection/dpi"
 	"github.com/tigera/operator/pkg/render/kubecontrollers"
 	"github.com/tigera/operator/pkg/render/monitor"
 	"github.com/tigera/operator/pkg/tls/certificatemanagement"
@@ -87,6 +90,17 @@ const (
 	// The default port used by calico/node to report Calico Enterprise internal metrics.
 	// This is separate from the calico/node prometheus metrics port, which is user configurable.
 	defaultNodeReporterPort = 9081
+
+	// The policy sync path prefix configured on FelixConfiguration when policy sync is enabled. calico-node
+	// mounts this directory from the host so that it is shared with application layer policy integrations.
+	policySyncPathPrefix = "/var/run/nodeagent"
+
+	// The endpoint status path prefix configured on FelixConfiguration when endpoint status reporting is enabled.
+	// calico-node mounts this directory from the host so that it is shared with the CNI plugin.
+	endpointStatusPathPrefix = "/var/run/calico"
+
+	// The Installation status condition set when there are not enough nodes to schedule the control plane replicas.
+	controlPlaneSchedulableCondition = "ControlPlaneSchedulable"
 )
 
 const InstallationName string = "calico"
@@ -190,9 +204,11 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions) (*ReconcileInst
 		namespaceMigration:   nm,
 		enterpriseCRDsExist:  opts.EnterpriseCRDExists,
 		clusterDomain:        opts.ClusterDomain,
+		auditLog:             opts.AuditLog,
 		manageCRDs:           opts.ManageCRDs,
 		usePSP:               opts.UsePSP,
 		tierWatchReady:       &utils.ReadyFlag{},
+		featureGates:         opts.FeatureGates,
 	}
 	r.status.Run(opts.ShutdownContext)
 	r.typhaAutoscaler.start(opts.ShutdownContext)
@@ -269,6 +285,31 @@ func add(c ctrlruntime.Controller, r *ReconcileInstallation) error {
 		return fmt.Errorf("tigera-installation-controller failed to watch BGPConfiguration resource: %w", err)
 	}
 
+	// Watch for changes to IPAMConfig.
+	err = c.WatchObject(&crdv1.IPAMConfig{}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return fmt.Errorf("tigera-installation-controller failed to watch IPAMConfig resource: %w", err)
+	}
+
+	// Watch for changes to BGPFilters, so that changes to those managed by the operator are reverted.
+	err = c.WatchObject(&crdv1.BGPFilter{}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return fmt.Errorf("tigera-installation-controller failed to watch BGPFilter resource: %w", err)
+	}
+
+	// Watch for changes to BGPPeers, so that changes to the one managed by the operator are reverted.
+	err = c.WatchObject(&crdv1.BGPPeer{}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return fmt.Errorf("tigera-installation-controller failed to watch BGPPeer resource: %w", err)
+	}
+
+	// Watch for node label changes, so that the route reflector cluster IDs follow the nodes selected as route
+	// reflectors.
+	err = c.WatchObject(&corev1.Node{}, &handler.EnqueueRequestForObject{}, predicate.LabelChangedPredicate{})
+	if err != nil {
+		return fmt.Errorf("tigera-installation-controller failed to watch Node resource: %w", err)
+	}
+
 	if r.enterpriseCRDsExist {
 		// Watch for changes to primary resource ManagementCluster
 		err = c.WatchObject(&operator.ManagementCluster{}, &handler.EnqueueRequestForObject{})
@@ -288,6 +329,12 @@ func add(c ctrlruntime.Controller, r *ReconcileInstallation) error {
 			return fmt.Errorf("tigera-installation-controller failed to watch primary resource: %v", err)
 		}
 
+		// Watch for changes to Authentication, which configures the Dex namespace.
+		err = c.WatchObject(&operator.Authentication{}, &handler.EnqueueRequestForObject{})
+		if err != nil {
+			return fmt.Errorf("tigera-installation-controller failed to watch Authentication resource: %v", err)
+		}
+
 		// Watch the internal manager TLS secret in the operator namespace, which included in the bundle for es-kube-controllers.
 		if err = utils.AddSecretsWatch(c, render.ManagerInternalTLSSecretName, common.OperatorNamespace()); err != nil {
 			return fmt.Errorf("tigera-installation-controller failed to watch secret: %v", err)
@@ -306,6 +353,26 @@ func add(c ctrlruntime.Controller, r *ReconcileInstallation) error {
 		}
 	}
 
+	// Watch for the creation and deletion of namespaces in which a ResourceQuota may be configured,
+	// so that a configured ResourceQuota is created as soon as its namespace exists.
+	err = c.WatchObject(&corev1.Namespace{}, &handler.EnqueueRequestForObject{}, predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return r.isResourceQuotaNamespace(e.Object.GetName())
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return r.isResourceQuotaNamespace(e.Object.GetName())
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("tigera-installation-controller failed to watch Namespace resource: %w", err)
+	}
+
 	// Watch for changes to IPPool.
 	err = c.WatchObject(&crdv1.IPPool{}, &handler.EnqueueRequestForObject{})
 	if err != nil {
@@ -362,9 +429,104 @@ type ReconcileInstallation struct {
 	amazonCRDExists      bool
 	migrationChecked     bool
 	clusterDomain        string
+	auditLog             bool
 	manageCRDs           bool
 	usePSP               bool
 	tierWatchReady       *utils.ReadyFlag
+	featureGates         options.FeatureGates
+}
+
+// resourceQuotaNamespaces returns the operator managed namespaces in which a ResourceQuota may be configured,
+// given the namespace Dex is configured to run in.
+func resourceQuotaNamespaces(dexNamespace string) []string {
+	return []string{
+		common.CalicoNamespace,
+		rmeta.APIServerNamespace(operator.Calico),
+		rmeta.APIServerNamespace(operator.TigeraSecureEnterprise),
+		dexNamespace,
+		render.ElasticsearchNamespace,
+		render.KibanaNamespace,
+		render.ECKOperatorNamespace,
+		render.ComplianceNamespace,
+		render.IntrusionDetectionNamespace,
+		dpi.DeepPacketInspectionNamespace,
+		render.LogCollectorNamespace,
+		render.ManagerNamespace,
+		render.GuardianNamespace,
+		render.PacketCaptureNamespace,
+		render.PolicyRecommendationNamespace,
+		common.TigeraPrometheusNamespace,
+	}
+}
+
+// dexNamespace returns the namespace Dex is configured to run in.
+func (r *ReconcileInstallation) dexNamespace(ctx context.Context) (string, error) {
+	if !r.enterpriseCRDsExist {
+		return render.DexNamespace, nil
+	}
+	authentication, err := utils.GetAuthentication(ctx, r.client)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", err
+	}
+	return render.GetDexNamespace(authentication), nil
+}
+
+// isResourceQuotaNamespace returns whether the named namespace is one in which a ResourceQuota may be configured.
+func (r *ReconcileInstallation) isResourceQuotaNamespace(name string) bool {
+	dexNamespace, err := r.dexNamespace(context.Background())
+	if err != nil {
+		log.Error(err, "Failed to query Authentication, assuming the default Dex namespace")
+		dexNamespace = render.DexNamespace
+	}
+	for _, ns := range resourceQuotaNamespaces(dexNamespace) {
+		if name == ns {
+			return true
+		}
+	}
+	return false
+}
+
+// namespaceResourceQuotas returns the configured ResourceQuotas whose namespaces exist, as well as the
+// namespaces of previously created ResourceQuotas that are no longer configured and so should be removed.
+// Only ResourceQuotas carrying the operator's namespace quota label are considered for removal.
+func (r *ReconcileInstallation) namespaceResourceQuotas(ctx context.Context, instance *operator.Installation, dexNamespace string) ([]operator.NamespaceResourceQuota, []string, error) {
+	configured := map[string]operator.NamespaceResourceQuota{}
+	for _, q := range instance.Spec.NamespaceResourceQuotas {
+		configured[q.Namespace] = q
+	}
+
+	var quotas []operator.NamespaceResourceQuota
+	for _, ns := range resourceQuotaNamespaces(dexNamespace) {
+		q, ok := configured[ns]
+		if !ok {
+			continue
+		}
+		// The calico-system namespace is rendered alongside the quotas, so it always exists by the time they are created.
+		if ns != common.CalicoNamespace {
+			if err := r.client.Get(ctx, types.NamespacedName{Name: ns}, &corev1.Namespace{}); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, nil, err
+			}
+		}
+		quotas = append(quotas, q)
+	}
+
+	existing := corev1.ResourceQuotaList{}
+	if err := r.client.List(ctx, &existing, client.HasLabels{resourcequota.NamespaceResourceQuotaLabel}); err != nil {
+		return nil, nil, err
+	}
+	var stale []string
+	for _, rq := range existing.Items {
+		if rq.Name != resourcequota.NamespaceResourceQuotaName {
+			continue
+		}
+		if _, ok := configured[rq.Namespace]; !ok {
+			stale = append(stale, rq.Namespace)
+		}
+	}
+	return quotas, stale, nil
 }
 
 // getActivePools returns the full set of enabled IP pools in the cluster.
@@ -697,18 +859,21 @@ func fillDefaults(instance *operator.Installation, currentPools *crdv1.IPPoolLis
 		instance.Spec.KubeletVolumePluginPath = filepath.Clean("/var/lib/kubelet")
 	}
 
-	// Default rolling update parameters.
-	one := intstr.FromInt(1)
-	if instance.Spec.NodeUpdateStrategy.RollingUpdate == nil {
-		instance.Spec.NodeUpdateStrategy.RollingUpdate = &appsv1.RollingUpdateDaemonSet{}
-	}
-	if instance.Spec.NodeUpdateStrategy.RollingUpdate.MaxUnavailable == nil {
-		instance.Spec.NodeUpdateStrategy.RollingUpdate.MaxUnavailable = &one
-	}
 	if instance.Spec.NodeUpdateStrategy.Type == "" {
 		instance.Spec.NodeUpdateStrategy.Type = appsv1.RollingUpdateDaemonSetStrategyType
 	}
 
+	// Default rolling update parameters. These are not used by the OnDelete strategy.
+	if instance.Spec.NodeUpdateStrategy.Type == appsv1.RollingUpdateDaemonSetStrategyType {
+		one := intstr.FromInt(1)
+		if instance.Spec.NodeUpdateStrategy.RollingUpdate == nil {
+			instance.Spec.NodeUpdateStrategy.RollingUpdate = &appsv1.RollingUpdateDaemonSet{}
+		}
+		if instance.Spec.NodeUpdateStrategy.RollingUpdate.MaxUnavailable == nil {
+			instance.Spec.NodeUpdateStrategy.RollingUpdate.MaxUnavailable = &one
+		}
+	}
+
 	return nil
 }
 
@@ -896,6 +1061,22 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		}
 	}
 
+	// The Dex namespace is configurable on the Authentication, and may have a ResourceQuota configured.
+	dexNamespace, err := r.dexNamespace(ctx)
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceReadError, "Error querying Authentication", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if err := validateNamespaceResourceQuotas(instance.Spec.NamespaceResourceQuotas, resourceQuotaNamespaces(dexNamespace)); err != nil {
+		r.status.SetDegraded(operator.InvalidConfigurationError, "Invalid Installation provided", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	if instance.Spec.NodeUpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+		reqLogger.Info("WARNING: Installation spec.nodeUpdateStrategy.type is OnDelete. Updates to calico-node " +
+			"will not be rolled out until the existing calico-node pods are manually deleted.")
+	}
+
 	if err = r.updateCRDs(ctx, instance.Spec.Variant, reqLogger); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -1097,11 +1278,20 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		return reconcile.Result{}, err
 	}
 
-	// Set any non-default FelixConfiguration values that we need.
+	// Set any non-default FelixConfiguration values that we need. WireGuard host encryption that cannot be applied is
+	// skipped rather than blocking the reconcile, and is reported once the rest of the Installation has been reconciled.
+	var wireguardHostEncryptionErr error
 	felixConfiguration, err := utils.PatchFelixConfiguration(ctx, r.client, func(fc *crdv1.FelixConfiguration) (bool, error) {
-		return r.setDefaultsOnFelixConfiguration(ctx, instance, fc, reqLogger)
+		updated, err := r.setDefaultsOnFelixConfiguration(ctx, instance, fc, reqLogger)
+		if err != nil {
+			return false, err
+		}
+		var updatedHostEncryption bool
+		updatedHostEncryption, wireguardHostEncryptionErr = setWireguardHostEncryptionOnFelixConfiguration(instance, fc)
+		return updated || updatedHostEncryption, nil
 	})
 	if err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Error updating FelixConfiguration", err, reqLogger)
 		return reconcile.Result{}, err
 	}
 
@@ -1125,6 +1315,14 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 			return reconcile.Result{}, err
 		}
 
+		// Felix Prometheus metrics are exposed on the same metrics Service as the reporter metrics, so their ports
+		// must differ.
+		if port, enabled := render.NodeMetricsPort(&instance.Spec); enabled && int(port) == nodeReporterMetricsPort {
+			err := fmt.Errorf("nodeMetricsPort=%d conflicts with felixConfiguration prometheusReporterPort", port)
+			r.status.SetDegraded(operator.InvalidConfigurationError, "invalid metrics port", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+
 		nodePrometheusTLS, err = certificateManager.GetOrCreateKeyPair(r.client, render.NodePrometheusTLSServerSecret, common.OperatorNamespace(), dns.GetServiceDNSNames(render.CalicoNodeMetricsService, common.CalicoNamespace, r.clusterDomain))
 		if err != nil {
 			r.status.SetDegraded(operator.ResourceCreateError, "Error creating TLS certificate", err, reqLogger)
@@ -1158,6 +1356,15 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		calicoVersion = components.EnterpriseRelease
 	}
 
+	// Apply the kube-controllers settings from the Installation to the default KubeControllersConfiguration, if requested.
+	_, err = utils.PatchKubeControllersConfiguration(ctx, r.client, func(kc *crdv1.KubeControllersConfiguration) (bool, error) {
+		return setNodeControllerOnKubeControllersConfiguration(instance, kc), nil
+	})
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Unable to update KubeControllersConfiguration", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	kubeControllersMetricsPort, err := utils.GetKubeControllerMetricsPort(ctx, r.client)
 	if err != nil {
 		r.status.SetDegraded(operator.ResourceReadError, "Unable to read KubeControllersConfiguration", err, reqLogger)
@@ -1196,9 +1403,17 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 
 	components := []render.Component{}
 
+	resourceQuotas, staleResourceQuotaNamespaces, err := r.namespaceResourceQuotas(ctx, instance, dexNamespace)
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceReadError, "Error reading namespaces for resource quotas", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
 	namespaceCfg := &render.NamespaceConfiguration{
-		Installation: &instance.Spec,
-		PullSecrets:  pullSecrets,
+		Installation:                 &instance.Spec,
+		PullSecrets:                  pullSecrets,
+		ResourceQuotas:               resourceQuotas,
+		StaleResourceQuotaNamespaces: staleResourceQuotaNamespaces,
 	}
 	// Render namespaces for Calico.
 	components = append(components, render.Namespaces(namespaceCfg))
@@ -1276,14 +1491,77 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		}
 	}
 
-	// Fetch any existing default BGPConfiguration object.
-	bgpConfiguration := &crdv1.BGPConfiguration{}
-	err = r.client.Get(ctx, types.NamespacedName{Name: "default"}, bgpConfiguration)
-	if err != nil && !apierrors.IsNotFound(err) {
-		r.status.SetDegraded(operator.ResourceReadError, "Unable to read BGPConfiguration", err, reqLogger)
+	// Fetch the BGPPeer for any route reflectors previously configured through the Installation.
+	var currentRRPeer *crdv1.BGPPeer
+	rrPeer := &crdv1.BGPPeer{}
+	if err = r.client.Get(ctx, types.NamespacedName{Name: routeReflectorPeerName}, rrPeer); err == nil {
+		currentRRPeer = rrPeer
+	} else if !apierrors.IsNotFound(err) {
+		r.status.SetDegraded(operator.ResourceReadError, "Unable to read BGPPeer", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	rrPeerToCreate, rrPeerToDelete, err := routeReflectorPeer(instance, currentRRPeer)
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceValidationError, "Unable to reconcile route reflectors", err, reqLogger)
 		return reconcile.Result{}, err
 	}
 
+	// Fetch any existing default BGPConfiguration object, applying BGP settings from the Installation if requested.
+	// A graceful restart time that cannot be applied is skipped rather than blocking the reconcile, and is reported
+	// once the rest of the Installation has been reconciled.
+	var gracefulRestartErr error
+	bgpConfiguration, err := utils.PatchBGPConfiguration(ctx, r.client, func(bc *crdv1.BGPConfiguration) (bool, error) {
+		updatedIPs := setServiceClusterIPsOnBGPConfiguration(instance, bc)
+		updatedMesh := setNodeMeshOnBGPConfiguration(instance, bc, rrPeerToDelete != nil)
+		var updatedRestart bool
+		updatedRestart, gracefulRestartErr = setGracefulRestartOnBGPConfiguration(instance, bc)
+		updatedPort := setListenPortOnBGPConfiguration(instance, bc)
+		return updatedIPs || updatedMesh || updatedRestart || updatedPort, nil
+	})
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Unable to update BGPConfiguration", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	// Apply IPAM settings from the Installation to the default IPAMConfig, if requested.
+	_, err = utils.PatchIPAMConfig(ctx, r.client, func(ic *crdv1.IPAMConfig) (bool, error) {
+		return setStrictAffinityOnIPAMConfig(instance, ic), nil
+	})
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceUpdateError, "Unable to update IPAMConfig", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+
+	// Create, update and delete the BGPFilters managed through the Installation.
+	currentFilters := &crdv1.BGPFilterList{}
+	if err = r.client.List(ctx, currentFilters); err != nil {
+		r.status.SetDegraded(operator.ResourceReadError, "Unable to read BGPFilters", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	filtersToCreate, filtersToDelete, err := bgpFilters(instance, currentFilters.Items)
+	if err != nil {
+		r.status.SetDegraded(operator.ResourceValidationError, "Unable to reconcile BGPFilters", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	components = append(components,
+		render.NewPassthrough(filtersToCreate...),
+		render.NewDeletionPassthrough(filtersToDelete...),
+	)
+
+	// Set the route reflector cluster IDs on the nodes, then create, update or delete the BGPPeer that peers all
+	// nodes with the route reflectors.
+	if rrPeerToCreate != nil || rrPeerToDelete != nil {
+		if err = setRouteReflectorClusterIDs(ctx, r.client, instance); err != nil {
+			r.status.SetDegraded(operator.ResourceUpdateError, "Unable to update route reflector nodes", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		if rrPeerToCreate != nil {
+			components = append(components, render.NewPassthrough(rrPeerToCreate))
+		} else {
+			components = append(components, render.NewDeletionPassthrough(rrPeerToDelete))
+		}
+	}
+
 	// Build a configuration for rendering calico/node.
 	nodeCfg := render.NodeConfiguration{
 		K8sServiceEp:            k8sapi.Endpoint,
@@ -1302,6 +1580,7 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		FelixHealthPort:         *felixConfiguration.Spec.HealthPort,
 		BindMode:                bgpConfiguration.Spec.BindMode,
 		UsePSP:                  r.usePSP,
+		InstallationGeneration:  instance.Generation,
 	}
 	components = append(components, render.Node(&nodeCfg))
 
@@ -1357,7 +1636,7 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 	}
 
 	// Create a component handler to create or update the rendered components.
-	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance, utils.WithInstallation(&instance.Spec), utils.WithAuditLog(r.auditLog, "tigera-installation-controller"))
 	for _, component := range components {
 		if err := handler.CreateOrUpdateOrDelete(ctx, component, nil); err != nil {
 			r.status.SetDegraded(operator.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
@@ -1449,7 +1728,31 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 	// We can clear the degraded state now since as far as we know everything is in order.
 	r.status.ClearDegraded()
 
+	// Report any settings that were skipped above. This leaves the Installation degraded, but does not block it.
+	if gracefulRestartErr != nil {
+		r.status.SetDegraded(operator.ResourceValidationError, "Skipped setting the BGP graceful restart time", gracefulRestartErr, reqLogger)
+	}
+	if wireguardHostEncryptionErr != nil {
+		r.status.SetDegraded(operator.ResourceValidationError, "Skipped setting WireGuard host encryption", wireguardHostEncryptionErr, reqLogger)
+	}
+
+	// Pre-flight check that the cluster has enough nodes to schedule every control plane replica. This does not
+	// block the reconcile, it only surfaces a condition on the Installation so that the user knows why pods are pending.
+	// It runs before the availability check below since unschedulable replicas are a common reason for the
+	// control plane not becoming available.
+	capacityChanged, err := r.updateControlPlaneCapacityCondition(ctx, instance, reqLogger)
+	if err != nil {
+		reqLogger.Error(err, "Failed to check control plane capacity")
+	}
+
 	if !r.status.IsAvailable() {
+		// The rest of the status is only written once everything is available, but persist the capacity
+		// condition now so that it explains why.
+		if capacityChanged {
+			if err = r.client.Status().Update(ctx, instance); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
 		// Schedule a kick to check again in the near future. Hopefully by then
 		// things will be available.
 		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
@@ -1469,6 +1772,7 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 		instance.Status.ImageSet = imageSet.Name
 	}
 	instance.Status.Computed = &instance.Spec
+
 	if err = r.client.Status().Update(ctx, instance); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -1477,6 +1781,89 @@ func (r *ReconcileInstallation) Reconcile(ctx context.Context, request reconcile
 	return reconcile.Result{}, nil
 }
 
+// updateControlPlaneCapacityCondition lists the nodes in the cluster and sets the ControlPlaneSchedulable condition
+// on the Installation status when there are fewer nodes able to run control plane pods than configured
+// control plane replicas. The condition is removed once enough nodes are available. It returns whether the
+// condition changed.
+func (r *ReconcileInstallation) updateControlPlaneCapacityCondition(ctx context.Context, instance *operator.Installation, reqLogger logr.Logger) (bool, error) {
+	if instance.Spec.ControlPlaneReplicas == nil {
+		return false, nil
+	}
+	nodes := &corev1.NodeList{}
+	if err := r.client.List(ctx, nodes); err != nil {
+		return false, err
+	}
+	if len(nodes.Items) == 0 {
+		// No nodes have registered yet, so there is nothing to check against.
+		return false, nil
+	}
+
+	var previous *metav1.Condition
+	if c := meta.FindStatusCondition(instance.Status.Conditions, controlPlaneSchedulableCondition); c != nil {
+		previous = c.DeepCopy()
+	}
+
+	replicas := *instance.Spec.ControlPlaneReplicas
+	schedulable := countControlPlaneSchedulableNodes(nodes.Items, &instance.Spec)
+	if int32(schedulable) >= replicas {
+		meta.RemoveStatusCondition(&instance.Status.Conditions, controlPlaneSchedulableCondition)
+		return previous != nil, nil
+	}
+
+	msg := fmt.Sprintf("spec.controlPlaneReplicas is %d but only %d node(s) can schedule control plane pods; some replicas will remain pending", replicas, schedulable)
+	reqLogger.Info("Insufficient nodes for control plane replicas", "replicas", replicas, "schedulableNodes", schedulable)
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:               controlPlaneSchedulableCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             "InsufficientNodes",
+		Message:            msg,
+		ObservedGeneration: instance.Generation,
+	})
+	return previous == nil || previous.Message != msg || previous.ObservedGeneration != instance.Generation, nil
+}
+
+// countControlPlaneSchedulableNodes returns the number of Linux nodes that accept new pods, match the control
+// plane node selector and whose NoSchedule and NoExecute taints are tolerated by the control plane tolerations.
+func countControlPlaneSchedulableNodes(nodes []corev1.Node, spec *operator.InstallationSpec) int {
+	tolerations := append(spec.ControlPlaneTolerations, rmeta.TolerateControlPlane...)
+	count := 0
+	for _, n := range nodes {
+		if n.Spec.Unschedulable || n.Labels["kubernetes.io/os"] != "linux" {
+			continue
+		}
+		matches := true
+		for k, v := range spec.ControlPlaneNodeSelector {
+			if n.Labels[k] != v {
+				matches = false
+				break
+			}
+		}
+		if matches && toleratesNodeTaints(n.Spec.Taints, tolerations) {
+			count++
+		}
+	}
+	return count
+}
+
+func toleratesNodeTaints(taints []corev1.Taint, tolerations []corev1.Toleration) bool {
+	for i := range taints {
+		if taints[i].Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		tolerated := false
+		for _, t := range tolerations {
+			if t.ToleratesTaint(&taints[i]) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
 func readMTUFile() (int, error) {
 	filename := "/var/lib/calico/mtu"
 	data, err := os.ReadFile(filename)
@@ -1623,6 +2010,13 @@ func (r *ReconcileInstallation) setDefaultsOnFelixConfiguration(ctx context.Cont
 		updated = true
 	}
 
+	// Enable experimental sidecar acceleration if the feature gate is set, unless the user has configured it explicitly.
+	if r.featureGates.Enabled(options.FeatureGateSidecarAcceleration) && fc.Spec.SidecarAccelerationEnabled == nil {
+		enabled := true
+		fc.Spec.SidecarAccelerationEnabled = &enabled
+		updated = true
+	}
+
 	if install.Spec.Variant == operator.TigeraSecureEnterprise {
 		// Some platforms need a different default setting for dnsTrustedServers, because their DNS service is not named "kube-dns".
 		dnsService := ""
@@ -1654,6 +2048,185 @@ func (r *ReconcileInstallation) setDefaultsOnFelixConfiguration(ctx context.Cont
 		}
 	}
 
+	// Override the failsafe ports if they are configured on the Installation.
+	if fp := install.Spec.FailsafePorts; fp != nil {
+		if fp.Inbound != nil {
+			inbound := convertFailsafePorts(*fp.Inbound)
+			if fc.Spec.FailsafeInboundHostPorts == nil || !reflect.DeepEqual(*fc.Spec.FailsafeInboundHostPorts, inbound) {
+				fc.Spec.FailsafeInboundHostPorts = &inbound
+				updated = true
+			}
+		}
+		if fp.Outbound != nil {
+			outbound := convertFailsafePorts(*fp.Outbound)
+			if fc.Spec.FailsafeOutboundHostPorts == nil || !reflect.DeepEqual(*fc.Spec.FailsafeOutboundHostPorts, outbound) {
+				fc.Spec.FailsafeOutboundHostPorts = &outbound
+				updated = true
+			}
+		}
+	}
+
+	// Configure XDP acceleration if it is set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.XDPAcceleration != nil {
+		xdpEnabled := *cn.XDPAcceleration != operator.XDPAccelerationDisabled
+		genericXDPEnabled := *cn.XDPAcceleration == operator.XDPAccelerationGeneric
+		if fc.Spec.XDPEnabled == nil || *fc.Spec.XDPEnabled != xdpEnabled {
+			fc.Spec.XDPEnabled = &xdpEnabled
+			updated = true
+		}
+		if fc.Spec.GenericXDPEnabled == nil || *fc.Spec.GenericXDPEnabled != genericXDPEnabled {
+			fc.Spec.GenericXDPEnabled = &genericXDPEnabled
+			updated = true
+		}
+	}
+
+	// Configure the BPF external service mode if it is set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.BPFExternalServiceMode != nil {
+		if mode := string(*cn.BPFExternalServiceMode); fc.Spec.BPFExternalServiceMode != mode {
+			fc.Spec.BPFExternalServiceMode = mode
+			updated = true
+		}
+	}
+
+	// Configure BPF connect-time load balancing if it is set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.BPFConnectTimeLoadBalancing != nil {
+		if ctlb := crdv1.BPFConnectTimeLBType(*cn.BPFConnectTimeLoadBalancing); fc.Spec.BPFConnectTimeLoadBalancing == nil || *fc.Spec.BPFConnectTimeLoadBalancing != ctlb {
+			fc.Spec.BPFConnectTimeLoadBalancing = &ctlb
+			updated = true
+		}
+	}
+
+	// Configure the default endpoint to host action if it is set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.DefaultEndpointToHostAction != nil {
+		if action := string(*cn.DefaultEndpointToHostAction); fc.Spec.DefaultEndpointToHostAction != action {
+			fc.Spec.DefaultEndpointToHostAction = action
+			updated = true
+		}
+	}
+
+	// Configure the AWS source/destination check if it is set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.AWSSrcDstCheck != nil {
+		if check := crdv1.AWSSrcDstCheckOption(*cn.AWSSrcDstCheck); fc.Spec.AWSSrcDstCheck == nil || *fc.Spec.AWSSrcDstCheck != check {
+			fc.Spec.AWSSrcDstCheck = &check
+			updated = true
+		}
+	}
+
+	// Configure floating IPs if they are set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.FloatingIPs != nil {
+		if fips := crdv1.FloatingIPType(*cn.FloatingIPs); fc.Spec.FloatingIPs == nil || *fc.Spec.FloatingIPs != fips {
+			fc.Spec.FloatingIPs = &fips
+			updated = true
+		}
+	}
+
+	// Configure the NAT port range if it is set on the Installation. It is validated before we get here.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.NATPortRange != nil {
+		portRange, err := numorstring.PortFromString(*cn.NATPortRange)
+		if err != nil {
+			return false, err
+		}
+		if fc.Spec.NATPortRange == nil || *fc.Spec.NATPortRange != portRange {
+			fc.Spec.NATPortRange = &portRange
+			updated = true
+		}
+	}
+
+	// Configure the removal of external routes if it is set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.RemoveExternalRoutes != nil {
+		remove := *cn.RemoveExternalRoutes == operator.RemoveExternalRoutesEnabled
+		if fc.Spec.RemoveExternalRoutes == nil || *fc.Spec.RemoveExternalRoutes != remove {
+			fc.Spec.RemoveExternalRoutes = &remove
+			updated = true
+		}
+	}
+
+	// Configure the VXLAN port if it is set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.VXLANPort != nil {
+		if port := int(*cn.VXLANPort); fc.Spec.VXLANPort == nil || *fc.Spec.VXLANPort != port {
+			fc.Spec.VXLANPort = &port
+			updated = true
+		}
+	}
+
+	// Keep the Felix Prometheus metrics settings in line with the port calico-node serves and is scraped on, so that
+	// FelixConfiguration does not report a different port than the one in use.
+	if setNodeMetricsOnFelixConfiguration(install, fc) {
+		updated = true
+	}
+
+	// Configure how long Felix keeps IPs learned from DNS if it is set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.DNSExtraTTL != nil {
+		if fc.Spec.DNSExtraTTL == nil || *fc.Spec.DNSExtraTTL != *cn.DNSExtraTTL {
+			ttl := *cn.DNSExtraTTL
+			fc.Spec.DNSExtraTTL = &ttl
+			updated = true
+		}
+	}
+
+	// Configure how often Felix cleans up stale conntrack entries if it is set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil {
+		if i := cn.ConntrackCleanupInterval; i != nil && (fc.Spec.ConntrackCleanupInterval == nil || *fc.Spec.ConntrackCleanupInterval != *i) {
+			interval := *i
+			fc.Spec.ConntrackCleanupInterval = &interval
+			updated = true
+		}
+		if i := cn.BPFConntrackCleanupInterval; i != nil && (fc.Spec.BPFConntrackCleanupInterval == nil || *fc.Spec.BPFConntrackCleanupInterval != *i) {
+			interval := *i
+			fc.Spec.BPFConntrackCleanupInterval = &interval
+			updated = true
+		}
+	}
+
+	// Configure how often Felix refreshes its iptables rules if it is set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil {
+		if i := cn.IptablesRefreshInterval; i != nil && (fc.Spec.IptablesRefreshInterval == nil || *fc.Spec.IptablesRefreshInterval != *i) {
+			interval := *i
+			fc.Spec.IptablesRefreshInterval = &interval
+			updated = true
+		}
+	}
+
+	// Size the BPF dataplane's maps if set on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.BPFMapSizes != nil {
+		for _, m := range []struct {
+			size   *int32
+			target **int
+		}{
+			{cn.BPFMapSizes.Conntrack, &fc.Spec.BPFMapSizeConntrack},
+			{cn.BPFMapSizes.NATFrontend, &fc.Spec.BPFMapSizeNATFrontend},
+			{cn.BPFMapSizes.NATBackend, &fc.Spec.BPFMapSizeNATBackend},
+			{cn.BPFMapSizes.Route, &fc.Spec.BPFMapSizeRoute},
+		} {
+			if m.size == nil {
+				continue
+			}
+			if size := int(*m.size); *m.target == nil || **m.target != size {
+				*m.target = &size
+				updated = true
+			}
+		}
+	}
+
+	// Serve the policy sync API from the directory calico-node shares with application layer policy integrations,
+	// if enabled on the Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.PolicySync != nil && *cn.PolicySync == operator.PolicySyncEnabled {
+		if fc.Spec.PolicySyncPathPrefix != policySyncPathPrefix {
+			fc.Spec.PolicySyncPathPrefix = policySyncPathPrefix
+			updated = true
+		}
+	}
+
+	// Report workload endpoint status to the directory calico-node shares with the CNI plugin, if enabled on the
+	// Installation.
+	if cn := install.Spec.CalicoNetwork; cn != nil && cn.EndpointStatusReporting != nil && *cn.EndpointStatusReporting == operator.EndpointStatusReportingEnabled {
+		if fc.Spec.EndpointStatusPathPrefix == nil || *fc.Spec.EndpointStatusPathPrefix != endpointStatusPathPrefix {
+			prefix := endpointStatusPathPrefix
+			fc.Spec.EndpointStatusPathPrefix = &prefix
+			updated = true
+		}
+	}
+
 	// If BPF is enabled, but not set on FelixConfiguration, do so here. This could happen when an older
 	// version of operator is replaced by the new one. Older versions of the operator used an
 	// environment variable to enable BPF, but we no longer do so. In order to prevent disruption
@@ -1688,6 +2261,212 @@ func (r *ReconcileInstallation) setDefaultsOnFelixConfiguration(ctx context.Cont
 	return updated, nil
 }
 
+// convertFailsafePorts converts the Installation failsafe ports into their FelixConfiguration form.
+func convertFailsafePorts(ports []operator.ProtoPort) []crdv1.ProtoPort {
+	out := make([]crdv1.ProtoPort, 0, len(ports))
+	for _, p := range ports {
+		out = append(out, crdv1.ProtoPort{Protocol: p.Protocol, Port: uint16(p.Port), Net: p.Net})
+	}
+	return out
+}
+
+// setServiceClusterIPsOnBGPConfiguration sets the service cluster IPs to advertise on the BGPConfiguration
+// when they are configured on the Installation. It returns true if the BGPConfiguration was changed.
+func setServiceClusterIPsOnBGPConfiguration(install *operator.Installation, bc *crdv1.BGPConfiguration) bool {
+	cn := install.Spec.CalicoNetwork
+	if cn == nil || len(cn.AdvertisedServiceClusterIPs) == 0 || cn.BGP == nil || *cn.BGP != operator.BGPEnabled {
+		// Leave any service cluster IPs configured directly on the BGPConfiguration alone.
+		return false
+	}
+
+	blocks := []crdv1.ServiceClusterIPBlock{}
+	for _, cidr := range cn.AdvertisedServiceClusterIPs {
+		blocks = append(blocks, crdv1.ServiceClusterIPBlock{CIDR: cidr})
+	}
+	if reflect.DeepEqual(bc.Spec.ServiceClusterIPs, blocks) {
+		return false
+	}
+	bc.Spec.ServiceClusterIPs = blocks
+	return true
+}
+
+// setNodeMetricsOnFelixConfiguration enables the Felix Prometheus metrics on the node metrics port when it is set on
+// the Installation. The port is recorded in an annotation, so that the settings can be reverted once the port is
+// removed from the Installation. They are only reverted if nobody else has changed them since. It returns true if
+// the FelixConfiguration was changed.
+func setNodeMetricsOnFelixConfiguration(install *operator.Installation, fc *crdv1.FelixConfiguration) bool {
+	updated := false
+	if port, enabled := render.NodeMetricsPort(&install.Spec); enabled {
+		if fc.Spec.PrometheusMetricsEnabled == nil || !*fc.Spec.PrometheusMetricsEnabled {
+			fc.Spec.PrometheusMetricsEnabled = &enabled
+			updated = true
+		}
+		if p := int(port); fc.Spec.PrometheusMetricsPort == nil || *fc.Spec.PrometheusMetricsPort != p {
+			fc.Spec.PrometheusMetricsPort = &p
+			updated = true
+		}
+		text := strconv.Itoa(int(port))
+		if fc.Annotations[render.NodeMetricsPortOperatorAnnotation] != text {
+			if fc.Annotations == nil {
+				fc.Annotations = map[string]string{}
+			}
+			fc.Annotations[render.NodeMetricsPortOperatorAnnotation] = text
+			updated = true
+		}
+		return updated
+	}
+
+	text, ok := fc.Annotations[render.NodeMetricsPortOperatorAnnotation]
+	if !ok {
+		return false
+	}
+	delete(fc.Annotations, render.NodeMetricsPortOperatorAnnotation)
+	owned := fc.Spec.PrometheusMetricsEnabled != nil && *fc.Spec.PrometheusMetricsEnabled &&
+		fc.Spec.PrometheusMetricsPort != nil && strconv.Itoa(*fc.Spec.PrometheusMetricsPort) == text
+	if owned {
+		fc.Spec.PrometheusMetricsEnabled = nil
+		fc.Spec.PrometheusMetricsPort = nil
+	}
+	return true
+}
+
+// setWireguardHostEncryptionOnFelixConfiguration sets WireGuard host encryption on the FelixConfiguration when it is
+// configured on the Installation. It returns true if the FelixConfiguration was changed, and an error if host
+// encryption is to be enabled but WireGuard itself is not enabled on the FelixConfiguration.
+func setWireguardHostEncryptionOnFelixConfiguration(install *operator.Installation, fc *crdv1.FelixConfiguration) (bool, error) {
+	cn := install.Spec.CalicoNetwork
+	if cn == nil || cn.WireguardHostEncryption == nil {
+		return false, nil
+	}
+	enabled := *cn.WireguardHostEncryption == operator.WireguardHostEncryptionEnabled
+	wireguardEnabled := (fc.Spec.WireguardEnabled != nil && *fc.Spec.WireguardEnabled) || (fc.Spec.WireguardEnabledV6 != nil && *fc.Spec.WireguardEnabledV6)
+	if enabled && !wireguardEnabled {
+		return false, fmt.Errorf("spec.calicoNetwork.wireguardHostEncryption requires WireGuard to be enabled in the default FelixConfiguration")
+	}
+	if fc.Spec.WireguardHostEncryptionEnabled != nil && *fc.Spec.WireguardHostEncryptionEnabled == enabled {
+		return false, nil
+	}
+	fc.Spec.WireguardHostEncryptionEnabled = &enabled
+	return true, nil
+}
+
+// setGracefulRestartOnBGPConfiguration sets the node-to-node mesh graceful restart time on the BGPConfiguration
+// when it is configured on the Installation. It returns true if the BGPConfiguration was changed, and an error if
+// the node-to-node mesh the restart time applies to is disabled on the BGPConfiguration.
+func setGracefulRestartOnBGPConfiguration(install *operator.Installation, bc *crdv1.BGPConfiguration) (bool, error) {
+	cn := install.Spec.CalicoNetwork
+	if cn == nil || cn.BGPGracefulRestartTime == nil || cn.BGP == nil || *cn.BGP != operator.BGPEnabled {
+		// Leave any restart time configured directly on the BGPConfiguration alone.
+		return false, nil
+	}
+	if bc.Spec.NodeToNodeMeshEnabled != nil && !*bc.Spec.NodeToNodeMeshEnabled {
+		return false, fmt.Errorf("spec.calicoNetwork.bgpGracefulRestartTime applies to the node-to-node mesh, which is disabled in the default BGPConfiguration")
+	}
+	if bc.Spec.NodeMeshMaxRestartTime != nil && *bc.Spec.NodeMeshMaxRestartTime == *cn.BGPGracefulRestartTime {
+		return false, nil
+	}
+	t := *cn.BGPGracefulRestartTime
+	bc.Spec.NodeMeshMaxRestartTime = &t
+	return true, nil
+}
+
+// setListenPortOnBGPConfiguration sets the BGP listen port on the BGPConfiguration when it is configured on
+// the Installation. It returns true if the BGPConfiguration was changed.
+func setListenPortOnBGPConfiguration(install *operator.Installation, bc *crdv1.BGPConfiguration) bool {
+	cn := install.Spec.CalicoNetwork
+	if cn == nil || cn.BGPListenPort == nil || cn.BGP == nil || *cn.BGP != operator.BGPEnabled {
+		// Leave any listen port configured directly on the BGPConfiguration alone.
+		return false
+	}
+	port := uint16(*cn.BGPListenPort)
+	if bc.Spec.ListenPort == port {
+		return false
+	}
+	bc.Spec.ListenPort = port
+	return true
+}
+
+// setStrictAffinityOnIPAMConfig sets strict affinity on the IPAMConfig when it is configured on the Installation.
+// It returns true if the IPAMConfig was changed.
+func setStrictAffinityOnIPAMConfig(install *operator.Installation, ic *crdv1.IPAMConfig) bool {
+	cni := install.Spec.CNI
+	if cni == nil || cni.IPAM == nil || cni.IPAM.Type != operator.IPAMPluginCalico || cni.IPAM.StrictAffinity == nil {
+		// Leave any strict affinity configured directly on the IPAMConfig alone.
+		return false
+	}
+	if ic.Spec.StrictAffinity == *cni.IPAM.StrictAffinity {
+		return false
+	}
+	ic.Spec.StrictAffinity = *cni.IPAM.StrictAffinity
+	return true
+}
+
+// setNodeControllerOnKubeControllersConfiguration sets the node controller settings configured on the Installation
+// on the KubeControllersConfiguration. It returns true if the KubeControllersConfiguration was changed.
+func setNodeControllerOnKubeControllersConfiguration(install *operator.Installation, kc *crdv1.KubeControllersConfiguration) bool {
+	if install.Spec.KubeControllersConfig == nil || install.Spec.KubeControllersConfig.Node == nil {
+		// Leave the KubeControllersConfiguration alone.
+		return false
+	}
+	desired := install.Spec.KubeControllersConfig.Node
+	if kc.Spec.Controllers.Node == nil {
+		kc.Spec.Controllers.Node = &crdv1.NodeControllerConfig{}
+	}
+	nc := kc.Spec.Controllers.Node
+	original := nc.DeepCopy()
+
+	if desired.ReconcilerPeriod != nil {
+		nc.ReconcilerPeriod = desired.ReconcilerPeriod.DeepCopy()
+	}
+	if desired.LeakGracePeriod != nil {
+		nc.LeakGracePeriod = desired.LeakGracePeriod.DeepCopy()
+	}
+	if desired.AutoHostEndpoints != nil {
+		if nc.HostEndpoint == nil {
+			nc.HostEndpoint = &crdv1.AutoHostEndpointConfig{}
+		}
+		nc.HostEndpoint.AutoCreate = string(*desired.AutoHostEndpoints)
+	}
+	if nc.HostEndpoint != nil {
+		setAutoHostEndpointTemplate(nc.HostEndpoint, desired.AutoHostEndpointInterfacePattern)
+	}
+
+	return !reflect.DeepEqual(original, nc)
+}
+
+// autoHostEndpointTemplateName is the name of the host endpoint template managed by the operator.
+const autoHostEndpointTemplateName = "operator"
+
+// setAutoHostEndpointTemplate makes the operator managed host endpoint template match the given interface pattern.
+// When a pattern is set, the template replaces the default host endpoint. Templates that are not managed by the
+// operator are left alone.
+func setAutoHostEndpointTemplate(hep *crdv1.AutoHostEndpointConfig, interfacePattern string) {
+	var templates []crdv1.Template
+	managed := false
+	for _, t := range hep.Templates {
+		if t.GenerateName == autoHostEndpointTemplateName {
+			managed = true
+			continue
+		}
+		templates = append(templates, t)
+	}
+
+	if interfacePattern == "" {
+		if managed {
+			// Restore the default host endpoint that was disabled in favour of the operator managed template.
+			hep.CreateDefaultHostEndpoint = ""
+		}
+		hep.Templates = templates
+		return
+	}
+
+	hep.CreateDefaultHostEndpoint = "Disabled"
+	hep.Templates = append(templates, crdv1.Template{
+		GenerateName:     autoHostEndpointTemplateName,
+		InterfacePattern: interfacePattern,
+	})
+}
+
 // setBPFUpdatesOnFelixConfiguration will take the passed in fc and update any BPF properties needed
 // based on the install config and the daemonset.
 func (r *ReconcileInstallation) setBPFUpdatesOnFelixConfiguration(ctx context.Context, install *operator.Installation, fc *crdv1.FelixConfiguration, reqLogger logr.Logger) (bool, error) {
@@ -1766,7 +2545,7 @@ func (r *ReconcileInstallation) updateCRDs(ctx context.Context, variant operator
 	crdComponent := render.NewPassthrough(crds.ToRuntimeObjects(crds.GetCRDs(variant)...)...)
 	// Specify nil for the CR so no ownership is put on the CRDs. We do this so removing the
 	// Installation CR will not remove the CRDs.
-	handler := utils.NewComponentHandler(log, r.client, r.scheme, nil)
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, nil, utils.WithAuditLog(r.auditLog, "tigera-installation-controller"))
 	if err := handler.CreateOrUpdateOrDelete(ctx, crdComponent, nil); err != nil {
 		r.status.SetDegraded(operator.ResourceUpdateError, "Error creating / updating CRD resource", err, log)
 		return err
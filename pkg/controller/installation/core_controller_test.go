@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -32,6 +33,9 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	schedv1 "k8s.io/api/scheduling/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -49,10 +53,12 @@ import (
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
+	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
 	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/ptr"
 	"github.com/tigera/operator/pkg/render"
 	"github.com/tigera/operator/pkg/render/common/secret"
 	"github.com/tigera/operator/pkg/render/monitor"
@@ -874,6 +880,830 @@ var _ = Describe("Testing core-controller installation", func() {
 			Expect(*fc.Spec.BPFEnabled).To(BeFalse())
 		})
 
+		It("should create resource quotas only in configured namespaces that exist", func() {
+			cr.Spec.NamespaceResourceQuotas = []operator.NamespaceResourceQuota{
+				{Namespace: "calico-system", Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("50")}}},
+				{Namespace: "tigera-manager", Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")}}},
+			}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rq := &corev1.ResourceQuota{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-namespace-quota", Namespace: "calico-system"}, rq)).NotTo(HaveOccurred())
+			Expect(rq.Spec.Hard.Pods().Value()).To(BeEquivalentTo(50))
+			err = c.Get(ctx, types.NamespacedName{Name: "tigera-namespace-quota", Namespace: "tigera-manager"}, rq)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should only remove resource quotas that were created by the operator", func() {
+			Expect(c.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "tigera-manager"}})).NotTo(HaveOccurred())
+			owned := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{
+				Name:      "tigera-namespace-quota",
+				Namespace: "tigera-manager",
+				Labels:    map[string]string{"operator.tigera.io/namespace-quota": "true"},
+			}}
+			Expect(c.Create(ctx, owned)).NotTo(HaveOccurred())
+			userCreated := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: "tigera-namespace-quota", Namespace: "calico-system"}}
+			Expect(c.Create(ctx, userCreated)).NotTo(HaveOccurred())
+
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rq := &corev1.ResourceQuota{}
+			err = c.Get(ctx, types.NamespacedName{Name: "tigera-namespace-quota", Namespace: "tigera-manager"}, rq)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-namespace-quota", Namespace: "calico-system"}, rq)).NotTo(HaveOccurred())
+		})
+
+		It("should not set failsafe ports on FelixConfiguration by default", func() {
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.FailsafeInboundHostPorts).To(BeNil())
+			Expect(fc.Spec.FailsafeOutboundHostPorts).To(BeNil())
+		})
+
+		It("should propagate failsafe ports from the Installation to FelixConfiguration", func() {
+			inbound := []operator.ProtoPort{{Protocol: "TCP", Port: 22}, {Protocol: "UDP", Port: 68, Net: "10.0.0.0/8"}}
+			cr.Spec.FailsafePorts = &operator.FailsafePorts{Inbound: &inbound}
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: crdv1.FelixConfigurationSpec{
+					FailsafeInboundHostPorts:  &[]crdv1.ProtoPort{{Protocol: "TCP", Port: 2222}},
+					FailsafeOutboundHostPorts: &[]crdv1.ProtoPort{{Protocol: "UDP", Port: 53}},
+				},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(*fc.Spec.FailsafeInboundHostPorts).To(Equal([]crdv1.ProtoPort{
+				{Protocol: "TCP", Port: 22},
+				{Protocol: "UDP", Port: 68, Net: "10.0.0.0/8"},
+			}))
+			// Outbound ports are not configured on the Installation, so they are left alone.
+			Expect(*fc.Spec.FailsafeOutboundHostPorts).To(Equal([]crdv1.ProtoPort{{Protocol: "UDP", Port: 53}}))
+		})
+
+		It("should not set XDP on FelixConfiguration by default", func() {
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.XDPEnabled).To(BeNil())
+			Expect(fc.Spec.GenericXDPEnabled).To(BeNil())
+		})
+
+		table.DescribeTable("should propagate XDP acceleration from the Installation to FelixConfiguration",
+			func(xdp operator.XDPAccelerationType, expectXDP, expectGeneric bool) {
+				cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{XDPAcceleration: &xdp}
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				fc := &crdv1.FelixConfiguration{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+				Expect(*fc.Spec.XDPEnabled).To(Equal(expectXDP))
+				Expect(*fc.Spec.GenericXDPEnabled).To(Equal(expectGeneric))
+			},
+			table.Entry("Disabled", operator.XDPAccelerationDisabled, false, false),
+			table.Entry("Enabled", operator.XDPAccelerationEnabled, true, false),
+			table.Entry("Generic", operator.XDPAccelerationGeneric, true, true),
+		)
+
+		It("should set the policy sync path prefix on FelixConfiguration when policy sync is enabled", func() {
+			ps := operator.PolicySyncEnabled
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{PolicySync: &ps}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.PolicySyncPathPrefix).To(Equal("/var/run/nodeagent"))
+		})
+
+		table.DescribeTable("should leave the policy sync path prefix on FelixConfiguration alone unless policy sync is enabled",
+			func(ps operator.PolicySyncType) {
+				cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{}
+				if ps != "" {
+					cr.Spec.CalicoNetwork.PolicySync = &ps
+				}
+				Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "default"},
+					Spec:       crdv1.FelixConfigurationSpec{PolicySyncPathPrefix: "/var/run/custom"},
+				})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				fc := &crdv1.FelixConfiguration{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+				Expect(fc.Spec.PolicySyncPathPrefix).To(Equal("/var/run/custom"))
+			},
+			table.Entry("not set", operator.PolicySyncType("")),
+			table.Entry("Disabled", operator.PolicySyncDisabled),
+		)
+
+		It("should set the endpoint status path prefix on FelixConfiguration when endpoint status reporting is enabled", func() {
+			esr := operator.EndpointStatusReportingEnabled
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{EndpointStatusReporting: &esr}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.EndpointStatusPathPrefix).NotTo(BeNil())
+			Expect(*fc.Spec.EndpointStatusPathPrefix).To(Equal("/var/run/calico"))
+		})
+
+		table.DescribeTable("should leave the endpoint status path prefix on FelixConfiguration alone unless endpoint status reporting is enabled",
+			func(esr operator.EndpointStatusReportingType, existing *string) {
+				cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{}
+				if esr != "" {
+					cr.Spec.CalicoNetwork.EndpointStatusReporting = &esr
+				}
+				Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "default"},
+					Spec:       crdv1.FelixConfigurationSpec{EndpointStatusPathPrefix: existing},
+				})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				fc := &crdv1.FelixConfiguration{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+				Expect(fc.Spec.EndpointStatusPathPrefix).To(Equal(existing))
+			},
+			table.Entry("not set", operator.EndpointStatusReportingType(""), nil),
+			table.Entry("not set with a custom prefix", operator.EndpointStatusReportingType(""), ptr.ToPtr("/var/run/custom")),
+			table.Entry("Disabled with a custom prefix", operator.EndpointStatusReportingDisabled, ptr.ToPtr("/var/run/custom")),
+		)
+
+		It("should degrade when XDP acceleration is enabled with the BPF dataplane", func() {
+			xdp := operator.XDPAccelerationEnabled
+			dp := operator.LinuxDataplaneBPF
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{XDPAcceleration: &xdp, LinuxDataplane: &dp}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			mockStatus.On("SetDegraded", operator.InvalidConfigurationError, "Invalid Installation provided", mock.Anything, mock.Anything).Return()
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("xdpAcceleration"))
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operator.InvalidConfigurationError, "Invalid Installation provided", mock.Anything, mock.Anything)
+		})
+
+		It("should not create a BGPConfiguration by default", func() {
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			err = c.Get(ctx, types.NamespacedName{Name: "default"}, &crdv1.BGPConfiguration{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should propagate advertised service cluster IPs from the Installation to BGPConfiguration", func() {
+			bgp := operator.BGPEnabled
+			cr.Spec.ServiceCIDRs = []string{"10.96.0.0/12"}
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{
+				BGP:                         &bgp,
+				AdvertisedServiceClusterIPs: []string{"10.96.0.0/16"},
+			}
+			Expect(c.Create(ctx, &crdv1.BGPConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.BGPConfigurationSpec{LogSeverityScreen: "Debug"},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			bc := &crdv1.BGPConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, bc)).ShouldNot(HaveOccurred())
+			Expect(bc.Spec.ServiceClusterIPs).To(Equal([]crdv1.ServiceClusterIPBlock{{CIDR: "10.96.0.0/16"}}))
+			// Settings not managed through the Installation are left alone.
+			Expect(bc.Spec.LogSeverityScreen).To(Equal("Debug"))
+		})
+
+		It("should propagate the BGP graceful restart time from the Installation to BGPConfiguration", func() {
+			bgp := operator.BGPEnabled
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{
+				BGP:                    &bgp,
+				BGPGracefulRestartTime: &metav1.Duration{Duration: 300 * time.Second},
+			}
+			Expect(c.Create(ctx, &crdv1.BGPConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.BGPConfigurationSpec{LogSeverityScreen: "Debug"},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			bc := &crdv1.BGPConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, bc)).ShouldNot(HaveOccurred())
+			Expect(bc.Spec.NodeMeshMaxRestartTime).To(Equal(&metav1.Duration{Duration: 300 * time.Second}))
+			// Settings not managed through the Installation are left alone.
+			Expect(bc.Spec.LogSeverityScreen).To(Equal("Debug"))
+		})
+
+		It("should leave the BGP graceful restart time on BGPConfiguration alone when not configured", func() {
+			bgp := operator.BGPEnabled
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{BGP: &bgp}
+			Expect(c.Create(ctx, &crdv1.BGPConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.BGPConfigurationSpec{NodeMeshMaxRestartTime: &metav1.Duration{Duration: 90 * time.Second}},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			bc := &crdv1.BGPConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, bc)).ShouldNot(HaveOccurred())
+			Expect(bc.Spec.NodeMeshMaxRestartTime).To(Equal(&metav1.Duration{Duration: 90 * time.Second}))
+		})
+
+		It("should skip the BGP graceful restart time and degrade when the node-to-node mesh is disabled", func() {
+			bgp := operator.BGPEnabled
+			port := int32(1790)
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{
+				BGP:                    &bgp,
+				BGPGracefulRestartTime: &metav1.Duration{Duration: 300 * time.Second},
+				BGPListenPort:          &port,
+			}
+			meshEnabled := false
+			Expect(c.Create(ctx, &crdv1.BGPConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.BGPConfigurationSpec{NodeToNodeMeshEnabled: &meshEnabled},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			mockStatus.On("SetDegraded", operator.ResourceValidationError, "Skipped setting the BGP graceful restart time",
+				mock.MatchedBy(func(msg string) bool { return strings.Contains(msg, "node-to-node mesh, which is disabled") }), mock.Anything).Return()
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertExpectations(GinkgoT())
+
+			bc := &crdv1.BGPConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, bc)).ShouldNot(HaveOccurred())
+			Expect(bc.Spec.NodeMeshMaxRestartTime).To(BeNil())
+			// The rest of the BGP settings are still applied.
+			Expect(bc.Spec.ListenPort).To(Equal(uint16(1790)))
+		})
+
+		It("should propagate the BGP listen port from the Installation to BGPConfiguration", func() {
+			bgp := operator.BGPEnabled
+			port := int32(1790)
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{
+				BGP:           &bgp,
+				BGPListenPort: &port,
+			}
+			Expect(c.Create(ctx, &crdv1.BGPConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.BGPConfigurationSpec{LogSeverityScreen: "Debug"},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			bc := &crdv1.BGPConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, bc)).ShouldNot(HaveOccurred())
+			Expect(bc.Spec.ListenPort).To(Equal(uint16(1790)))
+			Expect(bc.Spec.LogSeverityScreen).To(Equal("Debug"))
+		})
+
+		It("should leave the BGP listen port on BGPConfiguration alone when not configured", func() {
+			bgp := operator.BGPEnabled
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{BGP: &bgp}
+			Expect(c.Create(ctx, &crdv1.BGPConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.BGPConfigurationSpec{ListenPort: 1179},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			bc := &crdv1.BGPConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, bc)).ShouldNot(HaveOccurred())
+			Expect(bc.Spec.ListenPort).To(Equal(uint16(1179)))
+		})
+
+		It("should create the IPAMConfig with strict affinity from the Installation", func() {
+			strictAffinity := true
+			cr.Spec.CNI = &operator.CNISpec{
+				Type: operator.PluginCalico,
+				IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginCalico, StrictAffinity: &strictAffinity},
+			}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ic := &crdv1.IPAMConfig{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, ic)).ShouldNot(HaveOccurred())
+			Expect(ic.Spec.StrictAffinity).To(BeTrue())
+			// Block allocation must keep working when the operator creates the IPAMConfig.
+			Expect(ic.Spec.AutoAllocateBlocks).To(BeTrue())
+		})
+
+		It("should update strict affinity on an existing IPAMConfig", func() {
+			strictAffinity := false
+			cr.Spec.CNI = &operator.CNISpec{
+				Type: operator.PluginCalico,
+				IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginCalico, StrictAffinity: &strictAffinity},
+			}
+			Expect(c.Create(ctx, &crdv1.IPAMConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.IPAMConfigSpec{StrictAffinity: true, AutoAllocateBlocks: true, MaxBlocksPerHost: 4},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ic := &crdv1.IPAMConfig{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, ic)).ShouldNot(HaveOccurred())
+			Expect(ic.Spec.StrictAffinity).To(BeFalse())
+			Expect(ic.Spec.AutoAllocateBlocks).To(BeTrue())
+			Expect(ic.Spec.MaxBlocksPerHost).To(Equal(4))
+		})
+
+		It("should not create an IPAMConfig when strict affinity is not configured", func() {
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ic := &crdv1.IPAMConfig{}
+			err = c.Get(ctx, types.NamespacedName{Name: "default"}, ic)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should not create a KubeControllersConfiguration by default", func() {
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			kc := &crdv1.KubeControllersConfiguration{}
+			err = c.Get(ctx, types.NamespacedName{Name: "default"}, kc)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should create the KubeControllersConfiguration with the node controller settings from the Installation", func() {
+			autoHEPs := operator.AutoHostEndpointsEnabled
+			cr.Spec.KubeControllersConfig = &operator.KubeControllersConfig{
+				Node: &operator.KubeControllersNodeConfig{
+					ReconcilerPeriod:  &metav1.Duration{Duration: 10 * time.Minute},
+					AutoHostEndpoints: &autoHEPs,
+				},
+			}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			kc := &crdv1.KubeControllersConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, kc)).ShouldNot(HaveOccurred())
+			Expect(kc.Spec.Controllers.Node).NotTo(BeNil())
+			Expect(kc.Spec.Controllers.Node.ReconcilerPeriod.Duration).To(Equal(10 * time.Minute))
+			Expect(kc.Spec.Controllers.Node.HostEndpoint.AutoCreate).To(Equal("Enabled"))
+
+			// Settings that are not configured on the Installation get the calico-kube-controllers defaults.
+			Expect(kc.Spec.Controllers.Node.LeakGracePeriod.Duration).To(Equal(15 * time.Minute))
+			Expect(kc.Spec.Controllers.Node.SyncLabels).To(Equal("Enabled"))
+			Expect(kc.Spec.HealthChecks).To(Equal("Enabled"))
+			Expect(*kc.Spec.PrometheusMetricsPort).To(Equal(9094))
+		})
+
+		It("should update the node controller settings on an existing KubeControllersConfiguration", func() {
+			metricsPort := 9095
+			Expect(c.Create(ctx, &crdv1.KubeControllersConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: crdv1.KubeControllersConfigurationSpec{
+					PrometheusMetricsPort: &metricsPort,
+					Controllers: crdv1.ControllersConfig{
+						Node: &crdv1.NodeControllerConfig{
+							ReconcilerPeriod: &metav1.Duration{Duration: 5 * time.Minute},
+							SyncLabels:       "Disabled",
+						},
+					},
+				},
+			})).NotTo(HaveOccurred())
+			cr.Spec.KubeControllersConfig = &operator.KubeControllersConfig{
+				Node: &operator.KubeControllersNodeConfig{LeakGracePeriod: &metav1.Duration{Duration: 0}},
+			}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			kc := &crdv1.KubeControllersConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, kc)).ShouldNot(HaveOccurred())
+			Expect(kc.Spec.Controllers.Node.LeakGracePeriod.Duration).To(Equal(time.Duration(0)))
+			Expect(kc.Spec.Controllers.Node.ReconcilerPeriod.Duration).To(Equal(5 * time.Minute))
+			Expect(kc.Spec.Controllers.Node.SyncLabels).To(Equal("Disabled"))
+			Expect(kc.Spec.Controllers.Node.HostEndpoint).To(BeNil())
+			Expect(*kc.Spec.PrometheusMetricsPort).To(Equal(9095))
+		})
+
+		It("should manage an auto host endpoint template for the configured interface pattern", func() {
+			Expect(c.Create(ctx, &crdv1.KubeControllersConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: crdv1.KubeControllersConfigurationSpec{
+					Controllers: crdv1.ControllersConfig{
+						Node: &crdv1.NodeControllerConfig{
+							HostEndpoint: &crdv1.AutoHostEndpointConfig{
+								AutoCreate: "Enabled",
+								Templates:  []crdv1.Template{{GenerateName: "user", InterfaceCIDRs: []string{"10.0.0.0/8"}}},
+							},
+						},
+					},
+				},
+			})).NotTo(HaveOccurred())
+			autoHEPs := operator.AutoHostEndpointsEnabled
+			cr.Spec.KubeControllersConfig = &operator.KubeControllersConfig{
+				Node: &operator.KubeControllersNodeConfig{
+					AutoHostEndpoints:                &autoHEPs,
+					AutoHostEndpointInterfacePattern: "^eth[0-9]+$",
+				},
+			}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			kc := &crdv1.KubeControllersConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, kc)).ShouldNot(HaveOccurred())
+			hep := kc.Spec.Controllers.Node.HostEndpoint
+			Expect(hep.AutoCreate).To(Equal("Enabled"))
+			Expect(hep.CreateDefaultHostEndpoint).To(Equal("Disabled"))
+			Expect(hep.Templates).To(Equal([]crdv1.Template{
+				{GenerateName: "user", InterfaceCIDRs: []string{"10.0.0.0/8"}},
+				{GenerateName: "operator", InterfacePattern: "^eth[0-9]+$"},
+			}))
+
+			By("removing the interface pattern from the Installation")
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+			cr.Spec.KubeControllersConfig.Node.AutoHostEndpointInterfacePattern = ""
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, kc)).ShouldNot(HaveOccurred())
+			hep = kc.Spec.Controllers.Node.HostEndpoint
+			Expect(hep.AutoCreate).To(Equal("Enabled"))
+			Expect(hep.CreateDefaultHostEndpoint).To(BeEmpty())
+			Expect(hep.Templates).To(Equal([]crdv1.Template{{GenerateName: "user", InterfaceCIDRs: []string{"10.0.0.0/8"}}}))
+		})
+
+		Context("BGP filters", func() {
+			var bgp operator.BGPOption
+
+			BeforeEach(func() {
+				bgp = operator.BGPEnabled
+				cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{
+					BGP: &bgp,
+					BGPFilters: []operator.BGPFilter{{
+						Name: "reject-pods",
+						ExportV4: []operator.BGPFilterRule{
+							{CIDR: "10.10.0.0/16", MatchOperator: operator.BGPFilterMatchOperatorIn, Action: operator.BGPFilterActionReject},
+							{Action: operator.BGPFilterActionAccept},
+						},
+						ImportV6: []operator.BGPFilterRule{
+							{CIDR: "fd00::/64", MatchOperator: operator.BGPFilterMatchOperatorEqual, Action: operator.BGPFilterActionAccept},
+						},
+					}},
+				}
+			})
+
+			It("should create BGPFilters from the Installation", func() {
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				f := &crdv1.BGPFilter{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "reject-pods"}, f)).ShouldNot(HaveOccurred())
+				Expect(f.Labels).To(HaveKeyWithValue("app.kubernetes.io/managed-by", "tigera-operator"))
+				Expect(f.Spec.ExportV4).To(Equal([]crdv1.BGPFilterRuleV4{
+					{CIDR: "10.10.0.0/16", MatchOperator: crdv1.BGPFilterOperatorIn, Action: crdv1.BGPFilterActionReject},
+					{Action: crdv1.BGPFilterActionAccept},
+				}))
+				Expect(f.Spec.ImportV6).To(Equal([]crdv1.BGPFilterRuleV6{
+					{CIDR: "fd00::/64", MatchOperator: crdv1.BGPFilterOperatorEqual, Action: crdv1.BGPFilterActionAccept},
+				}))
+				Expect(f.Spec.ImportV4).To(BeEmpty())
+				Expect(f.Spec.ExportV6).To(BeEmpty())
+			})
+
+			It("should update and delete BGPFilters as the Installation changes", func() {
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				// Change the rules of the existing filter and add a second one.
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				cr.Spec.CalicoNetwork.BGPFilters = []operator.BGPFilter{
+					{
+						Name:     "reject-pods",
+						ExportV4: []operator.BGPFilterRule{{CIDR: "10.20.0.0/16", MatchOperator: operator.BGPFilterMatchOperatorNotIn, Action: operator.BGPFilterActionAccept}},
+					},
+					{
+						Name:     "reject-all",
+						ImportV4: []operator.BGPFilterRule{{Action: operator.BGPFilterActionReject}},
+					},
+				}
+				Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				f := &crdv1.BGPFilter{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "reject-pods"}, f)).ShouldNot(HaveOccurred())
+				Expect(f.Spec.ExportV4).To(Equal([]crdv1.BGPFilterRuleV4{
+					{CIDR: "10.20.0.0/16", MatchOperator: crdv1.BGPFilterOperatorNotIn, Action: crdv1.BGPFilterActionAccept},
+				}))
+				Expect(f.Spec.ImportV6).To(BeEmpty())
+				Expect(c.Get(ctx, types.NamespacedName{Name: "reject-all"}, f)).ShouldNot(HaveOccurred())
+
+				// Remove the first filter; it should be deleted from the cluster.
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				cr.Spec.CalicoNetwork.BGPFilters = cr.Spec.CalicoNetwork.BGPFilters[1:]
+				Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				err = c.Get(ctx, types.NamespacedName{Name: "reject-pods"}, f)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				Expect(c.Get(ctx, types.NamespacedName{Name: "reject-all"}, f)).ShouldNot(HaveOccurred())
+			})
+
+			It("should not delete BGPFilters that are not managed by the operator", func() {
+				Expect(c.Create(ctx, &crdv1.BGPFilter{ObjectMeta: metav1.ObjectMeta{Name: "user-filter"}})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				f := &crdv1.BGPFilter{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "user-filter"}, f)).ShouldNot(HaveOccurred())
+			})
+
+			It("should degrade rather than take over a BGPFilter that is not managed by the operator", func() {
+				Expect(c.Create(ctx, &crdv1.BGPFilter{ObjectMeta: metav1.ObjectMeta{Name: "reject-pods"}})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				mockStatus.On("SetDegraded", operator.ResourceValidationError, "Unable to reconcile BGPFilters", mock.Anything, mock.Anything).Return()
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("BGPFilter reject-pods already exists and is not managed by the operator"))
+
+				f := &crdv1.BGPFilter{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "reject-pods"}, f)).ShouldNot(HaveOccurred())
+				Expect(f.Spec.ExportV4).To(BeEmpty())
+			})
+		})
+
+		Context("route reflectors", func() {
+			var bgp operator.BGPOption
+
+			createNode := func(name string, nodeLabels map[string]string) {
+				Expect(c.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: nodeLabels}})).NotTo(HaveOccurred())
+			}
+			clusterID := func(name string) string {
+				n := &corev1.Node{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: name}, n)).NotTo(HaveOccurred())
+				return n.Annotations["projectcalico.org/RouteReflectorClusterID"]
+			}
+
+			BeforeEach(func() {
+				bgp = operator.BGPEnabled
+				cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{
+					BGP: &bgp,
+					RouteReflectors: &operator.RouteReflectors{
+						NodeSelector: map[string]string{"route-reflector": "true", "zone": "a"},
+						ClusterID:    "244.0.0.1",
+					},
+				}
+				createNode("rr-1", map[string]string{"route-reflector": "true", "zone": "a"})
+				createNode("rr-2", map[string]string{"route-reflector": "true", "zone": "a", "extra": "label"})
+				createNode("worker", map[string]string{"route-reflector": "true", "zone": "b"})
+			})
+
+			It("should generate the route reflector topology from the Installation", func() {
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				peer := &crdv1.BGPPeer{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-route-reflectors"}, peer)).ShouldNot(HaveOccurred())
+				Expect(peer.Labels).To(HaveKeyWithValue("app.kubernetes.io/managed-by", "tigera-operator"))
+				Expect(peer.Spec).To(Equal(crdv1.BGPPeerSpec{
+					NodeSelector: "all()",
+					PeerSelector: "route-reflector == 'true' && zone == 'a'",
+				}))
+
+				Expect(clusterID("rr-1")).To(Equal("244.0.0.1"))
+				Expect(clusterID("rr-2")).To(Equal("244.0.0.1"))
+				Expect(clusterID("worker")).To(BeEmpty())
+
+				bc := &crdv1.BGPConfiguration{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, bc)).ShouldNot(HaveOccurred())
+				Expect(bc.Spec.NodeToNodeMeshEnabled).To(Equal(ptr.BoolToPtr(false)))
+			})
+
+			It("should follow changes to the selected nodes", func() {
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				cr.Spec.CalicoNetwork.RouteReflectors = &operator.RouteReflectors{
+					NodeSelector: map[string]string{"zone": "b"},
+					ClusterID:    "244.0.0.2",
+				}
+				Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				peer := &crdv1.BGPPeer{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "tigera-route-reflectors"}, peer)).ShouldNot(HaveOccurred())
+				Expect(peer.Spec.PeerSelector).To(Equal("zone == 'b'"))
+				Expect(clusterID("rr-1")).To(BeEmpty())
+				Expect(clusterID("rr-2")).To(BeEmpty())
+				Expect(clusterID("worker")).To(Equal("244.0.0.2"))
+			})
+
+			It("should remove the topology and enable the node-to-node mesh when route reflectors are removed", func() {
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				cr.Spec.CalicoNetwork.RouteReflectors = nil
+				Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				err = c.Get(ctx, types.NamespacedName{Name: "tigera-route-reflectors"}, &crdv1.BGPPeer{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				Expect(clusterID("rr-1")).To(BeEmpty())
+				Expect(clusterID("rr-2")).To(BeEmpty())
+
+				bc := &crdv1.BGPConfiguration{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, bc)).ShouldNot(HaveOccurred())
+				Expect(bc.Spec.NodeToNodeMeshEnabled).To(Equal(ptr.BoolToPtr(true)))
+			})
+
+			It("should leave the node-to-node mesh and nodes alone when route reflectors were never configured", func() {
+				cr.Spec.CalicoNetwork.RouteReflectors = nil
+				n := &corev1.Node{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "rr-1"}, n)).NotTo(HaveOccurred())
+				n.Annotations = map[string]string{"projectcalico.org/RouteReflectorClusterID": "10.0.0.1"}
+				Expect(c.Update(ctx, n)).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(clusterID("rr-1")).To(Equal("10.0.0.1"))
+				err = c.Get(ctx, types.NamespacedName{Name: "default"}, &crdv1.BGPConfiguration{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should degrade rather than take over a BGPPeer that is not managed by the operator", func() {
+				Expect(c.Create(ctx, &crdv1.BGPPeer{ObjectMeta: metav1.ObjectMeta{Name: "tigera-route-reflectors"}})).NotTo(HaveOccurred())
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				mockStatus.On("SetDegraded", operator.ResourceValidationError, "Unable to reconcile route reflectors", mock.Anything, mock.Anything).Return()
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).Should(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("BGPPeer tigera-route-reflectors already exists and is not managed by the operator"))
+				Expect(clusterID("rr-1")).To(BeEmpty())
+			})
+		})
+
+		Context("control plane capacity", func() {
+			createNode := func(name string, unschedulable bool, taints ...corev1.Taint) {
+				Expect(c.Create(ctx, &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"kubernetes.io/os": "linux"}},
+					Spec:       corev1.NodeSpec{Unschedulable: unschedulable, Taints: taints},
+				})).NotTo(HaveOccurred())
+			}
+
+			It("should set a condition when there are fewer nodes than control plane replicas", func() {
+				createNode("node1", false)
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				cond := meta.FindStatusCondition(cr.Status.Conditions, "ControlPlaneSchedulable")
+				Expect(cond).NotTo(BeNil())
+				Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+				Expect(cond.Reason).To(Equal("InsufficientNodes"))
+				Expect(cond.Message).To(ContainSubstring("spec.controlPlaneReplicas is 2 but only 1 node(s) can schedule control plane pods"))
+			})
+
+			It("should set the condition while the control plane is not yet available", func() {
+				// Replicas that cannot be scheduled keep the control plane unavailable, which is exactly when the
+				// condition is needed.
+				for _, call := range mockStatus.ExpectedCalls {
+					if call.Method == "IsAvailable" {
+						call.Unset()
+						break
+					}
+				}
+				mockStatus.On("IsAvailable").Return(false)
+
+				createNode("node1", false)
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				result, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result.RequeueAfter).NotTo(BeZero())
+
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				cond := meta.FindStatusCondition(cr.Status.Conditions, "ControlPlaneSchedulable")
+				Expect(cond).NotTo(BeNil())
+				Expect(cond.Reason).To(Equal("InsufficientNodes"))
+				Expect(cr.Status.Computed).To(BeNil())
+			})
+
+			It("should not count cordoned nodes or nodes with untolerated taints", func() {
+				createNode("node1", false)
+				createNode("node2", true)
+				createNode("node3", false, corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule})
+				createNode("node4", false, corev1.Taint{Key: "node-role.kubernetes.io/control-plane", Effect: corev1.TaintEffectNoSchedule})
+				replicas := int32(3)
+				cr.Spec.ControlPlaneReplicas = &replicas
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				cond := meta.FindStatusCondition(cr.Status.Conditions, "ControlPlaneSchedulable")
+				Expect(cond).NotTo(BeNil())
+				Expect(cond.Message).To(ContainSubstring("spec.controlPlaneReplicas is 3 but only 2 node(s)"))
+			})
+
+			It("should count nodes whose taints are tolerated by the control plane tolerations", func() {
+				createNode("node1", false)
+				createNode("node2", false, corev1.Taint{Key: "dedicated", Value: "infra", Effect: corev1.TaintEffectNoSchedule})
+				cr.Spec.ControlPlaneTolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "infra", Effect: corev1.TaintEffectNoSchedule}}
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				Expect(meta.FindStatusCondition(cr.Status.Conditions, "ControlPlaneSchedulable")).To(BeNil())
+			})
+
+			It("should only count nodes matching the control plane node selector", func() {
+				createNode("node1", false)
+				createNode("node2", false)
+				cr.Spec.ControlPlaneNodeSelector = map[string]string{"role": "infra"}
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				cond := meta.FindStatusCondition(cr.Status.Conditions, "ControlPlaneSchedulable")
+				Expect(cond).NotTo(BeNil())
+				Expect(cond.Message).To(ContainSubstring("only 0 node(s)"))
+			})
+
+			It("should clear the condition once enough nodes are available", func() {
+				createNode("node1", false)
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				Expect(meta.FindStatusCondition(cr.Status.Conditions, "ControlPlaneSchedulable")).NotTo(BeNil())
+
+				createNode("node2", false)
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).NotTo(HaveOccurred())
+				Expect(meta.FindStatusCondition(cr.Status.Conditions, "ControlPlaneSchedulable")).To(BeNil())
+			})
+		})
+
+		It("should not enable sidecar acceleration on FelixConfiguration by default", func() {
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.SidecarAccelerationEnabled).To(BeNil())
+		})
+
+		It("should enable sidecar acceleration on FelixConfiguration when the feature gate is set", func() {
+			r.featureGates = options.FeatureGates{options.FeatureGateSidecarAcceleration: true}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.SidecarAccelerationEnabled).NotTo(BeNil())
+			Expect(*fc.Spec.SidecarAccelerationEnabled).To(BeTrue())
+		})
+
 		It("should set BPFEnabled to ture on FelixConfiguration if BPF is enabled on installation", func() {
 			createNodeDaemonSet()
 
@@ -884,14 +1714,493 @@ var _ = Describe("Testing core-controller installation", func() {
 			Expect(err).ShouldNot(HaveOccurred())
 
 			fc := &crdv1.FelixConfiguration{}
-			err = c.Get(ctx, types.NamespacedName{Name: "default"}, fc)
+			err = c.Get(ctx, types.NamespacedName{Name: "default"}, fc)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// Should set correct annoation and BPFEnabled field.
+			Expect(fc.Annotations).NotTo(BeNil())
+			Expect(fc.Annotations[render.BPFOperatorAnnotation]).To(Equal("true"))
+			Expect(fc.Spec.BPFEnabled).NotTo(BeNil())
+			Expect(*fc.Spec.BPFEnabled).To(BeTrue())
+		})
+
+		It("should set the BPF external service mode on FelixConfiguration", func() {
+			createNodeDaemonSet()
+
+			network := operator.LinuxDataplaneBPF
+			mode := operator.BPFExternalServiceModeDSR
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{LinuxDataplane: &network, BPFExternalServiceMode: &mode}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.BPFExternalServiceMode).To(Equal("DSR"))
+		})
+
+		It("should leave the BPF external service mode on FelixConfiguration alone when not set on the Installation", func() {
+			createNodeDaemonSet()
+
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{BPFExternalServiceMode: "DSR"},
+			})).NotTo(HaveOccurred())
+			network := operator.LinuxDataplaneBPF
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{LinuxDataplane: &network}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.BPFExternalServiceMode).To(Equal("DSR"))
+		})
+
+		It("should set BPF connect-time load balancing on FelixConfiguration", func() {
+			createNodeDaemonSet()
+
+			network := operator.LinuxDataplaneBPF
+			ctlb := operator.BPFConnectTimeLoadBalancingDisabled
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{LinuxDataplane: &network, BPFConnectTimeLoadBalancing: &ctlb}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.BPFConnectTimeLoadBalancing).NotTo(BeNil())
+			Expect(*fc.Spec.BPFConnectTimeLoadBalancing).To(Equal(crdv1.BPFConnectTimeLBDisabled))
+		})
+
+		It("should leave BPF connect-time load balancing on FelixConfiguration alone when not set on the Installation", func() {
+			createNodeDaemonSet()
+
+			ctlb := crdv1.BPFConnectTimeLBEnabled
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{BPFConnectTimeLoadBalancing: &ctlb},
+			})).NotTo(HaveOccurred())
+			network := operator.LinuxDataplaneBPF
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{LinuxDataplane: &network}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.BPFConnectTimeLoadBalancing).To(Equal(&ctlb))
+		})
+
+		It("should set the default endpoint to host action on FelixConfiguration", func() {
+			createNodeDaemonSet()
+
+			action := operator.EndpointToHostActionReturn
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{DefaultEndpointToHostAction: &action}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
 			Expect(err).ShouldNot(HaveOccurred())
 
-			// Should set correct annoation and BPFEnabled field.
-			Expect(fc.Annotations).NotTo(BeNil())
-			Expect(fc.Annotations[render.BPFOperatorAnnotation]).To(Equal("true"))
-			Expect(fc.Spec.BPFEnabled).NotTo(BeNil())
-			Expect(*fc.Spec.BPFEnabled).To(BeTrue())
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.DefaultEndpointToHostAction).To(Equal("Return"))
+		})
+
+		It("should leave the default endpoint to host action on FelixConfiguration alone when not set on the Installation", func() {
+			createNodeDaemonSet()
+
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{DefaultEndpointToHostAction: "Drop"},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.DefaultEndpointToHostAction).To(Equal("Drop"))
+		})
+
+		It("should set the AWS source/destination check on FelixConfiguration", func() {
+			createNodeDaemonSet()
+
+			check := operator.AWSSrcDstCheckDisable
+			cr.Spec.KubernetesProvider = operator.ProviderEKS
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{AWSSrcDstCheck: &check}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.AWSSrcDstCheck).NotTo(BeNil())
+			Expect(*fc.Spec.AWSSrcDstCheck).To(Equal(crdv1.AWSSrcDstCheckOptionDisable))
+		})
+
+		It("should set floating IPs on FelixConfiguration", func() {
+			createNodeDaemonSet()
+
+			fips := operator.FloatingIPsEnabled
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{FloatingIPs: &fips}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.FloatingIPs).NotTo(BeNil())
+			Expect(*fc.Spec.FloatingIPs).To(Equal(crdv1.FloatingIPsEnabled))
+		})
+
+		table.DescribeTable("should set WireGuard host encryption on FelixConfiguration when WireGuard is enabled",
+			func(hostEncryption operator.WireguardHostEncryptionType, expected bool) {
+				createNodeDaemonSet()
+
+				Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+					ObjectMeta: metav1.ObjectMeta{Name: "default"},
+					Spec:       crdv1.FelixConfigurationSpec{WireguardEnabled: ptr.BoolToPtr(true)},
+				})).NotTo(HaveOccurred())
+				cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{WireguardHostEncryption: &hostEncryption}
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				fc := &crdv1.FelixConfiguration{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+				Expect(fc.Spec.WireguardHostEncryptionEnabled).To(Equal(&expected))
+			},
+			table.Entry("Enabled", operator.WireguardHostEncryptionEnabled, true),
+			table.Entry("Disabled", operator.WireguardHostEncryptionDisabled, false),
+		)
+
+		It("should skip WireGuard host encryption on FelixConfiguration and degrade when WireGuard is disabled", func() {
+			createNodeDaemonSet()
+
+			hostEncryption := operator.WireguardHostEncryptionEnabled
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{WireguardHostEncryption: &hostEncryption}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			mockStatus.On("SetDegraded", operator.ResourceValidationError, "Skipped setting WireGuard host encryption",
+				mock.MatchedBy(func(msg string) bool { return strings.Contains(msg, "requires WireGuard to be enabled") }), mock.Anything).Return()
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertExpectations(GinkgoT())
+
+			// The rest of the FelixConfiguration is still written.
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.WireguardHostEncryptionEnabled).To(BeNil())
+			Expect(fc.Spec.HealthPort).NotTo(BeNil())
+		})
+
+		It("should leave floating IPs on FelixConfiguration alone when not set on the Installation", func() {
+			createNodeDaemonSet()
+
+			fips := crdv1.FloatingIPsDisabled
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{FloatingIPs: &fips},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.FloatingIPs).NotTo(BeNil())
+			Expect(*fc.Spec.FloatingIPs).To(Equal(crdv1.FloatingIPsDisabled))
+		})
+
+		It("should label calico-node pods with the Installation generation", func() {
+			createNodeDaemonSet()
+
+			cr.Generation = 1
+			cr.Spec.CalicoNodeGenerationLabel = "example.com/installation-generation"
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			ds := &appsv1.DaemonSet{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: common.NodeDaemonSetName, Namespace: common.CalicoNamespace}, ds)).ShouldNot(HaveOccurred())
+			Expect(ds.Spec.Template.Labels).To(HaveKeyWithValue("example.com/installation-generation", "1"))
+
+			// Change the Installation, which bumps its generation.
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).ShouldNot(HaveOccurred())
+			cr.Generation = 2
+			cr.Spec.FlexVolumePath = "/usr/libexec/kubernetes/kubelet-plugins/volume/exec/"
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(c.Get(ctx, types.NamespacedName{Name: common.NodeDaemonSetName, Namespace: common.CalicoNamespace}, ds)).ShouldNot(HaveOccurred())
+			Expect(ds.Spec.Template.Labels).To(HaveKeyWithValue("example.com/installation-generation", "2"))
+		})
+
+		It("should propagate the VXLAN port from the Installation to FelixConfiguration", func() {
+			createNodeDaemonSet()
+
+			bgp := operator.BGPDisabled
+			port := int32(4799)
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{
+				BGP: &bgp,
+				IPPools: []operator.IPPool{{
+					CIDR:          "192.168.0.0/16",
+					Encapsulation: operator.EncapsulationVXLAN,
+					NATOutgoing:   operator.NATOutgoingEnabled,
+					NodeSelector:  "all()",
+				}},
+				VXLANPort: &port,
+			}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.VXLANPort).NotTo(BeNil())
+			Expect(*fc.Spec.VXLANPort).To(Equal(4799))
+		})
+
+		It("should propagate the conntrack cleanup intervals from the Installation to FelixConfiguration", func() {
+			createNodeDaemonSet()
+
+			network := operator.LinuxDataplaneBPF
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{
+				LinuxDataplane:              &network,
+				ConntrackCleanupInterval:    &metav1.Duration{Duration: 5 * time.Second},
+				BPFConntrackCleanupInterval: &metav1.Duration{Duration: 3 * time.Second},
+			}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.ConntrackCleanupInterval).To(Equal(&metav1.Duration{Duration: 5 * time.Second}))
+			Expect(fc.Spec.BPFConntrackCleanupInterval).To(Equal(&metav1.Duration{Duration: 3 * time.Second}))
+		})
+
+		It("should leave the conntrack cleanup intervals on FelixConfiguration alone when not set on the Installation", func() {
+			createNodeDaemonSet()
+
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{ConntrackCleanupInterval: &metav1.Duration{Duration: 20 * time.Second}},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.ConntrackCleanupInterval).To(Equal(&metav1.Duration{Duration: 20 * time.Second}))
+			Expect(fc.Spec.BPFConntrackCleanupInterval).To(BeNil())
+		})
+
+		It("should propagate the BPF map sizes from the Installation to FelixConfiguration", func() {
+			createNodeDaemonSet()
+
+			// Sizes that are not set on the Installation are left as configured on FelixConfiguration.
+			natBackend := 65536
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{BPFMapSizeNATBackend: &natBackend},
+			})).NotTo(HaveOccurred())
+			network := operator.LinuxDataplaneBPF
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{
+				LinuxDataplane: &network,
+				BPFMapSizes: &operator.BPFMapSizes{
+					Conntrack:   ptr.Int32ToPtr(1024000),
+					NATFrontend: ptr.Int32ToPtr(131072),
+					Route:       ptr.Int32ToPtr(524288),
+				},
+			}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.BPFMapSizeConntrack).To(Equal(ptr.ToPtr(1024000)))
+			Expect(fc.Spec.BPFMapSizeNATFrontend).To(Equal(ptr.ToPtr(131072)))
+			Expect(fc.Spec.BPFMapSizeNATBackend).To(Equal(ptr.ToPtr(65536)))
+			Expect(fc.Spec.BPFMapSizeRoute).To(Equal(ptr.ToPtr(524288)))
+		})
+
+		It("should propagate the iptables refresh interval from the Installation to FelixConfiguration", func() {
+			createNodeDaemonSet()
+
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{
+				IptablesRefreshInterval: &metav1.Duration{Duration: 180 * time.Second},
+			}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.IptablesRefreshInterval).To(Equal(&metav1.Duration{Duration: 180 * time.Second}))
+		})
+
+		It("should leave the iptables refresh interval on FelixConfiguration alone when not set on the Installation", func() {
+			createNodeDaemonSet()
+
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{IptablesRefreshInterval: &metav1.Duration{Duration: 60 * time.Second}},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.IptablesRefreshInterval).To(Equal(&metav1.Duration{Duration: 60 * time.Second}))
+		})
+
+		It("should set the Prometheus metrics port on FelixConfiguration to the node metrics port", func() {
+			createNodeDaemonSet()
+
+			enabled := false
+			port := 9091
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{PrometheusMetricsEnabled: &enabled, PrometheusMetricsPort: &port},
+			})).NotTo(HaveOccurred())
+			cr.Spec.NodeMetricsPort = ptr.Int32ToPtr(9095)
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.PrometheusMetricsEnabled).To(Equal(ptr.BoolToPtr(true)))
+			Expect(fc.Spec.PrometheusMetricsPort).NotTo(BeNil())
+			Expect(*fc.Spec.PrometheusMetricsPort).To(Equal(9095))
+
+			ds := &appsv1.DaemonSet{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: common.NodeDaemonSetName, Namespace: common.CalicoNamespace}, ds)).ShouldNot(HaveOccurred())
+			Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "FELIX_PROMETHEUSMETRICSPORT", Value: "9095"}))
+		})
+
+		It("should revert the Prometheus metrics settings on FelixConfiguration when the node metrics port is removed", func() {
+			createNodeDaemonSet()
+
+			cr.Spec.NodeMetricsPort = ptr.Int32ToPtr(9095)
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Annotations).To(HaveKeyWithValue(render.NodeMetricsPortOperatorAnnotation, "9095"))
+
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).ShouldNot(HaveOccurred())
+			cr.Spec.NodeMetricsPort = nil
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc = &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.PrometheusMetricsEnabled).To(BeNil())
+			Expect(fc.Spec.PrometheusMetricsPort).To(BeNil())
+			Expect(fc.Annotations).NotTo(HaveKey(render.NodeMetricsPortOperatorAnnotation))
+		})
+
+		It("should keep Prometheus metrics settings changed by someone else when the node metrics port is removed", func() {
+			createNodeDaemonSet()
+
+			cr.Spec.NodeMetricsPort = ptr.Int32ToPtr(9095)
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			port := 9099
+			fc.Spec.PrometheusMetricsPort = &port
+			Expect(c.Update(ctx, fc)).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, cr)).ShouldNot(HaveOccurred())
+			cr.Spec.NodeMetricsPort = nil
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc = &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.PrometheusMetricsEnabled).To(Equal(ptr.BoolToPtr(true)))
+			Expect(*fc.Spec.PrometheusMetricsPort).To(Equal(9099))
+			Expect(fc.Annotations).NotTo(HaveKey(render.NodeMetricsPortOperatorAnnotation))
+		})
+
+		It("should propagate the NAT port range from the Installation to FelixConfiguration", func() {
+			createNodeDaemonSet()
+
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{NATPortRange: &numorstring.Port{MinPort: 15, MaxPort: 55}},
+			})).NotTo(HaveOccurred())
+			portRange := "32768:65535"
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{NATPortRange: &portRange}
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.NATPortRange).To(Equal(&numorstring.Port{MinPort: 32768, MaxPort: 65535}))
+		})
+
+		table.DescribeTable("should set removeExternalRoutes on FelixConfiguration",
+			func(rer operator.RemoveExternalRoutesType, expected bool) {
+				createNodeDaemonSet()
+
+				cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{RemoveExternalRoutes: &rer}
+				Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				fc := &crdv1.FelixConfiguration{}
+				Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+				Expect(fc.Spec.RemoveExternalRoutes).To(Equal(&expected))
+			},
+			table.Entry("Enabled", operator.RemoveExternalRoutesEnabled, true),
+			table.Entry("Disabled", operator.RemoveExternalRoutesDisabled, false),
+		)
+
+		It("should leave removeExternalRoutes on FelixConfiguration alone when not set on the Installation", func() {
+			createNodeDaemonSet()
+
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{RemoveExternalRoutes: ptr.BoolToPtr(false)},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.RemoveExternalRoutes).To(Equal(ptr.BoolToPtr(false)))
+		})
+
+		It("should leave the VXLAN port on FelixConfiguration alone when not set on the Installation", func() {
+			createNodeDaemonSet()
+
+			port := 4800
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{VXLANPort: &port},
+			})).NotTo(HaveOccurred())
+			Expect(c.Create(ctx, cr)).NotTo(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.VXLANPort).To(Equal(&port))
 		})
 
 		It("should set BPFEnabled to false on FelixConfiguration if BPF is disabled on installation", func() {
@@ -1577,5 +2886,54 @@ var _ = Describe("Testing core-controller installation", func() {
 			Expect(c.Get(ctx, client.ObjectKey{Name: render.TyphaTLSSecretName, Namespace: common.OperatorNamespace()}, secret)).ShouldNot(HaveOccurred())
 			Expect(secret.GetOwnerReferences()).To(HaveLen(1))
 		})
+
+		It("should set the DNS extra TTL on FelixConfiguration when set on the Installation", func() {
+			Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, cr)).NotTo(HaveOccurred())
+			cr.Spec.CalicoNetwork = &operator.CalicoNetworkSpec{DNSExtraTTL: &metav1.Duration{Duration: 90 * time.Second}}
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.DNSExtraTTL).To(Equal(&metav1.Duration{Duration: 90 * time.Second}))
+
+			By("updating FelixConfiguration when the Installation changes")
+			Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, cr)).NotTo(HaveOccurred())
+			cr.Spec.CalicoNetwork.DNSExtraTTL = &metav1.Duration{Duration: 5 * time.Minute}
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.DNSExtraTTL).To(Equal(&metav1.Duration{Duration: 5 * time.Minute}))
+		})
+
+		It("should leave the DNS extra TTL on FelixConfiguration alone when not set on the Installation", func() {
+			ttl := metav1.Duration{Duration: 30 * time.Second}
+			Expect(c.Create(ctx, &crdv1.FelixConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       crdv1.FelixConfigurationSpec{DNSExtraTTL: &ttl},
+			})).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			fc := &crdv1.FelixConfiguration{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: "default"}, fc)).ShouldNot(HaveOccurred())
+			Expect(fc.Spec.DNSExtraTTL).To(Equal(&ttl))
+		})
+
+		It("should degrade if the node metrics port conflicts with the reporter port", func() {
+			mockStatus.On("SetDegraded", operator.InvalidConfigurationError, "invalid metrics port", mock.Anything, mock.Anything).Return()
+			Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, cr)).NotTo(HaveOccurred())
+			cr.Spec.NodeMetricsPort = ptr.Int32ToPtr(9081)
+			Expect(c.Update(ctx, cr)).NotTo(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("nodeMetricsPort=9081 conflicts with felixConfiguration prometheusReporterPort"))
+		})
 	})
 })
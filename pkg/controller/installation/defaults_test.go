@@ -282,6 +282,20 @@ var _ = Describe("Defaulting logic tests", func() {
 		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
 	})
 
+	It("should not default rolling update parameters for the OnDelete node update strategy", func() {
+		instance := &operator.Installation{
+			Spec: operator.InstallationSpec{
+				NodeUpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+					Type: appsv1.OnDeleteDaemonSetStrategyType,
+				},
+			},
+		}
+		Expect(fillDefaults(instance, nil)).NotTo(HaveOccurred())
+		Expect(instance.Spec.NodeUpdateStrategy.Type).To(Equal(appsv1.OnDeleteDaemonSetStrategyType))
+		Expect(instance.Spec.NodeUpdateStrategy.RollingUpdate).To(BeNil())
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+	})
+
 	It("should allow for zero IP pools to be specified", func() {
 		instance := &operator.Installation{
 			Spec: operator.InstallationSpec{
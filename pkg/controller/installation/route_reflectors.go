@@ -0,0 +1,156 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package installation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operator "github.com/tigera/operator/api/v1"
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+)
+
+const (
+	// routeReflectorPeerName is the name of the BGPPeer that peers all nodes with the route reflectors.
+	routeReflectorPeerName = "tigera-route-reflectors"
+
+	// routeReflectorClusterIDAnnotation is the node annotation that Calico reads the route reflector cluster ID of a
+	// node from. A node with a cluster ID acts as a route reflector.
+	routeReflectorClusterIDAnnotation = "projectcalico.org/RouteReflectorClusterID"
+)
+
+// routeReflectorPeer returns the BGPPeer to create or update for the route reflectors configured on the Installation,
+// or the BGPPeer to delete if route reflectors are no longer configured. current is the existing BGPPeer, or nil if
+// there is none. An error is returned if a BGPPeer with the same name exists that the operator does not manage.
+func routeReflectorPeer(install *operator.Installation, current *crdv1.BGPPeer) (client.Object, client.Object, error) {
+	managed := current != nil && current.Labels[bgpFilterManagedByLabel] == bgpFilterManagedByValue
+
+	rr := routeReflectors(install)
+	if rr == nil {
+		if managed {
+			return nil, &crdv1.BGPPeer{ObjectMeta: metav1.ObjectMeta{Name: routeReflectorPeerName}}, nil
+		}
+		return nil, nil, nil
+	}
+	if current != nil && !managed {
+		return nil, nil, fmt.Errorf("BGPPeer %s already exists and is not managed by the operator", routeReflectorPeerName)
+	}
+
+	return &crdv1.BGPPeer{
+		TypeMeta: metav1.TypeMeta{Kind: "BGPPeer", APIVersion: "crd.projectcalico.org/v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   routeReflectorPeerName,
+			Labels: map[string]string{bgpFilterManagedByLabel: bgpFilterManagedByValue},
+		},
+		Spec: crdv1.BGPPeerSpec{
+			NodeSelector: "all()",
+			PeerSelector: routeReflectorSelector(rr.NodeSelector),
+		},
+	}, nil, nil
+}
+
+// routeReflectorSelector converts the route reflector node labels into the equivalent Calico selector.
+func routeReflectorSelector(nodeLabels map[string]string) string {
+	keys := make([]string, 0, len(nodeLabels))
+	for k := range nodeLabels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	terms := make([]string, 0, len(keys))
+	for _, k := range keys {
+		terms = append(terms, fmt.Sprintf("%s == '%s'", k, nodeLabels[k]))
+	}
+	return strings.Join(terms, " && ")
+}
+
+// setRouteReflectorClusterIDs sets the route reflector cluster ID on the nodes selected by the route reflectors on
+// the Installation, and removes it from all other nodes. If route reflectors are not configured, it is removed from
+// every node.
+func setRouteReflectorClusterIDs(ctx context.Context, c client.Client, install *operator.Installation) error {
+	selector := labels.Nothing()
+	clusterID := ""
+	if rr := routeReflectors(install); rr != nil {
+		selector = labels.SelectorFromSet(rr.NodeSelector)
+		clusterID = rr.ClusterID
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes); err != nil {
+		return err
+	}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		want := ""
+		if selector.Matches(labels.Set(node.Labels)) {
+			want = clusterID
+		}
+		if node.Annotations[routeReflectorClusterIDAnnotation] == want {
+			continue
+		}
+
+		patchFrom := client.MergeFrom(node.DeepCopy())
+		if want == "" {
+			delete(node.Annotations, routeReflectorClusterIDAnnotation)
+		} else {
+			if node.Annotations == nil {
+				node.Annotations = map[string]string{}
+			}
+			node.Annotations[routeReflectorClusterIDAnnotation] = want
+		}
+		if err := c.Patch(ctx, node, patchFrom); err != nil {
+			return fmt.Errorf("unable to set the route reflector cluster ID on node %s: %w", node.Name, err)
+		}
+	}
+	return nil
+}
+
+// setNodeMeshOnBGPConfiguration disables the node-to-node mesh on the BGPConfiguration while route reflectors are
+// configured on the Installation, and enables it again once they are removed. wasManaged indicates whether the
+// operator configured route reflectors previously. It returns true if the BGPConfiguration was changed.
+func setNodeMeshOnBGPConfiguration(install *operator.Installation, bc *crdv1.BGPConfiguration, wasManaged bool) bool {
+	var enabled bool
+	switch {
+	case routeReflectors(install) != nil:
+		enabled = false
+	case wasManaged:
+		enabled = true
+	default:
+		// Leave any node-to-node mesh setting configured directly on the BGPConfiguration alone.
+		return false
+	}
+	if bc.Spec.NodeToNodeMeshEnabled != nil && *bc.Spec.NodeToNodeMeshEnabled == enabled {
+		return false
+	}
+	bc.Spec.NodeToNodeMeshEnabled = &enabled
+	return true
+}
+
+// routeReflectors returns the route reflectors configured on the Installation, or nil if BGP is not enabled or no
+// route reflectors are configured.
+func routeReflectors(install *operator.Installation) *operator.RouteReflectors {
+	cn := install.Spec.CalicoNetwork
+	if cn == nil || cn.RouteReflectors == nil || cn.BGP == nil || *cn.BGP != operator.BGPEnabled {
+		return nil
+	}
+	return cn.RouteReflectors
+}
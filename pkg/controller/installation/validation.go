@@ -18,10 +18,14 @@ import (
 	"fmt"
 	"net"
 	"path"
+	"regexp"
 	"strings"
 
+	"github.com/tigera/api/pkg/lib/numorstring"
+
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
+	"github.com/tigera/operator/pkg/common/k8svalidation"
 	"github.com/tigera/operator/pkg/common/validation"
 	node "github.com/tigera/operator/pkg/common/validation/calico-node"
 	csinodedriver "github.com/tigera/operator/pkg/common/validation/csi-node-driver"
@@ -31,8 +35,12 @@ import (
 	"github.com/tigera/operator/pkg/controller/utils"
 	"github.com/tigera/operator/pkg/render"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 // validateCustomResource validates that the given custom resource is correct. This
@@ -134,6 +142,10 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 			instance.Spec.CNI.Type, strings.Join(operatorv1.CNIPluginTypesString, ","))
 	}
 
+	if instance.Spec.CNI.IPAM.StrictAffinity != nil && instance.Spec.CNI.IPAM.Type != operatorv1.IPAMPluginCalico {
+		return fmt.Errorf("spec.cni.ipam.strictAffinity is only supported with spec.cni.ipam.type %s", operatorv1.IPAMPluginCalico)
+	}
+
 	// Verify Calico settings, if specified.
 	if instance.Spec.CalicoNetwork != nil {
 		bpfDataplane := instance.Spec.CalicoNetwork.LinuxDataplane != nil && *instance.Spec.CalicoNetwork.LinuxDataplane == operatorv1.LinuxDataplaneBPF
@@ -282,6 +294,263 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 				return fmt.Errorf("spec.calicoNetwork.linuxPolicySetupTimeoutSeconds is supported only for the Iptables and BPF Linux dataplanes")
 			}
 		}
+
+		if gate := instance.Spec.CalicoNetwork.BGPReadinessGate; gate != nil {
+			switch *gate {
+			case operatorv1.BGPReadinessGateEnabled:
+				if instance.Spec.CalicoNetwork.BGP == nil || *instance.Spec.CalicoNetwork.BGP != operatorv1.BGPEnabled {
+					return fmt.Errorf("spec.calicoNetwork.bgpReadinessGate requires BGP to be enabled")
+				}
+			case operatorv1.BGPReadinessGateDisabled:
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.bgpReadinessGate, should be one of Enabled, Disabled", *gate)
+			}
+		}
+
+		if xdp := instance.Spec.CalicoNetwork.XDPAcceleration; xdp != nil {
+			switch *xdp {
+			case operatorv1.XDPAccelerationEnabled, operatorv1.XDPAccelerationGeneric:
+				if instance.Spec.CalicoNetwork.LinuxDataplane != nil && *instance.Spec.CalicoNetwork.LinuxDataplane != operatorv1.LinuxDataplaneIptables {
+					return fmt.Errorf("spec.calicoNetwork.xdpAcceleration is supported only for the Iptables Linux dataplane")
+				}
+			case operatorv1.XDPAccelerationDisabled:
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.xdpAcceleration, should be one of Disabled, Enabled, Generic", *xdp)
+			}
+		}
+
+		if mode := instance.Spec.CalicoNetwork.BPFExternalServiceMode; mode != nil {
+			switch *mode {
+			case operatorv1.BPFExternalServiceModeTunnel, operatorv1.BPFExternalServiceModeDSR:
+				if !instance.Spec.BPFEnabled() {
+					return fmt.Errorf("spec.calicoNetwork.bpfExternalServiceMode is supported only for the BPF Linux dataplane")
+				}
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.bpfExternalServiceMode, should be one of Tunnel, DSR", *mode)
+			}
+		}
+
+		if ctlb := instance.Spec.CalicoNetwork.BPFConnectTimeLoadBalancing; ctlb != nil {
+			switch *ctlb {
+			case operatorv1.BPFConnectTimeLoadBalancingTCP, operatorv1.BPFConnectTimeLoadBalancingEnabled, operatorv1.BPFConnectTimeLoadBalancingDisabled:
+				if !instance.Spec.BPFEnabled() {
+					return fmt.Errorf("spec.calicoNetwork.bpfConnectTimeLoadBalancing is supported only for the BPF Linux dataplane")
+				}
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.bpfConnectTimeLoadBalancing, should be one of TCP, Enabled, Disabled", *ctlb)
+			}
+		}
+
+		if action := instance.Spec.CalicoNetwork.DefaultEndpointToHostAction; action != nil {
+			switch *action {
+			case operatorv1.EndpointToHostActionDrop, operatorv1.EndpointToHostActionReturn, operatorv1.EndpointToHostActionAccept:
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.defaultEndpointToHostAction, should be one of Drop, Return, Accept", *action)
+			}
+		}
+
+		if check := instance.Spec.CalicoNetwork.AWSSrcDstCheck; check != nil {
+			switch *check {
+			case operatorv1.AWSSrcDstCheckDoNothing, operatorv1.AWSSrcDstCheckEnable, operatorv1.AWSSrcDstCheckDisable:
+				if instance.Spec.KubernetesProvider != operatorv1.ProviderEKS {
+					return fmt.Errorf("spec.calicoNetwork.awsSrcDstCheck is supported only for the EKS provider")
+				}
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.awsSrcDstCheck, should be one of DoNothing, Enable, Disable", *check)
+			}
+		}
+
+		if fips := instance.Spec.CalicoNetwork.FloatingIPs; fips != nil {
+			switch *fips {
+			case operatorv1.FloatingIPsEnabled, operatorv1.FloatingIPsDisabled:
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.floatingIPs, should be one of Enabled, Disabled", *fips)
+			}
+		}
+
+		if hostEncryption := instance.Spec.CalicoNetwork.WireguardHostEncryption; hostEncryption != nil {
+			switch *hostEncryption {
+			case operatorv1.WireguardHostEncryptionEnabled, operatorv1.WireguardHostEncryptionDisabled:
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.wireguardHostEncryption, should be one of Enabled, Disabled", *hostEncryption)
+			}
+		}
+
+		if r := instance.Spec.CalicoNetwork.NATPortRange; r != nil {
+			portRange, err := numorstring.PortFromString(*r)
+			if err != nil || portRange.PortName != "" || portRange.MinPort == 0 {
+				return fmt.Errorf("%q is invalid for spec.calicoNetwork.natPortRange, should be a port or a range of ports such as 32768:65535", *r)
+			}
+		}
+
+		if rer := instance.Spec.CalicoNetwork.RemoveExternalRoutes; rer != nil {
+			switch *rer {
+			case operatorv1.RemoveExternalRoutesEnabled, operatorv1.RemoveExternalRoutesDisabled:
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.removeExternalRoutes, should be one of Enabled, Disabled", *rer)
+			}
+		}
+
+		if port := instance.Spec.CalicoNetwork.VXLANPort; port != nil {
+			vxlanPool := false
+			for _, pool := range instance.Spec.CalicoNetwork.IPPools {
+				if pool.Encapsulation == operatorv1.EncapsulationVXLAN || pool.Encapsulation == operatorv1.EncapsulationVXLANCrossSubnet {
+					vxlanPool = true
+				}
+			}
+			if !vxlanPool {
+				return fmt.Errorf("spec.calicoNetwork.vxlanPort requires an IP pool with VXLAN encapsulation")
+			}
+			if *port < 1 || *port > 65535 {
+				return fmt.Errorf("spec.calicoNetwork.vxlanPort must be between 1 and 65535, got %d", *port)
+			}
+		}
+
+		if ttl := instance.Spec.CalicoNetwork.DNSExtraTTL; ttl != nil {
+			if instance.Spec.Variant != operatorv1.TigeraSecureEnterprise {
+				return fmt.Errorf("spec.calicoNetwork.dnsExtraTTL is only supported for Calico Enterprise")
+			}
+			if ttl.Duration <= 0 {
+				return fmt.Errorf("spec.calicoNetwork.dnsExtraTTL must be a positive duration, got %s", ttl.Duration)
+			}
+		}
+
+		if i := instance.Spec.CalicoNetwork.ConntrackCleanupInterval; i != nil && i.Duration <= 0 {
+			return fmt.Errorf("spec.calicoNetwork.conntrackCleanupInterval must be a positive duration, got %s", i.Duration)
+		}
+
+		if i := instance.Spec.CalicoNetwork.BPFConntrackCleanupInterval; i != nil {
+			if !instance.Spec.BPFEnabled() {
+				return fmt.Errorf("spec.calicoNetwork.bpfConntrackCleanupInterval is supported only for the BPF Linux dataplane")
+			}
+			if i.Duration <= 0 {
+				return fmt.Errorf("spec.calicoNetwork.bpfConntrackCleanupInterval must be a positive duration, got %s", i.Duration)
+			}
+		}
+
+		if i := instance.Spec.CalicoNetwork.IptablesRefreshInterval; i != nil {
+			if instance.Spec.CalicoNetwork.LinuxDataplane != nil && *instance.Spec.CalicoNetwork.LinuxDataplane != operatorv1.LinuxDataplaneIptables {
+				return fmt.Errorf("spec.calicoNetwork.iptablesRefreshInterval is supported only for the Iptables Linux dataplane")
+			}
+			if i.Duration <= 0 {
+				return fmt.Errorf("spec.calicoNetwork.iptablesRefreshInterval must be a positive duration, got %s", i.Duration)
+			}
+		}
+
+		if sizes := instance.Spec.CalicoNetwork.BPFMapSizes; sizes != nil {
+			if !instance.Spec.BPFEnabled() {
+				return fmt.Errorf("spec.calicoNetwork.bpfMapSizes is supported only for the BPF Linux dataplane")
+			}
+			for _, m := range []struct {
+				name string
+				size *int32
+			}{
+				{"conntrack", sizes.Conntrack},
+				{"natFrontend", sizes.NATFrontend},
+				{"natBackend", sizes.NATBackend},
+				{"route", sizes.Route},
+			} {
+				if m.size != nil && *m.size <= 0 {
+					return fmt.Errorf("spec.calicoNetwork.bpfMapSizes.%s must be positive, got %d", m.name, *m.size)
+				}
+			}
+		}
+
+		if ps := instance.Spec.CalicoNetwork.PolicySync; ps != nil {
+			switch *ps {
+			case operatorv1.PolicySyncEnabled:
+				if instance.Spec.CalicoNetwork.LinuxDataplane != nil && *instance.Spec.CalicoNetwork.LinuxDataplane == operatorv1.LinuxDataplaneVPP {
+					return fmt.Errorf("spec.calicoNetwork.policySync is not supported with the VPP dataplane")
+				}
+			case operatorv1.PolicySyncDisabled:
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.policySync, should be one of Enabled, Disabled", *ps)
+			}
+		}
+
+		if esr := instance.Spec.CalicoNetwork.EndpointStatusReporting; esr != nil {
+			switch *esr {
+			case operatorv1.EndpointStatusReportingEnabled, operatorv1.EndpointStatusReportingDisabled:
+			default:
+				return fmt.Errorf("%s is invalid for spec.calicoNetwork.endpointStatusReporting, should be one of Enabled, Disabled", *esr)
+			}
+		}
+
+		if t := instance.Spec.CalicoNetwork.BGPGracefulRestartTime; t != nil {
+			if instance.Spec.CalicoNetwork.BGP == nil || *instance.Spec.CalicoNetwork.BGP != operatorv1.BGPEnabled {
+				return fmt.Errorf("spec.calicoNetwork.bgpGracefulRestartTime requires BGP to be enabled")
+			}
+			if t.Duration <= 0 {
+				return fmt.Errorf("spec.calicoNetwork.bgpGracefulRestartTime must be a positive duration, got %s", t.Duration)
+			}
+		}
+
+		if port := instance.Spec.CalicoNetwork.BGPListenPort; port != nil {
+			if instance.Spec.CalicoNetwork.BGP == nil || *instance.Spec.CalicoNetwork.BGP != operatorv1.BGPEnabled {
+				return fmt.Errorf("spec.calicoNetwork.bgpListenPort requires BGP to be enabled")
+			}
+			if *port < 1 || *port > 65535 {
+				return fmt.Errorf("spec.calicoNetwork.bgpListenPort must be between 1 and 65535, got %d", *port)
+			}
+		}
+
+		if cidrs := instance.Spec.CalicoNetwork.AdvertisedServiceClusterIPs; len(cidrs) > 0 {
+			if instance.Spec.CalicoNetwork.BGP == nil || *instance.Spec.CalicoNetwork.BGP != operatorv1.BGPEnabled {
+				return fmt.Errorf("spec.calicoNetwork.advertisedServiceClusterIPs requires BGP to be enabled")
+			}
+			if err := validateAdvertisedServiceClusterIPs(cidrs, instance.Spec.ServiceCIDRs); err != nil {
+				return fmt.Errorf("spec.calicoNetwork.advertisedServiceClusterIPs is invalid: %w", err)
+			}
+		}
+
+		if filters := instance.Spec.CalicoNetwork.BGPFilters; len(filters) > 0 {
+			if instance.Spec.CalicoNetwork.BGP == nil || *instance.Spec.CalicoNetwork.BGP != operatorv1.BGPEnabled {
+				return fmt.Errorf("spec.calicoNetwork.bgpFilters requires BGP to be enabled")
+			}
+			if err := validateBGPFilters(filters); err != nil {
+				return fmt.Errorf("spec.calicoNetwork.bgpFilters is invalid: %w", err)
+			}
+		}
+
+		if rr := instance.Spec.CalicoNetwork.RouteReflectors; rr != nil {
+			if instance.Spec.CalicoNetwork.BGP == nil || *instance.Spec.CalicoNetwork.BGP != operatorv1.BGPEnabled {
+				return fmt.Errorf("spec.calicoNetwork.routeReflectors requires BGP to be enabled")
+			}
+			if instance.Spec.CalicoNetwork.BGPGracefulRestartTime != nil {
+				return fmt.Errorf("spec.calicoNetwork.routeReflectors cannot be used with spec.calicoNetwork.bgpGracefulRestartTime, which applies to the node-to-node mesh")
+			}
+			if err := validateRouteReflectors(rr); err != nil {
+				return fmt.Errorf("spec.calicoNetwork.routeReflectors is invalid: %w", err)
+			}
+		}
+	}
+
+	if c := instance.Spec.NodeLocalDNSCache; c != nil && c.LocalIP != "" && net.ParseIP(c.LocalIP) == nil {
+		return fmt.Errorf("spec.nodeLocalDNSCache.localIP %q is not a valid IP address", c.LocalIP)
+	}
+
+	if kc := instance.Spec.KubeControllersConfig; kc != nil && kc.Node != nil {
+		if p := kc.Node.ReconcilerPeriod; p != nil && p.Duration <= 0 {
+			return fmt.Errorf("spec.kubeControllersConfig.node.reconcilerPeriod must be a positive duration, got %s", p.Duration)
+		}
+		if p := kc.Node.LeakGracePeriod; p != nil && p.Duration < 0 {
+			return fmt.Errorf("spec.kubeControllersConfig.node.leakGracePeriod must not be negative, got %s", p.Duration)
+		}
+		if a := kc.Node.AutoHostEndpoints; a != nil {
+			switch *a {
+			case operatorv1.AutoHostEndpointsEnabled, operatorv1.AutoHostEndpointsDisabled:
+			default:
+				return fmt.Errorf("%s is invalid for spec.kubeControllersConfig.node.autoHostEndpoints, should be one of Enabled, Disabled", *a)
+			}
+		}
+		if p := kc.Node.AutoHostEndpointInterfacePattern; p != "" {
+			if a := kc.Node.AutoHostEndpoints; a == nil || *a != operatorv1.AutoHostEndpointsEnabled {
+				return fmt.Errorf("spec.kubeControllersConfig.node.autoHostEndpointInterfacePattern requires autoHostEndpoints to be Enabled")
+			}
+			if _, err := regexp.Compile(p); err != nil {
+				return fmt.Errorf("spec.kubeControllersConfig.node.autoHostEndpointInterfacePattern %q is not a valid regular expression: %w", p, err)
+			}
+		}
 	}
 
 	// Verify that the flexvolume path is valid - either "None" (to disable) or a valid absolute path.
@@ -296,10 +565,45 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 			instance.Spec.KubeletVolumePluginPath)
 	}
 
-	// We only support RollingUpdate for the node daemonset strategy.
-	if instance.Spec.NodeUpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType {
+	// We only support RollingUpdate and OnDelete for the node daemonset strategy.
+	switch instance.Spec.NodeUpdateStrategy.Type {
+	case appsv1.RollingUpdateDaemonSetStrategyType:
+	case appsv1.OnDeleteDaemonSetStrategyType:
+		if instance.Spec.NodeUpdateStrategy.RollingUpdate != nil {
+			return fmt.Errorf("Installation spec.NodeUpdateStrategy.rollingUpdate must not be set when type is '%s'",
+				appsv1.OnDeleteDaemonSetStrategyType)
+		}
+	default:
 		return fmt.Errorf("Installation spec.NodeUpdateStrategy.type '%s' is not supported",
-			instance.Spec.NodeUpdateStrategy.RollingUpdate)
+			instance.Spec.NodeUpdateStrategy.Type)
+	}
+
+	if sc := instance.Spec.DefaultPodSecurityContext; sc != nil {
+		if err := validateDefaultPodSecurityContext(sc); err != nil {
+			return fmt.Errorf("Installation spec.DefaultPodSecurityContext is invalid: %w", err)
+		}
+	}
+
+	if fp := instance.Spec.FailsafePorts; fp != nil {
+		if fp.Inbound != nil {
+			if err := validateFailsafePorts(*fp.Inbound); err != nil {
+				return fmt.Errorf("Installation spec.FailsafePorts.inbound is invalid: %w", err)
+			}
+		}
+		if fp.Outbound != nil {
+			if err := validateFailsafePorts(*fp.Outbound); err != nil {
+				return fmt.Errorf("Installation spec.FailsafePorts.outbound is invalid: %w", err)
+			}
+		}
+	}
+
+	if limit := instance.Spec.MaxConcurrentNodeRestarts; limit != nil {
+		if *limit < 1 {
+			return fmt.Errorf("Installation spec.MaxConcurrentNodeRestarts must be at least 1, got %d", *limit)
+		}
+		if ru := instance.Spec.NodeUpdateStrategy.RollingUpdate; ru != nil && ru.MaxUnavailable != nil && ru.MaxUnavailable.Type != intstr.Int {
+			return fmt.Errorf("Installation spec.MaxConcurrentNodeRestarts requires spec.NodeUpdateStrategy.rollingUpdate.maxUnavailable to be an integer")
+		}
 	}
 
 	if instance.Spec.ControlPlaneNodeSelector != nil {
@@ -348,6 +652,12 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 		if err != nil {
 			return fmt.Errorf("Installation spec.CalicoNodeDaemonSet is not valid: %w", err)
 		}
+		if err := node.ValidateCalicoNodeDaemonSetExtraContainers(ds.GetExtraContainers(), render.CalicoNodeContainerNames...); err != nil {
+			return fmt.Errorf("Installation spec.CalicoNodeDaemonSet is not valid: %w", err)
+		}
+		if err := node.ValidateCalicoNodeStartupProbe(ds.GetStartupProbe(render.CalicoNodeObjectName)); err != nil {
+			return fmt.Errorf("Installation spec.CalicoNodeDaemonSet is not valid: %w", err)
+		}
 	}
 
 	// Verify the CalicoNodeWindowsDaemonSet overrides, if specified, is valid.
@@ -366,6 +676,19 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 		}
 	}
 
+	// Verify the deprecated TyphaAffinity, if specified, is valid.
+	if ta := instance.Spec.TyphaAffinity; ta != nil && ta.NodeAffinity != nil {
+		affinity := &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution:  ta.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+				PreferredDuringSchedulingIgnoredDuringExecution: ta.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			},
+		}
+		if errs := k8svalidation.ValidateAffinity(affinity, field.NewPath("spec", "typhaAffinity")); errs.ToAggregate() != nil {
+			return fmt.Errorf("Installation spec.TyphaAffinity is not valid: %w", errs.ToAggregate())
+		}
+	}
+
 	// Verify the TyphaDeployment overrides, if specified, is valid.
 	if deploy := instance.Spec.TyphaDeployment; deploy != nil {
 		err := validation.ValidateReplicatedPodResourceOverrides(deploy, typha.ValidateTyphaDeploymentContainer, typha.ValidateTyphaDeploymentInitContainer)
@@ -411,6 +734,104 @@ func validateCustomResource(instance *operatorv1.Installation) error {
 		}
 	}
 
+	if err := validatePodSecurityAdmissionModes(instance.Spec.PodSecurityAdmissionModes); err != nil {
+		return err
+	}
+
+	if key := instance.Spec.CalicoNodeGenerationLabel; key != "" {
+		if errs := utilvalidation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("spec.calicoNodeGenerationLabel %q is not a valid label key: %s", key, strings.Join(errs, "; "))
+		}
+		if key == "k8s-app" || key == "app.kubernetes.io/name" {
+			return fmt.Errorf("spec.calicoNodeGenerationLabel %q is reserved for the label the operator sets on calico-node pods", key)
+		}
+	}
+
+	if err := validateServiceAccountAnnotations(instance.Spec.ServiceAccountAnnotations); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateServiceAccountAnnotations checks that service account annotations are configured at most once for each
+// supported component, and that the annotations are valid.
+func validateServiceAccountAnnotations(saAnnotations []operatorv1.ServiceAccountAnnotations) error {
+	validComponentNames := map[operatorv1.ComponentName]struct{}{
+		operatorv1.ComponentNameNode:            {},
+		operatorv1.ComponentNameTypha:           {},
+		operatorv1.ComponentNameKubeControllers: {},
+		operatorv1.ComponentNameGuardian:        {},
+		operatorv1.ComponentNameESGateway:       {},
+		operatorv1.ComponentNameLinseed:         {},
+	}
+	seen := map[operatorv1.ComponentName]bool{}
+	for i, sa := range saAnnotations {
+		if _, ok := validComponentNames[sa.ComponentName]; !ok {
+			return fmt.Errorf("Installation spec.serviceAccountAnnotations.componentName %s is not supported", sa.ComponentName)
+		}
+		if seen[sa.ComponentName] {
+			return fmt.Errorf("Installation spec.serviceAccountAnnotations has more than one entry for component %s", sa.ComponentName)
+		}
+		seen[sa.ComponentName] = true
+		if errs := k8svalidation.ValidateAnnotations(sa.Annotations, field.NewPath("spec", "serviceAccountAnnotations").Index(i).Child("annotations")); len(errs) > 0 {
+			return fmt.Errorf("Installation spec.serviceAccountAnnotations is not valid: %w", errs.ToAggregate())
+		}
+	}
+	return nil
+}
+
+// validatePodSecurityAdmissionModes checks that the Pod Security Admission modes are known, are not repeated and
+// include Enforce, without which namespaces hosting privileged components could be blocked by a restricted default.
+func validatePodSecurityAdmissionModes(modes []operatorv1.PodSecurityAdmissionMode) error {
+	if len(modes) == 0 {
+		return nil
+	}
+	seen := map[operatorv1.PodSecurityAdmissionMode]bool{}
+	for _, m := range modes {
+		switch m {
+		case operatorv1.PodSecurityAdmissionModeEnforce, operatorv1.PodSecurityAdmissionModeAudit, operatorv1.PodSecurityAdmissionModeWarn:
+		default:
+			return fmt.Errorf("Installation spec.PodSecurityAdmissionModes value %q is not valid, it should be one of Enforce, Audit, Warn", m)
+		}
+		if seen[m] {
+			return fmt.Errorf("Installation spec.PodSecurityAdmissionModes value %q is specified more than once", m)
+		}
+		seen[m] = true
+	}
+	if !seen[operatorv1.PodSecurityAdmissionModeEnforce] {
+		return fmt.Errorf("Installation spec.PodSecurityAdmissionModes must include Enforce")
+	}
+	return nil
+}
+
+// validateNamespaceResourceQuotas checks that ResourceQuotas are only configured once for each
+// of the given operator managed namespaces, and that their limits are valid.
+func validateNamespaceResourceQuotas(quotas []operatorv1.NamespaceResourceQuota, managedNamespaces []string) error {
+	seen := map[string]bool{}
+	for _, q := range quotas {
+		managed := false
+		for _, ns := range managedNamespaces {
+			if q.Namespace == ns {
+				managed = true
+				break
+			}
+		}
+		if !managed {
+			return fmt.Errorf("Installation spec.NamespaceResourceQuotas namespace %q is not managed by the operator", q.Namespace)
+		}
+		if seen[q.Namespace] {
+			return fmt.Errorf("Installation spec.NamespaceResourceQuotas namespace %q is specified more than once", q.Namespace)
+		}
+		seen[q.Namespace] = true
+
+		fldPath := field.NewPath("spec", "namespaceResourceQuotas").Key(q.Namespace).Child("spec", "hard")
+		for name, quantity := range q.Spec.Hard {
+			if errs := k8svalidation.ValidateResourceQuantityValue(string(name), quantity, fldPath.Key(string(name))); len(errs) > 0 {
+				return fmt.Errorf("Installation spec.NamespaceResourceQuotas is not valid: %w", errs.ToAggregate())
+			}
+		}
+	}
 	return nil
 }
 
@@ -419,9 +840,15 @@ func validateNodeAddressDetection(ad *operatorv1.NodeAddressAutodetection) error
 	numEnabled := 0
 	if len(ad.Interface) != 0 {
 		numEnabled++
+		if err := validateInterfacePatterns("interface", ad.Interface); err != nil {
+			return err
+		}
 	}
 	if len(ad.SkipInterface) != 0 {
 		numEnabled++
+		if err := validateInterfacePatterns("skipInterface", ad.SkipInterface); err != nil {
+			return err
+		}
 	}
 	if len(ad.CanReach) != 0 {
 		numEnabled++
@@ -449,6 +876,25 @@ func validateNodeAddressDetection(ad *operatorv1.NodeAddressAutodetection) error
 	return nil
 }
 
+// validateInterfacePatterns checks that each pattern in a comma-separated list of interface regexes is a valid,
+// non-empty regex that is not listed more than once.
+func validateInterfacePatterns(field, patterns string) error {
+	seen := map[string]bool{}
+	for _, p := range render.InterfacePatterns(patterns) {
+		if p == "" {
+			return fmt.Errorf("node address autodetection %s %q contains an empty pattern", field, patterns)
+		}
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("node address autodetection %s pattern %q is not a valid regex: %v", field, p, err)
+		}
+		if seen[p] {
+			return fmt.Errorf("node address autodetection %s pattern %q is specified more than once", field, p)
+		}
+		seen[p] = true
+	}
+	return nil
+}
+
 func validateHostPorts(hp *operatorv1.HostPortsType) error {
 	if hp == nil {
 		return fmt.Errorf("HostPorts must be set, it should be one of %s",
@@ -467,3 +913,162 @@ func validateHostPorts(hp *operatorv1.HostPortsType) error {
 
 	return nil
 }
+
+// validateFailsafePorts checks that each failsafe port has a supported protocol, a valid port number and,
+// if set, a valid CIDR.
+func validateFailsafePorts(ports []operatorv1.ProtoPort) error {
+	for _, p := range ports {
+		if p.Protocol != "TCP" && p.Protocol != "UDP" {
+			return fmt.Errorf("protocol %q is not supported, should be one of TCP, UDP", p.Protocol)
+		}
+		if p.Port < 1 || p.Port > 65535 {
+			return fmt.Errorf("port %d is out of range", p.Port)
+		}
+		if p.Net != "" {
+			if _, _, err := net.ParseCIDR(p.Net); err != nil {
+				return fmt.Errorf("net %q is not a valid CIDR", p.Net)
+			}
+		}
+	}
+	return nil
+}
+
+// validateBGPFilters checks that each BGPFilter has a unique, valid name and that its rules have a valid action
+// and a CIDR of the right IP family together with a match operator.
+func validateBGPFilters(filters []operatorv1.BGPFilter) error {
+	names := map[string]bool{}
+	for _, f := range filters {
+		if err := utils.ValidateResourceNameIsQualified(f.Name); err != nil {
+			return err
+		}
+		if names[f.Name] {
+			return fmt.Errorf("filter name %s is used more than once", f.Name)
+		}
+		names[f.Name] = true
+
+		for _, r := range []struct {
+			field string
+			rules []operatorv1.BGPFilterRule
+			ipv6  bool
+		}{
+			{"exportV4", f.ExportV4, false},
+			{"importV4", f.ImportV4, false},
+			{"exportV6", f.ExportV6, true},
+			{"importV6", f.ImportV6, true},
+		} {
+			for i, rule := range r.rules {
+				if err := validateBGPFilterRule(rule, r.ipv6); err != nil {
+					return fmt.Errorf("filter %s %s[%d]: %w", f.Name, r.field, i, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateBGPFilterRule(rule operatorv1.BGPFilterRule, ipv6 bool) error {
+	switch rule.Action {
+	case operatorv1.BGPFilterActionAccept, operatorv1.BGPFilterActionReject:
+	default:
+		return fmt.Errorf("action %q is invalid, should be one of Accept, Reject", rule.Action)
+	}
+
+	switch rule.MatchOperator {
+	case "", operatorv1.BGPFilterMatchOperatorEqual, operatorv1.BGPFilterMatchOperatorNotEqual,
+		operatorv1.BGPFilterMatchOperatorIn, operatorv1.BGPFilterMatchOperatorNotIn:
+	default:
+		return fmt.Errorf("matchOperator %q is invalid, should be one of Equal, NotEqual, In, NotIn", rule.MatchOperator)
+	}
+
+	if (rule.CIDR == "") != (rule.MatchOperator == "") {
+		return fmt.Errorf("cidr and matchOperator must be set together")
+	}
+	if rule.CIDR == "" {
+		return nil
+	}
+	ip, _, err := net.ParseCIDR(rule.CIDR)
+	if err != nil {
+		return fmt.Errorf("cidr %s is invalid: %w", rule.CIDR, err)
+	}
+	if isV6 := ip.To4() == nil; isV6 != ipv6 {
+		if ipv6 {
+			return fmt.Errorf("cidr %s is not an IPv6 CIDR", rule.CIDR)
+		}
+		return fmt.Errorf("cidr %s is not an IPv4 CIDR", rule.CIDR)
+	}
+	return nil
+}
+
+// validateRouteReflectors checks that the route reflectors select nodes by valid labels and have a cluster ID in
+// the form of an IPv4 address.
+func validateRouteReflectors(rr *operatorv1.RouteReflectors) error {
+	if len(rr.NodeSelector) == 0 {
+		return fmt.Errorf("nodeSelector must select nodes by at least one label")
+	}
+	for k, v := range rr.NodeSelector {
+		if errs := utilvalidation.IsQualifiedName(k); len(errs) > 0 {
+			return fmt.Errorf("nodeSelector key %q is invalid: %s", k, strings.Join(errs, "; "))
+		}
+		if errs := utilvalidation.IsValidLabelValue(v); len(errs) > 0 {
+			return fmt.Errorf("nodeSelector value %q is invalid: %s", v, strings.Join(errs, "; "))
+		}
+	}
+	if ip := net.ParseIP(rr.ClusterID); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("clusterID %q must be an IPv4 address", rr.ClusterID)
+	}
+	return nil
+}
+
+// validateAdvertisedServiceClusterIPs checks that each advertised CIDR is valid and within one of the
+// service CIDRs of the cluster.
+func validateAdvertisedServiceClusterIPs(cidrs, serviceCIDRs []string) error {
+	if len(serviceCIDRs) == 0 {
+		return fmt.Errorf("spec.serviceCIDRs must be provided")
+	}
+	var serviceNets []*net.IPNet
+	for _, c := range serviceCIDRs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			return fmt.Errorf("service CIDR %q is not a valid CIDR", c)
+		}
+		serviceNets = append(serviceNets, n)
+	}
+	for _, c := range cidrs {
+		ip, n, err := net.ParseCIDR(c)
+		if err != nil {
+			return fmt.Errorf("%q is not a valid CIDR", c)
+		}
+		ones, bits := n.Mask.Size()
+		within := false
+		for _, sn := range serviceNets {
+			snOnes, snBits := sn.Mask.Size()
+			if bits == snBits && ones >= snOnes && sn.Contains(ip) {
+				within = true
+				break
+			}
+		}
+		if !within {
+			return fmt.Errorf("%s is not within spec.serviceCIDRs", c)
+		}
+	}
+	return nil
+}
+
+// validateDefaultPodSecurityContext checks that the default pod securityContext only sets fields that can be
+// applied to every component without breaking it.
+func validateDefaultPodSecurityContext(sc *corev1.PodSecurityContext) error {
+	if sc.SELinuxOptions != nil || sc.WindowsOptions != nil || sc.SupplementalGroups != nil ||
+		sc.Sysctls != nil || sc.FSGroupChangePolicy != nil {
+		return fmt.Errorf("only runAsNonRoot, runAsUser, runAsGroup, fsGroup and seccompProfile are supported")
+	}
+	if sc.RunAsNonRoot != nil && !*sc.RunAsNonRoot {
+		return fmt.Errorf("runAsNonRoot may only be set to true")
+	}
+	if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+		return fmt.Errorf("runAsUser must not be 0")
+	}
+	if sc.SeccompProfile != nil && sc.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined {
+		return fmt.Errorf("seccompProfile type %s is not supported", corev1.SeccompProfileTypeUnconfined)
+	}
+	return nil
+}
@@ -15,7 +15,9 @@
 package installation
 
 import (
+	"fmt"
 	"path/filepath"
+	"time"
 
 	"github.com/tigera/operator/pkg/render"
 
@@ -26,9 +28,12 @@ import (
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	operator "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/controller/k8sapi"
+	"github.com/tigera/operator/pkg/ptr"
 )
 
 var _ = Describe("Installation validation tests", func() {
@@ -53,6 +58,149 @@ var _ = Describe("Installation validation tests", func() {
 		}
 	})
 
+	It("should allow the OnDelete node update strategy", func() {
+		instance.Spec.NodeUpdateStrategy = appsv1.DaemonSetUpdateStrategy{
+			Type: appsv1.OnDeleteDaemonSetStrategyType,
+		}
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+	})
+
+	It("should not allow rollingUpdate parameters with the OnDelete node update strategy", func() {
+		one := intstr.FromInt(1)
+		instance.Spec.NodeUpdateStrategy = appsv1.DaemonSetUpdateStrategy{
+			Type:          appsv1.OnDeleteDaemonSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDaemonSet{MaxUnavailable: &one},
+		}
+		Expect(validateCustomResource(instance)).To(HaveOccurred())
+	})
+
+	It("should not allow an unknown node update strategy", func() {
+		instance.Spec.NodeUpdateStrategy.Type = "Unknown"
+		err := validateCustomResource(instance)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("'Unknown' is not supported"))
+	})
+
+	It("should allow a supported default pod securityContext", func() {
+		runAsNonRoot := true
+		runAsUser := int64(10001)
+		instance.Spec.DefaultPodSecurityContext = &v1.PodSecurityContext{
+			RunAsNonRoot:   &runAsNonRoot,
+			RunAsUser:      &runAsUser,
+			SeccompProfile: &v1.SeccompProfile{Type: v1.SeccompProfileTypeRuntimeDefault},
+		}
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("should reject an incompatible default pod securityContext", func(sc *v1.PodSecurityContext, expected string) {
+		instance.Spec.DefaultPodSecurityContext = sc
+		err := validateCustomResource(instance)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("spec.DefaultPodSecurityContext"))
+		Expect(err.Error()).To(ContainSubstring(expected))
+	},
+		Entry("root user", &v1.PodSecurityContext{RunAsUser: new(int64)}, "runAsUser must not be 0"),
+		Entry("runAsNonRoot false", &v1.PodSecurityContext{RunAsNonRoot: new(bool)}, "runAsNonRoot may only be set to true"),
+		Entry("unconfined seccomp", &v1.PodSecurityContext{SeccompProfile: &v1.SeccompProfile{Type: v1.SeccompProfileTypeUnconfined}}, "Unconfined"),
+		Entry("sysctls", &v1.PodSecurityContext{Sysctls: []v1.Sysctl{{Name: "net.ipv4.ip_forward", Value: "1"}}}, "only runAsNonRoot"),
+	)
+
+	It("should allow valid failsafe ports", func() {
+		ports := []operator.ProtoPort{{Protocol: "TCP", Port: 22}, {Protocol: "UDP", Port: 68, Net: "fd00::/8"}}
+		instance.Spec.FailsafePorts = &operator.FailsafePorts{Inbound: &ports, Outbound: &ports}
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("should reject invalid failsafe ports", func(port operator.ProtoPort, expected string) {
+		ports := []operator.ProtoPort{port}
+		instance.Spec.FailsafePorts = &operator.FailsafePorts{Outbound: &ports}
+		err := validateCustomResource(instance)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("spec.FailsafePorts.outbound"))
+		Expect(err.Error()).To(ContainSubstring(expected))
+	},
+		Entry("unsupported protocol", operator.ProtoPort{Protocol: "SCTP", Port: 22}, "protocol \"SCTP\""),
+		Entry("port out of range", operator.ProtoPort{Protocol: "TCP", Port: 70000}, "port 70000"),
+		Entry("invalid net", operator.ProtoPort{Protocol: "TCP", Port: 22, Net: "10.0.0.0"}, "net \"10.0.0.0\""),
+	)
+
+	It("should allow a concurrent node restart limit with an integer maxUnavailable", func() {
+		limit := int32(2)
+		five := intstr.FromInt(5)
+		instance.Spec.MaxConcurrentNodeRestarts = &limit
+		instance.Spec.NodeUpdateStrategy.RollingUpdate = &appsv1.RollingUpdateDaemonSet{MaxUnavailable: &five}
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+	})
+
+	It("should not allow a non-positive concurrent node restart limit", func() {
+		limit := int32(0)
+		instance.Spec.MaxConcurrentNodeRestarts = &limit
+		err := validateCustomResource(instance)
+		Expect(err).To(MatchError("Installation spec.MaxConcurrentNodeRestarts must be at least 1, got 0"))
+	})
+
+	It("should not allow a concurrent node restart limit with a percentage maxUnavailable", func() {
+		limit := int32(2)
+		pct := intstr.FromString("25%")
+		instance.Spec.MaxConcurrentNodeRestarts = &limit
+		instance.Spec.NodeUpdateStrategy.RollingUpdate = &appsv1.RollingUpdateDaemonSet{MaxUnavailable: &pct}
+		err := validateCustomResource(instance)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("maxUnavailable to be an integer"))
+	})
+
+	It("should allow resource quotas for operator managed namespaces", func() {
+		instance.Spec.NamespaceResourceQuotas = []operator.NamespaceResourceQuota{{
+			Namespace: "calico-system",
+			Spec:      v1.ResourceQuotaSpec{Hard: v1.ResourceList{v1.ResourcePods: resource.MustParse("50")}},
+		}}
+		Expect(validateNamespaceResourceQuotas(instance.Spec.NamespaceResourceQuotas, resourceQuotaNamespaces("tigera-dex"))).NotTo(HaveOccurred())
+	})
+
+	It("should not allow resource quotas for namespaces that are not managed by the operator", func() {
+		instance.Spec.NamespaceResourceQuotas = []operator.NamespaceResourceQuota{{Namespace: "default"}}
+		Expect(validateNamespaceResourceQuotas(instance.Spec.NamespaceResourceQuotas, resourceQuotaNamespaces("tigera-dex"))).To(HaveOccurred())
+	})
+
+	It("should not allow multiple resource quotas for the same namespace", func() {
+		instance.Spec.NamespaceResourceQuotas = []operator.NamespaceResourceQuota{{Namespace: "calico-system"}, {Namespace: "calico-system"}}
+		Expect(validateNamespaceResourceQuotas(instance.Spec.NamespaceResourceQuotas, resourceQuotaNamespaces("tigera-dex"))).To(HaveOccurred())
+	})
+
+	It("should not allow negative resource quota limits", func() {
+		instance.Spec.NamespaceResourceQuotas = []operator.NamespaceResourceQuota{{
+			Namespace: "calico-system",
+			Spec:      v1.ResourceQuotaSpec{Hard: v1.ResourceList{v1.ResourcePods: resource.MustParse("-1")}},
+		}}
+		Expect(validateNamespaceResourceQuotas(instance.Spec.NamespaceResourceQuotas, resourceQuotaNamespaces("tigera-dex"))).To(HaveOccurred())
+	})
+
+	It("should allow resource quotas for a custom dex namespace", func() {
+		instance.Spec.NamespaceResourceQuotas = []operator.NamespaceResourceQuota{{Namespace: "tenant-a-dex"}}
+		Expect(validateNamespaceResourceQuotas(instance.Spec.NamespaceResourceQuotas, resourceQuotaNamespaces("tenant-a-dex"))).NotTo(HaveOccurred())
+		Expect(validateNamespaceResourceQuotas(instance.Spec.NamespaceResourceQuotas, resourceQuotaNamespaces("tigera-dex"))).To(HaveOccurred())
+	})
+
+	It("should allow pod security admission modes that include Enforce", func() {
+		instance.Spec.PodSecurityAdmissionModes = []operator.PodSecurityAdmissionMode{
+			operator.PodSecurityAdmissionModeEnforce, operator.PodSecurityAdmissionModeAudit, operator.PodSecurityAdmissionModeWarn,
+		}
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+	})
+
+	It("should not allow pod security admission modes without Enforce", func() {
+		instance.Spec.PodSecurityAdmissionModes = []operator.PodSecurityAdmissionMode{operator.PodSecurityAdmissionModeWarn}
+		Expect(validateCustomResource(instance)).To(MatchError("Installation spec.PodSecurityAdmissionModes must include Enforce"))
+	})
+
+	It("should not allow unknown or repeated pod security admission modes", func() {
+		instance.Spec.PodSecurityAdmissionModes = []operator.PodSecurityAdmissionMode{operator.PodSecurityAdmissionModeEnforce, "Exempt"}
+		Expect(validateCustomResource(instance)).To(MatchError(ContainSubstring(`value "Exempt" is not valid`)))
+
+		instance.Spec.PodSecurityAdmissionModes = []operator.PodSecurityAdmissionMode{operator.PodSecurityAdmissionModeEnforce, operator.PodSecurityAdmissionModeEnforce}
+		Expect(validateCustomResource(instance)).To(MatchError(`Installation spec.PodSecurityAdmissionModes value "Enforce" is specified more than once`))
+	})
+
 	It("should allow IPv6 if BPF is enabled", func() {
 		bpf := operator.LinuxDataplaneBPF
 		enabled := operator.BGPEnabled
@@ -144,6 +292,42 @@ var _ = Describe("Installation validation tests", func() {
 		Expect(err).To(MatchError("no more than one node address autodetection method can be specified per-family"))
 	})
 
+	It("should allow multiple interface regexes for node address autodetection", func() {
+		instance.Spec.CalicoNetwork.NodeAddressAutodetectionV4 = &operator.NodeAddressAutodetection{
+			Interface: "^bond[0-9]+$, ^eth0\\.[0-9]+$",
+		}
+		instance.Spec.CalicoNetwork.NodeAddressAutodetectionV6 = &operator.NodeAddressAutodetection{
+			SkipInterface: "^docker.*,^veth.*",
+		}
+		Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+	})
+
+	It("should prevent interface and skipInterface autodetection together", func() {
+		instance.Spec.CalicoNetwork.NodeAddressAutodetectionV4 = &operator.NodeAddressAutodetection{
+			Interface:     "^bond[0-9]+$",
+			SkipInterface: "^bond0$",
+		}
+		err := validateCustomResource(instance)
+		Expect(err).To(MatchError("no more than one node address autodetection method can be specified per-family"))
+	})
+
+	It("should prevent invalid, empty and duplicate interface regexes", func() {
+		instance.Spec.CalicoNetwork.NodeAddressAutodetectionV4 = &operator.NodeAddressAutodetection{
+			Interface: "^bond[0-9+$",
+		}
+		Expect(validateCustomResource(instance)).To(MatchError(ContainSubstring(`node address autodetection interface pattern "^bond[0-9+$" is not a valid regex`)))
+
+		instance.Spec.CalicoNetwork.NodeAddressAutodetectionV4 = &operator.NodeAddressAutodetection{
+			SkipInterface: "^docker.*,,^veth.*",
+		}
+		Expect(validateCustomResource(instance)).To(MatchError(`node address autodetection skipInterface "^docker.*,,^veth.*" contains an empty pattern`))
+
+		instance.Spec.CalicoNetwork.NodeAddressAutodetectionV4 = &operator.NodeAddressAutodetection{
+			Interface: "^bond0$, ^bond0$",
+		}
+		Expect(validateCustomResource(instance)).To(MatchError(`node address autodetection interface pattern "^bond0$" is specified more than once`))
+	})
+
 	It("should allow autodetection based on Kubernetes node IP", func() {
 		nodeIP := operator.NodeInternalIP
 		instance.Spec.CalicoNetwork.NodeAddressAutodetectionV4 = &operator.NodeAddressAutodetection{
@@ -792,171 +976,1215 @@ var _ = Describe("Installation validation tests", func() {
 		})
 	})
 
-	It("validate custom installation", func() {
-		disabled := operator.BGPDisabled
-		ipfw := operator.ContainerIPForwardingEnabled
-		var twentyEight int32 = 28
-		instance = &operator.Installation{
-			Spec: operator.InstallationSpec{
-				KubernetesProvider: operator.ProviderAKS,
-				ImagePullSecrets:   []v1.LocalObjectReference{},
-				CalicoNetwork: &operator.CalicoNetworkSpec{
-					BGP:                   &disabled,
-					ContainerIPForwarding: &ipfw,
-					IPPools: []operator.IPPool{
-						{
-							CIDR:          "192.168.0.0/27",
-							BlockSize:     &twentyEight,
-							Encapsulation: operator.EncapsulationNone,
-						},
-					},
-				},
-				ServiceCIDRs: []string{"10.96.0.0/12"},
-				CNI: &operator.CNISpec{
-					Type: operator.PluginCalico,
-					IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginHostLocal},
-				},
-			},
-		}
-		// Populate the k8s service endpoint (required for windows)
-		k8sapi.Endpoint = k8sapi.ServiceEndpoint{
-			Host: "1.2.3.4",
-			Port: "6443",
-		}
-		Expect(fillDefaults(instance, nil)).NotTo(HaveOccurred())
-		err := validateCustomResource(instance)
-		Expect(err).NotTo(HaveOccurred())
-	})
-
-	Describe("validate CalicoNodeDaemonSet", func() {
-		It("should return nil when it is empty", func() {
-			instance.Spec.CalicoNodeDaemonSet = &operator.CalicoNodeDaemonSet{}
+	Describe("validate CalicoNetwork BGPReadinessGate", func() {
+		It("should return an error when the readiness gate is enabled without BGP", func() {
+			gate := operator.BGPReadinessGateEnabled
+			bgp := operator.BGPDisabled
+			instance.Spec.CalicoNetwork.BGPReadinessGate = &gate
+			instance.Spec.CalicoNetwork.BGP = &bgp
 			err := validateCustomResource(instance)
-			Expect(err).NotTo(HaveOccurred())
+			Expect(err).To(MatchError("spec.calicoNetwork.bgpReadinessGate requires BGP to be enabled"))
 		})
 
-		It("should return an error if it is invalid", func() {
-			instance.Spec.CalicoNodeDaemonSet = &operator.CalicoNodeDaemonSet{
-				Metadata: &operator.Metadata{
-					Labels: map[string]string{
-						"NoUppercaseOrSpecialCharsLike=Equals":    "b",
-						"WowNoUppercaseOrSpecialCharsLike=Equals": "b",
-					},
-					Annotations: map[string]string{
-						"AnnotNoUppercaseOrSpecialCharsLike=Equals": "bar",
-					},
-				},
-			}
+		It("should return an error for an invalid value", func() {
+			gate := operator.BGPReadinessGateType("Maybe")
+			instance.Spec.CalicoNetwork.BGPReadinessGate = &gate
 			err := validateCustomResource(instance)
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError("Maybe is invalid for spec.calicoNetwork.bgpReadinessGate, should be one of Enabled, Disabled"))
+		})
 
-			var invalidMinReadySeconds int32 = -1
-			instance.Spec.CalicoNodeDaemonSet = &operator.CalicoNodeDaemonSet{
-				Spec: &operator.CalicoNodeDaemonSetSpec{
-					MinReadySeconds: &invalidMinReadySeconds,
-				},
-			}
-			err = validateCustomResource(instance)
-			Expect(err).To(HaveOccurred())
+		It("should not error when the readiness gate is enabled with BGP", func() {
+			gate := operator.BGPReadinessGateEnabled
+			bgp := operator.BGPEnabled
+			instance.Spec.CalicoNetwork.BGPReadinessGate = &gate
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should not error when the readiness gate is disabled without BGP", func() {
+			gate := operator.BGPReadinessGateDisabled
+			bgp := operator.BGPDisabled
+			instance.Spec.CalicoNetwork.BGPReadinessGate = &gate
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
 		})
 	})
 
-	Describe("validate CalicoNodeWindowsDaemonSet", func() {
-		It("should return nil when it is empty", func() {
-			instance.Spec.CalicoNodeWindowsDaemonSet = &operator.CalicoNodeWindowsDaemonSet{}
+	Describe("validate CalicoNetwork BPFExternalServiceMode", func() {
+		iptablesDataplane := operator.LinuxDataplaneIptables
+
+		DescribeTable("should accept the BPF external service mode with the BPF dataplane",
+			func(mode operator.BPFExternalServiceModeType) {
+				dp := operator.LinuxDataplaneBPF
+				instance.Spec.CalicoNetwork.LinuxDataplane = &dp
+				instance.Spec.CalicoNetwork.BPFExternalServiceMode = &mode
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("Tunnel", operator.BPFExternalServiceModeTunnel),
+			Entry("DSR", operator.BPFExternalServiceModeDSR),
+		)
+
+		DescribeTable("should reject the BPF external service mode with another dataplane",
+			func(dp *operator.LinuxDataplaneOption) {
+				mode := operator.BPFExternalServiceModeDSR
+				instance.Spec.CalicoNetwork.LinuxDataplane = dp
+				instance.Spec.CalicoNetwork.BPFExternalServiceMode = &mode
+				err := validateCustomResource(instance)
+				Expect(err).To(MatchError("spec.calicoNetwork.bpfExternalServiceMode is supported only for the BPF Linux dataplane"))
+			},
+			Entry("default dataplane", nil),
+			Entry("Iptables", &iptablesDataplane),
+		)
+
+		It("should return an error for an invalid value", func() {
+			dp := operator.LinuxDataplaneBPF
+			mode := operator.BPFExternalServiceModeType("Direct")
+			instance.Spec.CalicoNetwork.LinuxDataplane = &dp
+			instance.Spec.CalicoNetwork.BPFExternalServiceMode = &mode
 			err := validateCustomResource(instance)
-			Expect(err).NotTo(HaveOccurred())
+			Expect(err).To(MatchError("Direct is invalid for spec.calicoNetwork.bpfExternalServiceMode, should be one of Tunnel, DSR"))
 		})
+	})
 
-		It("should return an error if it is invalid", func() {
-			instance.Spec.CalicoNodeWindowsDaemonSet = &operator.CalicoNodeWindowsDaemonSet{
-				Metadata: &operator.Metadata{
-					Labels: map[string]string{
-						"NoUppercaseOrSpecialCharsLike=Equals":    "b",
-						"WowNoUppercaseOrSpecialCharsLike=Equals": "b",
-					},
-					Annotations: map[string]string{
-						"AnnotNoUppercaseOrSpecialCharsLike=Equals": "bar",
-					},
-				},
-			}
+	Describe("validate CalicoNetwork BPFConnectTimeLoadBalancing", func() {
+		iptablesDataplane := operator.LinuxDataplaneIptables
+
+		DescribeTable("should accept connect-time load balancing with the BPF dataplane",
+			func(ctlb operator.BPFConnectTimeLoadBalancingType) {
+				dp := operator.LinuxDataplaneBPF
+				instance.Spec.CalicoNetwork.LinuxDataplane = &dp
+				instance.Spec.CalicoNetwork.BPFConnectTimeLoadBalancing = &ctlb
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("TCP", operator.BPFConnectTimeLoadBalancingTCP),
+			Entry("Enabled", operator.BPFConnectTimeLoadBalancingEnabled),
+			Entry("Disabled", operator.BPFConnectTimeLoadBalancingDisabled),
+		)
+
+		DescribeTable("should reject connect-time load balancing with another dataplane",
+			func(dp *operator.LinuxDataplaneOption) {
+				ctlb := operator.BPFConnectTimeLoadBalancingTCP
+				instance.Spec.CalicoNetwork.LinuxDataplane = dp
+				instance.Spec.CalicoNetwork.BPFConnectTimeLoadBalancing = &ctlb
+				err := validateCustomResource(instance)
+				Expect(err).To(MatchError("spec.calicoNetwork.bpfConnectTimeLoadBalancing is supported only for the BPF Linux dataplane"))
+			},
+			Entry("default dataplane", nil),
+			Entry("Iptables", &iptablesDataplane),
+		)
+
+		It("should return an error for an invalid value", func() {
+			dp := operator.LinuxDataplaneBPF
+			ctlb := operator.BPFConnectTimeLoadBalancingType("UDP")
+			instance.Spec.CalicoNetwork.LinuxDataplane = &dp
+			instance.Spec.CalicoNetwork.BPFConnectTimeLoadBalancing = &ctlb
 			err := validateCustomResource(instance)
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError("UDP is invalid for spec.calicoNetwork.bpfConnectTimeLoadBalancing, should be one of TCP, Enabled, Disabled"))
+		})
+	})
 
-			var invalidMinReadySeconds int32 = -1
-			instance.Spec.CalicoNodeWindowsDaemonSet = &operator.CalicoNodeWindowsDaemonSet{
-				Spec: &operator.CalicoNodeWindowsDaemonSetSpec{
-					MinReadySeconds: &invalidMinReadySeconds,
-				},
-			}
-			err = validateCustomResource(instance)
-			Expect(err).To(HaveOccurred())
+	Describe("validate CalicoNetwork DefaultEndpointToHostAction", func() {
+		DescribeTable("should accept a valid action",
+			func(action operator.EndpointToHostActionType) {
+				instance.Spec.CalicoNetwork.DefaultEndpointToHostAction = &action
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("Drop", operator.EndpointToHostActionDrop),
+			Entry("Return", operator.EndpointToHostActionReturn),
+			Entry("Accept", operator.EndpointToHostActionAccept),
+		)
+
+		It("should return an error for an invalid value", func() {
+			action := operator.EndpointToHostActionType("Reject")
+			instance.Spec.CalicoNetwork.DefaultEndpointToHostAction = &action
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("Reject is invalid for spec.calicoNetwork.defaultEndpointToHostAction, should be one of Drop, Return, Accept"))
 		})
 	})
 
-	Describe("validate CalicoKubeControllersDeployment", func() {
-		It("should return nil when it is empty", func() {
-			instance.Spec.CalicoKubeControllersDeployment = &operator.CalicoKubeControllersDeployment{}
+	Describe("validate CalicoNetwork AWSSrcDstCheck", func() {
+		DescribeTable("should accept a valid value on EKS",
+			func(check operator.AWSSrcDstCheckType) {
+				instance.Spec.KubernetesProvider = operator.ProviderEKS
+				instance.Spec.CalicoNetwork.AWSSrcDstCheck = &check
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("DoNothing", operator.AWSSrcDstCheckDoNothing),
+			Entry("Enable", operator.AWSSrcDstCheckEnable),
+			Entry("Disable", operator.AWSSrcDstCheckDisable),
+		)
+
+		DescribeTable("should reject the setting with another provider",
+			func(provider operator.Provider) {
+				check := operator.AWSSrcDstCheckDisable
+				instance.Spec.KubernetesProvider = provider
+				instance.Spec.CalicoNetwork.AWSSrcDstCheck = &check
+				err := validateCustomResource(instance)
+				Expect(err).To(MatchError("spec.calicoNetwork.awsSrcDstCheck is supported only for the EKS provider"))
+			},
+			Entry("no provider", operator.ProviderNone),
+			Entry("GKE", operator.ProviderGKE),
+		)
+
+		It("should return an error for an invalid value", func() {
+			check := operator.AWSSrcDstCheckType("Off")
+			instance.Spec.KubernetesProvider = operator.ProviderEKS
+			instance.Spec.CalicoNetwork.AWSSrcDstCheck = &check
 			err := validateCustomResource(instance)
-			Expect(err).NotTo(HaveOccurred())
+			Expect(err).To(MatchError("Off is invalid for spec.calicoNetwork.awsSrcDstCheck, should be one of DoNothing, Enable, Disable"))
 		})
+	})
 
-		It("should return an error if it is invalid", func() {
-			instance.Spec.CalicoKubeControllersDeployment = &operator.CalicoKubeControllersDeployment{
-				Metadata: &operator.Metadata{
-					Labels: map[string]string{
-						"NoUppercaseOrSpecialCharsLike=Equals":    "b",
-						"WowNoUppercaseOrSpecialCharsLike=Equals": "b",
-					},
-					Annotations: map[string]string{
-						"AnnotNoUppercaseOrSpecialCharsLike=Equals": "bar",
-					},
-				},
+	Describe("validate ServiceAccountAnnotations", func() {
+		It("should accept annotations for supported components", func() {
+			instance.Spec.ServiceAccountAnnotations = []operator.ServiceAccountAnnotations{
+				{ComponentName: operator.ComponentNameGuardian, Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/guardian"}},
+				{ComponentName: operator.ComponentNameESGateway, Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/es-gateway"}},
 			}
-			err := validateCustomResource(instance)
-			Expect(err).To(HaveOccurred())
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
 
-			var invalidMinReadySeconds int32 = -1
-			instance.Spec.CalicoKubeControllersDeployment = &operator.CalicoKubeControllersDeployment{
-				Spec: &operator.CalicoKubeControllersDeploymentSpec{
-					MinReadySeconds: &invalidMinReadySeconds,
-				},
+		It("should reject an unsupported component", func() {
+			instance.Spec.ServiceAccountAnnotations = []operator.ServiceAccountAnnotations{
+				{ComponentName: operator.ComponentNameNodeWindows, Annotations: map[string]string{"a": "b"}},
 			}
-			err = validateCustomResource(instance)
-			Expect(err).To(HaveOccurred())
+			Expect(validateCustomResource(instance)).To(MatchError("Installation spec.serviceAccountAnnotations.componentName NodeWindows is not supported"))
 		})
-	})
 
-	Describe("validate TyphaDeployment", func() {
-		It("should return nil when it is empty", func() {
-			instance.Spec.TyphaDeployment = &operator.TyphaDeployment{}
-			err := validateCustomResource(instance)
-			Expect(err).NotTo(HaveOccurred())
+		It("should reject a component configured more than once", func() {
+			instance.Spec.ServiceAccountAnnotations = []operator.ServiceAccountAnnotations{
+				{ComponentName: operator.ComponentNameTypha, Annotations: map[string]string{"a": "b"}},
+				{ComponentName: operator.ComponentNameTypha, Annotations: map[string]string{"c": "d"}},
+			}
+			Expect(validateCustomResource(instance)).To(MatchError("Installation spec.serviceAccountAnnotations has more than one entry for component Typha"))
 		})
 
-		It("should return an error if it is invalid", func() {
-			instance.Spec.TyphaDeployment = &operator.TyphaDeployment{
-				Metadata: &operator.Metadata{
-					Labels: map[string]string{
-						"NoUppercaseOrSpecialCharsLike=Equals":    "b",
-						"WowNoUppercaseOrSpecialCharsLike=Equals": "b",
-					},
-					Annotations: map[string]string{
-						"AnnotNoUppercaseOrSpecialCharsLike=Equals": "bar",
-					},
-				},
+		It("should reject an invalid annotation key", func() {
+			instance.Spec.ServiceAccountAnnotations = []operator.ServiceAccountAnnotations{
+				{ComponentName: operator.ComponentNameLinseed, Annotations: map[string]string{"not a key": "b"}},
 			}
 			err := validateCustomResource(instance)
 			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.serviceAccountAnnotations[0].annotations"))
+		})
+	})
 
-			var invalidMinReadySeconds int32 = -1
-			instance.Spec.TyphaDeployment = &operator.TyphaDeployment{
-				Spec: &operator.TyphaDeploymentSpec{
-					MinReadySeconds: &invalidMinReadySeconds,
-				},
-			}
-			err = validateCustomResource(instance)
+	Describe("validate CalicoNetwork WireguardHostEncryption", func() {
+		DescribeTable("should accept a valid value",
+			func(hostEncryption operator.WireguardHostEncryptionType) {
+				instance.Spec.CalicoNetwork.WireguardHostEncryption = &hostEncryption
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("Enabled", operator.WireguardHostEncryptionEnabled),
+			Entry("Disabled", operator.WireguardHostEncryptionDisabled),
+		)
+
+		It("should return an error for an invalid value", func() {
+			hostEncryption := operator.WireguardHostEncryptionType("On")
+			instance.Spec.CalicoNetwork.WireguardHostEncryption = &hostEncryption
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("On is invalid for spec.calicoNetwork.wireguardHostEncryption, should be one of Enabled, Disabled"))
+		})
+	})
+
+	Describe("validate CalicoNetwork FloatingIPs", func() {
+		DescribeTable("should accept a valid value",
+			func(fips operator.FloatingIPsType) {
+				instance.Spec.CalicoNetwork.FloatingIPs = &fips
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("Enabled", operator.FloatingIPsEnabled),
+			Entry("Disabled", operator.FloatingIPsDisabled),
+		)
+
+		It("should return an error for an invalid value", func() {
+			fips := operator.FloatingIPsType("On")
+			instance.Spec.CalicoNetwork.FloatingIPs = &fips
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("On is invalid for spec.calicoNetwork.floatingIPs, should be one of Enabled, Disabled"))
+		})
+	})
+
+	Describe("validate CalicoNetwork XDPAcceleration", func() {
+		iptablesDataplane := operator.LinuxDataplaneIptables
+		bpfDataplane := operator.LinuxDataplaneBPF
+
+		DescribeTable("should accept XDP acceleration with a compatible dataplane",
+			func(xdp operator.XDPAccelerationType, dp *operator.LinuxDataplaneOption) {
+				instance.Spec.CalicoNetwork.XDPAcceleration = &xdp
+				instance.Spec.CalicoNetwork.LinuxDataplane = dp
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("Enabled, default dataplane", operator.XDPAccelerationEnabled, nil),
+			Entry("Enabled, Iptables", operator.XDPAccelerationEnabled, &iptablesDataplane),
+			Entry("Generic, Iptables", operator.XDPAccelerationGeneric, &iptablesDataplane),
+			Entry("Disabled, BPF", operator.XDPAccelerationDisabled, &bpfDataplane),
+		)
+
+		DescribeTable("should reject XDP acceleration with an incompatible dataplane",
+			func(xdp operator.XDPAccelerationType, dp operator.LinuxDataplaneOption) {
+				instance.Spec.CalicoNetwork.XDPAcceleration = &xdp
+				instance.Spec.CalicoNetwork.LinuxDataplane = &dp
+				err := validateCustomResource(instance)
+				Expect(err).To(MatchError("spec.calicoNetwork.xdpAcceleration is supported only for the Iptables Linux dataplane"))
+			},
+			Entry("Enabled, BPF", operator.XDPAccelerationEnabled, operator.LinuxDataplaneBPF),
+			Entry("Generic, BPF", operator.XDPAccelerationGeneric, operator.LinuxDataplaneBPF),
+		)
+
+		It("should return an error for an invalid value", func() {
+			xdp := operator.XDPAccelerationType("Offload")
+			instance.Spec.CalicoNetwork.XDPAcceleration = &xdp
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("Offload is invalid for spec.calicoNetwork.xdpAcceleration, should be one of Disabled, Enabled, Generic"))
+		})
+	})
+
+	Describe("validate CalicoNetwork BGPGracefulRestartTime", func() {
+		It("should not error for a positive duration with BGP enabled", func() {
+			bgp := operator.BGPEnabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			instance.Spec.CalicoNetwork.BGPGracefulRestartTime = &metav1.Duration{Duration: 2 * time.Minute}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error when BGP is disabled", func() {
+			bgp := operator.BGPDisabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			instance.Spec.CalicoNetwork.BGPGracefulRestartTime = &metav1.Duration{Duration: 2 * time.Minute}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.bgpGracefulRestartTime requires BGP to be enabled"))
+		})
+
+		It("should return an error for a duration that is not positive", func() {
+			bgp := operator.BGPEnabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			instance.Spec.CalicoNetwork.BGPGracefulRestartTime = &metav1.Duration{}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.bgpGracefulRestartTime must be a positive duration, got 0s"))
+		})
+	})
+
+	Describe("validate CalicoNetwork DNSExtraTTL", func() {
+		It("should not error for a positive duration on Calico Enterprise", func() {
+			instance.Spec.Variant = operator.TigeraSecureEnterprise
+			instance.Spec.CalicoNetwork.DNSExtraTTL = &metav1.Duration{Duration: time.Minute}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error on Calico", func() {
+			instance.Spec.Variant = operator.Calico
+			instance.Spec.CalicoNetwork.DNSExtraTTL = &metav1.Duration{Duration: time.Minute}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.dnsExtraTTL is only supported for Calico Enterprise"))
+		})
+
+		It("should return an error for a duration that is not positive", func() {
+			instance.Spec.Variant = operator.TigeraSecureEnterprise
+			instance.Spec.CalicoNetwork.DNSExtraTTL = &metav1.Duration{Duration: -time.Second}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.dnsExtraTTL must be a positive duration, got -1s"))
+		})
+	})
+
+	Describe("validate CalicoNetwork conntrack cleanup intervals", func() {
+		It("should not error for a positive conntrack cleanup interval", func() {
+			instance.Spec.CalicoNetwork.ConntrackCleanupInterval = &metav1.Duration{Duration: 5 * time.Second}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error for a conntrack cleanup interval that is not positive", func() {
+			instance.Spec.CalicoNetwork.ConntrackCleanupInterval = &metav1.Duration{}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.conntrackCleanupInterval must be a positive duration, got 0s"))
+		})
+
+		It("should not error for a positive BPF conntrack cleanup interval with the BPF dataplane", func() {
+			bpf := operator.LinuxDataplaneBPF
+			instance.Spec.CalicoNetwork.LinuxDataplane = &bpf
+			instance.Spec.CalicoNetwork.NodeAddressAutodetectionV4 = &operator.NodeAddressAutodetection{CanReach: "8.8.8.8"}
+			instance.Spec.CalicoNetwork.BPFConntrackCleanupInterval = &metav1.Duration{Duration: 5 * time.Second}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error for a BPF conntrack cleanup interval without the BPF dataplane", func() {
+			instance.Spec.CalicoNetwork.BPFConntrackCleanupInterval = &metav1.Duration{Duration: 5 * time.Second}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.bpfConntrackCleanupInterval is supported only for the BPF Linux dataplane"))
+		})
+
+		It("should return an error for a BPF conntrack cleanup interval that is not positive", func() {
+			bpf := operator.LinuxDataplaneBPF
+			instance.Spec.CalicoNetwork.LinuxDataplane = &bpf
+			instance.Spec.CalicoNetwork.NodeAddressAutodetectionV4 = &operator.NodeAddressAutodetection{CanReach: "8.8.8.8"}
+			instance.Spec.CalicoNetwork.BPFConntrackCleanupInterval = &metav1.Duration{Duration: -time.Second}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.bpfConntrackCleanupInterval must be a positive duration, got -1s"))
+		})
+	})
+
+	Describe("validate CalicoNetwork IptablesRefreshInterval", func() {
+		It("should not error for a positive interval when the dataplane is unset", func() {
+			instance.Spec.CalicoNetwork.IptablesRefreshInterval = &metav1.Duration{Duration: 180 * time.Second}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should not error for a positive interval with the Iptables dataplane", func() {
+			iptables := operator.LinuxDataplaneIptables
+			instance.Spec.CalicoNetwork.LinuxDataplane = &iptables
+			instance.Spec.CalicoNetwork.IptablesRefreshInterval = &metav1.Duration{Duration: 180 * time.Second}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error for an interval that is not positive", func() {
+			instance.Spec.CalicoNetwork.IptablesRefreshInterval = &metav1.Duration{}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.iptablesRefreshInterval must be a positive duration, got 0s"))
+		})
+
+		It("should return an error for an interval with the BPF dataplane", func() {
+			bpf := operator.LinuxDataplaneBPF
+			instance.Spec.CalicoNetwork.LinuxDataplane = &bpf
+			instance.Spec.CalicoNetwork.NodeAddressAutodetectionV4 = &operator.NodeAddressAutodetection{CanReach: "8.8.8.8"}
+			instance.Spec.CalicoNetwork.IptablesRefreshInterval = &metav1.Duration{Duration: 180 * time.Second}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.iptablesRefreshInterval is supported only for the Iptables Linux dataplane"))
+		})
+	})
+
+	Describe("validate CalicoNetwork BPF map sizes", func() {
+		BeforeEach(func() {
+			bpf := operator.LinuxDataplaneBPF
+			instance.Spec.CalicoNetwork.LinuxDataplane = &bpf
+			instance.Spec.CalicoNetwork.NodeAddressAutodetectionV4 = &operator.NodeAddressAutodetection{CanReach: "8.8.8.8"}
+		})
+
+		It("should not error for positive sizes with the BPF dataplane", func() {
+			instance.Spec.CalicoNetwork.BPFMapSizes = &operator.BPFMapSizes{
+				Conntrack:   ptr.Int32ToPtr(1024000),
+				NATFrontend: ptr.Int32ToPtr(131072),
+				NATBackend:  ptr.Int32ToPtr(524288),
+				Route:       ptr.Int32ToPtr(524288),
+			}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error without the BPF dataplane", func() {
+			iptables := operator.LinuxDataplaneIptables
+			instance.Spec.CalicoNetwork.LinuxDataplane = &iptables
+			instance.Spec.CalicoNetwork.BPFMapSizes = &operator.BPFMapSizes{Conntrack: ptr.Int32ToPtr(1024000)}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.bpfMapSizes is supported only for the BPF Linux dataplane"))
+		})
+
+		DescribeTable("should return an error for a size that is not positive", func(sizes *operator.BPFMapSizes, expected string) {
+			instance.Spec.CalicoNetwork.BPFMapSizes = sizes
+			Expect(validateCustomResource(instance)).To(MatchError(expected))
+		},
+			Entry("conntrack", &operator.BPFMapSizes{Conntrack: ptr.Int32ToPtr(0)}, "spec.calicoNetwork.bpfMapSizes.conntrack must be positive, got 0"),
+			Entry("natFrontend", &operator.BPFMapSizes{NATFrontend: ptr.Int32ToPtr(-1)}, "spec.calicoNetwork.bpfMapSizes.natFrontend must be positive, got -1"),
+			Entry("natBackend", &operator.BPFMapSizes{NATBackend: ptr.Int32ToPtr(0)}, "spec.calicoNetwork.bpfMapSizes.natBackend must be positive, got 0"),
+			Entry("route", &operator.BPFMapSizes{Route: ptr.Int32ToPtr(-5)}, "spec.calicoNetwork.bpfMapSizes.route must be positive, got -5"),
+		)
+	})
+
+	Describe("validate CalicoNetwork PolicySync", func() {
+		It("should not error when enabled with the Iptables dataplane", func() {
+			ps := operator.PolicySyncEnabled
+			instance.Spec.CalicoNetwork.PolicySync = &ps
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error when enabled with the VPP dataplane", func() {
+			ps := operator.PolicySyncEnabled
+			dp := operator.LinuxDataplaneVPP
+			bgp := operator.BGPEnabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			instance.Spec.CalicoNetwork.PolicySync = &ps
+			instance.Spec.CalicoNetwork.LinuxDataplane = &dp
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.policySync is not supported with the VPP dataplane"))
+		})
+
+		It("should return an error for an invalid value", func() {
+			ps := operator.PolicySyncType("Sometimes")
+			instance.Spec.CalicoNetwork.PolicySync = &ps
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("Sometimes is invalid for spec.calicoNetwork.policySync, should be one of Enabled, Disabled"))
+		})
+	})
+
+	Describe("validate CalicoNetwork EndpointStatusReporting", func() {
+		DescribeTable("should accept a valid value",
+			func(esr operator.EndpointStatusReportingType) {
+				instance.Spec.CalicoNetwork.EndpointStatusReporting = &esr
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("Enabled", operator.EndpointStatusReportingEnabled),
+			Entry("Disabled", operator.EndpointStatusReportingDisabled),
+		)
+
+		It("should return an error for an invalid value", func() {
+			esr := operator.EndpointStatusReportingType("On")
+			instance.Spec.CalicoNetwork.EndpointStatusReporting = &esr
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("On is invalid for spec.calicoNetwork.endpointStatusReporting, should be one of Enabled, Disabled"))
+		})
+	})
+
+	Describe("validate CNI IPAM StrictAffinity", func() {
+		It("should not error with Calico IPAM", func() {
+			strictAffinity := true
+			instance.Spec.CNI.IPAM.StrictAffinity = &strictAffinity
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error with host-local IPAM", func() {
+			strictAffinity := true
+			instance.Spec.CNI.IPAM = &operator.IPAMSpec{Type: operator.IPAMPluginHostLocal, StrictAffinity: &strictAffinity}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.cni.ipam.strictAffinity is only supported with spec.cni.ipam.type Calico"))
+		})
+	})
+
+	Describe("validate CalicoNetwork BGPListenPort", func() {
+		It("should not error for a valid port with BGP enabled", func() {
+			bgp := operator.BGPEnabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			port := int32(1790)
+			instance.Spec.CalicoNetwork.BGPListenPort = &port
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error when BGP is disabled", func() {
+			bgp := operator.BGPDisabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			port := int32(1790)
+			instance.Spec.CalicoNetwork.BGPListenPort = &port
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.bgpListenPort requires BGP to be enabled"))
+		})
+
+		It("should return an error for a port outside the valid range", func() {
+			bgp := operator.BGPEnabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			port := int32(70000)
+			instance.Spec.CalicoNetwork.BGPListenPort = &port
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.bgpListenPort must be between 1 and 65535, got 70000"))
+		})
+	})
+
+	Describe("validate CalicoNetwork NATPortRange", func() {
+		DescribeTable("should accept a valid port range",
+			func(portRange string) {
+				instance.Spec.CalicoNetwork.NATPortRange = &portRange
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("a range", "32768:65535"),
+			Entry("a single port", "40000"),
+		)
+
+		DescribeTable("should return an error for an invalid port range",
+			func(portRange string) {
+				instance.Spec.CalicoNetwork.NATPortRange = &portRange
+				err := validateCustomResource(instance)
+				Expect(err).To(MatchError(fmt.Sprintf("%q is invalid for spec.calicoNetwork.natPortRange, should be a port or a range of ports such as 32768:65535", portRange)))
+			},
+			Entry("a reversed range", "65535:32768"),
+			Entry("a port out of range", "32768:70000"),
+			Entry("port zero", "0:100"),
+			Entry("a named port", "http"),
+			Entry("an empty string", ""),
+		)
+	})
+
+	Describe("validate CalicoNetwork RemoveExternalRoutes", func() {
+		DescribeTable("should accept a valid value",
+			func(rer operator.RemoveExternalRoutesType) {
+				instance.Spec.CalicoNetwork.RemoveExternalRoutes = &rer
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("Enabled", operator.RemoveExternalRoutesEnabled),
+			Entry("Disabled", operator.RemoveExternalRoutesDisabled),
+		)
+
+		It("should return an error for an invalid value", func() {
+			rer := operator.RemoveExternalRoutesType("Off")
+			instance.Spec.CalicoNetwork.RemoveExternalRoutes = &rer
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("Off is invalid for spec.calicoNetwork.removeExternalRoutes, should be one of Enabled, Disabled"))
+		})
+	})
+
+	Describe("validate CalicoNetwork VXLANPort", func() {
+		vxlanPool := func(encap operator.EncapsulationType) []operator.IPPool {
+			return []operator.IPPool{{
+				CIDR:          "192.168.0.0/16",
+				NATOutgoing:   operator.NATOutgoingEnabled,
+				Encapsulation: encap,
+				NodeSelector:  "all()",
+			}}
+		}
+
+		DescribeTable("should not error for a valid port with a VXLAN pool",
+			func(encap operator.EncapsulationType) {
+				instance.Spec.CalicoNetwork.IPPools = vxlanPool(encap)
+				port := int32(4799)
+				instance.Spec.CalicoNetwork.VXLANPort = &port
+				Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+			},
+			Entry("VXLAN", operator.EncapsulationVXLAN),
+			Entry("VXLANCrossSubnet", operator.EncapsulationVXLANCrossSubnet),
+		)
+
+		It("should return an error when no IP pool uses VXLAN", func() {
+			bgp := operator.BGPEnabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			instance.Spec.CalicoNetwork.IPPools = vxlanPool(operator.EncapsulationIPIP)
+			port := int32(4799)
+			instance.Spec.CalicoNetwork.VXLANPort = &port
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.vxlanPort requires an IP pool with VXLAN encapsulation"))
+		})
+
+		It("should return an error for a port outside the valid range", func() {
+			instance.Spec.CalicoNetwork.IPPools = vxlanPool(operator.EncapsulationVXLAN)
+			port := int32(0)
+			instance.Spec.CalicoNetwork.VXLANPort = &port
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.vxlanPort must be between 1 and 65535, got 0"))
+		})
+	})
+
+	Describe("validate NodeLocalDNSCache", func() {
+		It("should not error without a local IP", func() {
+			instance.Spec.NodeLocalDNSCache = &operator.NodeLocalDNSCache{}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should not error for a valid local IP", func() {
+			instance.Spec.NodeLocalDNSCache = &operator.NodeLocalDNSCache{LocalIP: "169.254.20.10"}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error for an invalid local IP", func() {
+			instance.Spec.NodeLocalDNSCache = &operator.NodeLocalDNSCache{LocalIP: "169.254.20.10/32"}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError(`spec.nodeLocalDNSCache.localIP "169.254.20.10/32" is not a valid IP address`))
+		})
+	})
+
+	Describe("validate KubeControllersConfig", func() {
+		It("should not error for valid node controller settings", func() {
+			autoHEPs := operator.AutoHostEndpointsDisabled
+			instance.Spec.KubeControllersConfig = &operator.KubeControllersConfig{
+				Node: &operator.KubeControllersNodeConfig{
+					ReconcilerPeriod:  &metav1.Duration{Duration: time.Minute},
+					LeakGracePeriod:   &metav1.Duration{Duration: 0},
+					AutoHostEndpoints: &autoHEPs,
+				},
+			}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error for a non-positive reconciler period", func() {
+			instance.Spec.KubeControllersConfig = &operator.KubeControllersConfig{
+				Node: &operator.KubeControllersNodeConfig{ReconcilerPeriod: &metav1.Duration{Duration: 0}},
+			}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.kubeControllersConfig.node.reconcilerPeriod must be a positive duration, got 0s"))
+		})
+
+		It("should return an error for a negative leak grace period", func() {
+			instance.Spec.KubeControllersConfig = &operator.KubeControllersConfig{
+				Node: &operator.KubeControllersNodeConfig{LeakGracePeriod: &metav1.Duration{Duration: -time.Minute}},
+			}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.kubeControllersConfig.node.leakGracePeriod must not be negative, got -1m0s"))
+		})
+
+		It("should return an error for an invalid auto host endpoints value", func() {
+			autoHEPs := operator.AutoHostEndpointsType("Sometimes")
+			instance.Spec.KubeControllersConfig = &operator.KubeControllersConfig{
+				Node: &operator.KubeControllersNodeConfig{AutoHostEndpoints: &autoHEPs},
+			}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("Sometimes is invalid for spec.kubeControllersConfig.node.autoHostEndpoints, should be one of Enabled, Disabled"))
+		})
+
+		It("should validate the auto host endpoint interface pattern", func() {
+			autoHEPs := operator.AutoHostEndpointsEnabled
+			instance.Spec.KubeControllersConfig = &operator.KubeControllersConfig{
+				Node: &operator.KubeControllersNodeConfig{
+					AutoHostEndpoints:                &autoHEPs,
+					AutoHostEndpointInterfacePattern: "^(eth|ens)[0-9]+$",
+				},
+			}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+
+			instance.Spec.KubeControllersConfig.Node.AutoHostEndpointInterfacePattern = "eth[0-9"
+			Expect(validateCustomResource(instance)).To(MatchError(HavePrefix(`spec.kubeControllersConfig.node.autoHostEndpointInterfacePattern "eth[0-9" is not a valid regular expression`)))
+
+			instance.Spec.KubeControllersConfig.Node.AutoHostEndpointInterfacePattern = "^eth[0-9]+$"
+			instance.Spec.KubeControllersConfig.Node.AutoHostEndpoints = nil
+			Expect(validateCustomResource(instance)).To(MatchError("spec.kubeControllersConfig.node.autoHostEndpointInterfacePattern requires autoHostEndpoints to be Enabled"))
+		})
+	})
+
+	Describe("validate CalicoNetwork BGPFilters", func() {
+		BeforeEach(func() {
+			bgp := operator.BGPEnabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+		})
+
+		It("should not error for valid filters", func() {
+			instance.Spec.CalicoNetwork.BGPFilters = []operator.BGPFilter{
+				{
+					Name: "filter-a",
+					ExportV4: []operator.BGPFilterRule{
+						{CIDR: "10.0.0.0/8", MatchOperator: operator.BGPFilterMatchOperatorIn, Action: operator.BGPFilterActionReject},
+						{Action: operator.BGPFilterActionAccept},
+					},
+					ImportV6: []operator.BGPFilterRule{
+						{CIDR: "fd00::/64", MatchOperator: operator.BGPFilterMatchOperatorNotEqual, Action: operator.BGPFilterActionAccept},
+					},
+				},
+				{Name: "filter-b"},
+			}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error when BGP is disabled", func() {
+			bgp := operator.BGPDisabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			instance.Spec.CalicoNetwork.BGPFilters = []operator.BGPFilter{{Name: "filter-a"}}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.bgpFilters requires BGP to be enabled"))
+		})
+
+		DescribeTable("should reject invalid filters", func(filter operator.BGPFilter, expected string) {
+			instance.Spec.CalicoNetwork.BGPFilters = []operator.BGPFilter{filter}
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expected))
+		},
+			Entry("invalid name", operator.BGPFilter{Name: "Not_Valid"},
+				"Not_Valid is not a qualified resource name"),
+			Entry("invalid action", operator.BGPFilter{Name: "f", ExportV4: []operator.BGPFilterRule{{Action: "Drop"}}},
+				`filter f exportV4[0]: action "Drop" is invalid, should be one of Accept, Reject`),
+			Entry("invalid match operator", operator.BGPFilter{Name: "f", ImportV4: []operator.BGPFilterRule{{CIDR: "10.0.0.0/8", MatchOperator: "Contains", Action: operator.BGPFilterActionAccept}}},
+				`filter f importV4[0]: matchOperator "Contains" is invalid`),
+			Entry("cidr without match operator", operator.BGPFilter{Name: "f", ExportV4: []operator.BGPFilterRule{{CIDR: "10.0.0.0/8", Action: operator.BGPFilterActionAccept}}},
+				"filter f exportV4[0]: cidr and matchOperator must be set together"),
+			Entry("match operator without cidr", operator.BGPFilter{Name: "f", ExportV4: []operator.BGPFilterRule{{MatchOperator: operator.BGPFilterMatchOperatorIn, Action: operator.BGPFilterActionAccept}}},
+				"filter f exportV4[0]: cidr and matchOperator must be set together"),
+			Entry("malformed cidr", operator.BGPFilter{Name: "f", ExportV4: []operator.BGPFilterRule{{CIDR: "10.0.0.0/33", MatchOperator: operator.BGPFilterMatchOperatorIn, Action: operator.BGPFilterActionAccept}}},
+				"filter f exportV4[0]: cidr 10.0.0.0/33 is invalid"),
+			Entry("IPv6 cidr in an IPv4 list", operator.BGPFilter{Name: "f", ImportV4: []operator.BGPFilterRule{{CIDR: "fd00::/64", MatchOperator: operator.BGPFilterMatchOperatorIn, Action: operator.BGPFilterActionAccept}}},
+				"filter f importV4[0]: cidr fd00::/64 is not an IPv4 CIDR"),
+			Entry("IPv4 cidr in an IPv6 list", operator.BGPFilter{Name: "f", ExportV6: []operator.BGPFilterRule{{CIDR: "10.0.0.0/8", MatchOperator: operator.BGPFilterMatchOperatorIn, Action: operator.BGPFilterActionAccept}}},
+				"filter f exportV6[0]: cidr 10.0.0.0/8 is not an IPv6 CIDR"),
+		)
+
+		It("should return an error for duplicate filter names", func() {
+			instance.Spec.CalicoNetwork.BGPFilters = []operator.BGPFilter{{Name: "filter-a"}, {Name: "filter-a"}}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.bgpFilters is invalid: filter name filter-a is used more than once"))
+		})
+	})
+
+	Describe("validate CalicoNetwork RouteReflectors", func() {
+		BeforeEach(func() {
+			bgp := operator.BGPEnabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			instance.Spec.CalicoNetwork.RouteReflectors = &operator.RouteReflectors{
+				NodeSelector: map[string]string{"example.com/route-reflector": "true"},
+				ClusterID:    "244.0.0.1",
+			}
+		})
+
+		It("should not error for valid route reflectors", func() {
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error when BGP is disabled", func() {
+			bgp := operator.BGPDisabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.routeReflectors requires BGP to be enabled"))
+		})
+
+		It("should return an error when a BGP graceful restart time is set", func() {
+			instance.Spec.CalicoNetwork.BGPGracefulRestartTime = &metav1.Duration{Duration: 2 * time.Minute}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.routeReflectors cannot be used with spec.calicoNetwork.bgpGracefulRestartTime, which applies to the node-to-node mesh"))
+		})
+
+		DescribeTable("should return an error for invalid route reflectors",
+			func(nodeSelector map[string]string, clusterID, expected string) {
+				instance.Spec.CalicoNetwork.RouteReflectors = &operator.RouteReflectors{NodeSelector: nodeSelector, ClusterID: clusterID}
+				err := validateCustomResource(instance)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(expected))
+			},
+			Entry("no node selector", nil, "244.0.0.1", "nodeSelector must select nodes by at least one label"),
+			Entry("invalid label key", map[string]string{"bad key": "true"}, "244.0.0.1", `nodeSelector key "bad key" is invalid`),
+			Entry("invalid label value", map[string]string{"route-reflector": "it's"}, "244.0.0.1", `nodeSelector value "it's" is invalid`),
+			Entry("missing cluster ID", map[string]string{"route-reflector": "true"}, "", `clusterID "" must be an IPv4 address`),
+			Entry("IPv6 cluster ID", map[string]string{"route-reflector": "true"}, "fd00::1", `clusterID "fd00::1" must be an IPv4 address`),
+		)
+	})
+
+	Describe("validate CalicoNetwork AdvertisedServiceClusterIPs", func() {
+		BeforeEach(func() {
+			bgp := operator.BGPEnabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			instance.Spec.ServiceCIDRs = []string{"10.96.0.0/12", "fd00:10:96::/112"}
+		})
+
+		It("should not error for CIDRs within the service CIDRs", func() {
+			instance.Spec.CalicoNetwork.AdvertisedServiceClusterIPs = []string{"10.96.0.0/12", "10.100.0.0/16", "fd00:10:96::/120"}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error when BGP is disabled", func() {
+			bgp := operator.BGPDisabled
+			instance.Spec.CalicoNetwork.BGP = &bgp
+			instance.Spec.CalicoNetwork.AdvertisedServiceClusterIPs = []string{"10.96.0.0/12"}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.advertisedServiceClusterIPs requires BGP to be enabled"))
+		})
+
+		It("should return an error when BGP is not set", func() {
+			instance.Spec.CalicoNetwork.BGP = nil
+			instance.Spec.CalicoNetwork.AdvertisedServiceClusterIPs = []string{"10.96.0.0/12"}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.advertisedServiceClusterIPs requires BGP to be enabled"))
+		})
+
+		It("should return an error when no service CIDRs are configured", func() {
+			instance.Spec.ServiceCIDRs = nil
+			instance.Spec.CalicoNetwork.AdvertisedServiceClusterIPs = []string{"10.96.0.0/12"}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError("spec.calicoNetwork.advertisedServiceClusterIPs is invalid: spec.serviceCIDRs must be provided"))
+		})
+
+		It("should return an error for an invalid CIDR", func() {
+			instance.Spec.CalicoNetwork.AdvertisedServiceClusterIPs = []string{"10.96.0.0"}
+			err := validateCustomResource(instance)
+			Expect(err).To(MatchError(`spec.calicoNetwork.advertisedServiceClusterIPs is invalid: "10.96.0.0" is not a valid CIDR`))
+		})
+
+		It("should return an error for a CIDR outside the service CIDRs", func() {
+			for _, cidr := range []string{"10.0.0.0/8", "192.168.0.0/24"} {
+				instance.Spec.CalicoNetwork.AdvertisedServiceClusterIPs = []string{cidr}
+				err := validateCustomResource(instance)
+				Expect(err).To(MatchError(fmt.Sprintf("spec.calicoNetwork.advertisedServiceClusterIPs is invalid: %s is not within spec.serviceCIDRs", cidr)))
+			}
+		})
+	})
+
+	It("validate custom installation", func() {
+		disabled := operator.BGPDisabled
+		ipfw := operator.ContainerIPForwardingEnabled
+		var twentyEight int32 = 28
+		instance = &operator.Installation{
+			Spec: operator.InstallationSpec{
+				KubernetesProvider: operator.ProviderAKS,
+				ImagePullSecrets:   []v1.LocalObjectReference{},
+				CalicoNetwork: &operator.CalicoNetworkSpec{
+					BGP:                   &disabled,
+					ContainerIPForwarding: &ipfw,
+					IPPools: []operator.IPPool{
+						{
+							CIDR:          "192.168.0.0/27",
+							BlockSize:     &twentyEight,
+							Encapsulation: operator.EncapsulationNone,
+						},
+					},
+				},
+				ServiceCIDRs: []string{"10.96.0.0/12"},
+				CNI: &operator.CNISpec{
+					Type: operator.PluginCalico,
+					IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginHostLocal},
+				},
+			},
+		}
+		// Populate the k8s service endpoint (required for windows)
+		k8sapi.Endpoint = k8sapi.ServiceEndpoint{
+			Host: "1.2.3.4",
+			Port: "6443",
+		}
+		Expect(fillDefaults(instance, nil)).NotTo(HaveOccurred())
+		err := validateCustomResource(instance)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("validate CalicoNodeGenerationLabel", func() {
+		It("should accept a valid label key", func() {
+			instance.Spec.CalicoNodeGenerationLabel = "example.com/installation-generation"
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error for an invalid label key", func() {
+			instance.Spec.CalicoNodeGenerationLabel = "not a label"
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.calicoNodeGenerationLabel "not a label" is not a valid label key`))
+		})
+
+		DescribeTable("should return an error for a label key the operator sets",
+			func(key string) {
+				instance.Spec.CalicoNodeGenerationLabel = key
+				err := validateCustomResource(instance)
+				Expect(err).To(MatchError(fmt.Sprintf("spec.calicoNodeGenerationLabel %q is reserved for the label the operator sets on calico-node pods", key)))
+			},
+			Entry("k8s-app", "k8s-app"),
+			Entry("app.kubernetes.io/name", "app.kubernetes.io/name"),
+		)
+	})
+
+	Describe("validate CalicoNodeDaemonSet", func() {
+		It("should return nil when it is empty", func() {
+			instance.Spec.CalicoNodeDaemonSet = &operator.CalicoNodeDaemonSet{}
+			err := validateCustomResource(instance)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return an error if it is invalid", func() {
+			instance.Spec.CalicoNodeDaemonSet = &operator.CalicoNodeDaemonSet{
+				Metadata: &operator.Metadata{
+					Labels: map[string]string{
+						"NoUppercaseOrSpecialCharsLike=Equals":    "b",
+						"WowNoUppercaseOrSpecialCharsLike=Equals": "b",
+					},
+					Annotations: map[string]string{
+						"AnnotNoUppercaseOrSpecialCharsLike=Equals": "bar",
+					},
+				},
+			}
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+
+			var invalidMinReadySeconds int32 = -1
+			instance.Spec.CalicoNodeDaemonSet = &operator.CalicoNodeDaemonSet{
+				Spec: &operator.CalicoNodeDaemonSetSpec{
+					MinReadySeconds: &invalidMinReadySeconds,
+				},
+			}
+			err = validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error if the affinity is invalid", func() {
+			instance.Spec.CalicoNodeDaemonSet = &operator.CalicoNodeDaemonSet{
+				Spec: &operator.CalicoNodeDaemonSetSpec{
+					Template: &operator.CalicoNodeDaemonSetPodTemplateSpec{
+						Spec: &operator.CalicoNodeDaemonSetPodSpec{
+							Affinity: &v1.Affinity{
+								NodeAffinity: &v1.NodeAffinity{
+									RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{},
+								},
+							},
+						},
+					},
+				},
+			}
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("Installation spec.CalicoNodeDaemonSet is not valid: spec.Template.Spec.Affinity is invalid"))
+		})
+	})
+
+	Describe("validate CalicoNodeDaemonSet extra containers", func() {
+		setExtraContainers := func(containers ...v1.Container) {
+			instance.Spec.CalicoNodeDaemonSet = &operator.CalicoNodeDaemonSet{
+				Spec: &operator.CalicoNodeDaemonSetSpec{
+					Template: &operator.CalicoNodeDaemonSetPodTemplateSpec{
+						Spec: &operator.CalicoNodeDaemonSetPodSpec{ExtraContainers: containers},
+					},
+				},
+			}
+		}
+
+		It("should accept a valid sidecar", func() {
+			setExtraContainers(v1.Container{Name: "telemetry", Image: "example.com/telemetry:v1"})
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should reject sidecars with duplicate names", func() {
+			setExtraContainers(
+				v1.Container{Name: "telemetry", Image: "example.com/telemetry:v1"},
+				v1.Container{Name: "telemetry", Image: "example.com/telemetry:v2"},
+			)
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not unique"))
+		})
+
+		It("should reject a sidecar that reuses an operator container name", func() {
+			setExtraContainers(v1.Container{Name: "install-cni", Image: "example.com/telemetry:v1"})
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not unique"))
+		})
+
+		It("should reject a sidecar that reuses the non-privileged hostpath init container name", func() {
+			setExtraContainers(v1.Container{Name: "hostpath-init", Image: "example.com/telemetry:v1"})
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not unique"))
+		})
+
+		It("should reject a sidecar without an image", func() {
+			setExtraContainers(v1.Container{Name: "telemetry"})
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must specify an image"))
+		})
+
+		It("should reject a sidecar with invalid resources", func() {
+			setExtraContainers(v1.Container{
+				Name:  "telemetry",
+				Image: "example.com/telemetry:v1",
+				Resources: v1.ResourceRequirements{
+					Limits:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")},
+					Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("200m")},
+				},
+			})
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("validate CalicoNodeDaemonSet startup probe", func() {
+		setStartupProbe := func(probe *operator.CalicoNodeStartupProbe) {
+			instance.Spec.CalicoNodeDaemonSet = &operator.CalicoNodeDaemonSet{
+				Spec: &operator.CalicoNodeDaemonSetSpec{
+					Template: &operator.CalicoNodeDaemonSetPodTemplateSpec{
+						Spec: &operator.CalicoNodeDaemonSetPodSpec{
+							Containers: []operator.CalicoNodeDaemonSetContainer{{Name: "calico-node", StartupProbe: probe}},
+						},
+					},
+				},
+			}
+		}
+
+		It("should accept a startup probe", func() {
+			setStartupProbe(&operator.CalicoNodeStartupProbe{PeriodSeconds: ptr.Int32ToPtr(5), FailureThreshold: ptr.Int32ToPtr(60)})
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should reject a startup probe period that is not positive", func() {
+			setStartupProbe(&operator.CalicoNodeStartupProbe{PeriodSeconds: ptr.Int32ToPtr(0)})
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("StartupProbe.PeriodSeconds must be at least 1"))
+		})
+
+		It("should reject a startup probe failure threshold that is not positive", func() {
+			setStartupProbe(&operator.CalicoNodeStartupProbe{FailureThreshold: ptr.Int32ToPtr(-1)})
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("StartupProbe.FailureThreshold must be at least 1"))
+		})
+	})
+
+	Describe("validate CalicoNodeWindowsDaemonSet", func() {
+		It("should return nil when it is empty", func() {
+			instance.Spec.CalicoNodeWindowsDaemonSet = &operator.CalicoNodeWindowsDaemonSet{}
+			err := validateCustomResource(instance)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return an error if it is invalid", func() {
+			instance.Spec.CalicoNodeWindowsDaemonSet = &operator.CalicoNodeWindowsDaemonSet{
+				Metadata: &operator.Metadata{
+					Labels: map[string]string{
+						"NoUppercaseOrSpecialCharsLike=Equals":    "b",
+						"WowNoUppercaseOrSpecialCharsLike=Equals": "b",
+					},
+					Annotations: map[string]string{
+						"AnnotNoUppercaseOrSpecialCharsLike=Equals": "bar",
+					},
+				},
+			}
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+
+			var invalidMinReadySeconds int32 = -1
+			instance.Spec.CalicoNodeWindowsDaemonSet = &operator.CalicoNodeWindowsDaemonSet{
+				Spec: &operator.CalicoNodeWindowsDaemonSetSpec{
+					MinReadySeconds: &invalidMinReadySeconds,
+				},
+			}
+			err = validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("validate CalicoKubeControllersDeployment", func() {
+		It("should return nil when it is empty", func() {
+			instance.Spec.CalicoKubeControllersDeployment = &operator.CalicoKubeControllersDeployment{}
+			err := validateCustomResource(instance)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return an error if it is invalid", func() {
+			instance.Spec.CalicoKubeControllersDeployment = &operator.CalicoKubeControllersDeployment{
+				Metadata: &operator.Metadata{
+					Labels: map[string]string{
+						"NoUppercaseOrSpecialCharsLike=Equals":    "b",
+						"WowNoUppercaseOrSpecialCharsLike=Equals": "b",
+					},
+					Annotations: map[string]string{
+						"AnnotNoUppercaseOrSpecialCharsLike=Equals": "bar",
+					},
+				},
+			}
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+
+			var invalidMinReadySeconds int32 = -1
+			instance.Spec.CalicoKubeControllersDeployment = &operator.CalicoKubeControllersDeployment{
+				Spec: &operator.CalicoKubeControllersDeploymentSpec{
+					MinReadySeconds: &invalidMinReadySeconds,
+				},
+			}
+			err = validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("validate TyphaDeployment", func() {
+		It("should return nil when it is empty", func() {
+			instance.Spec.TyphaDeployment = &operator.TyphaDeployment{}
+			err := validateCustomResource(instance)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return an error if it is invalid", func() {
+			instance.Spec.TyphaDeployment = &operator.TyphaDeployment{
+				Metadata: &operator.Metadata{
+					Labels: map[string]string{
+						"NoUppercaseOrSpecialCharsLike=Equals":    "b",
+						"WowNoUppercaseOrSpecialCharsLike=Equals": "b",
+					},
+					Annotations: map[string]string{
+						"AnnotNoUppercaseOrSpecialCharsLike=Equals": "bar",
+					},
+				},
+			}
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+
+			var invalidMinReadySeconds int32 = -1
+			instance.Spec.TyphaDeployment = &operator.TyphaDeployment{
+				Spec: &operator.TyphaDeploymentSpec{
+					MinReadySeconds: &invalidMinReadySeconds,
+				},
+			}
+			err = validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should accept a node affinity pinning typha to a node pool", func() {
+			instance.Spec.TyphaDeployment = &operator.TyphaDeployment{
+				Spec: &operator.TyphaDeploymentSpec{
+					Template: &operator.TyphaDeploymentPodTemplateSpec{
+						Spec: &operator.TyphaDeploymentPodSpec{
+							Affinity: &v1.Affinity{
+								NodeAffinity: &v1.NodeAffinity{
+									RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+										NodeSelectorTerms: []v1.NodeSelectorTerm{{
+											MatchExpressions: []v1.NodeSelectorRequirement{{
+												Key:      "node-pool",
+												Operator: v1.NodeSelectorOpIn,
+												Values:   []string{"infra"},
+											}},
+										}},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error if the node affinity is invalid", func() {
+			instance.Spec.TyphaDeployment = &operator.TyphaDeployment{
+				Spec: &operator.TyphaDeploymentSpec{
+					Template: &operator.TyphaDeploymentPodTemplateSpec{
+						Spec: &operator.TyphaDeploymentPodSpec{
+							Affinity: &v1.Affinity{
+								NodeAffinity: &v1.NodeAffinity{
+									RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+										NodeSelectorTerms: []v1.NodeSelectorTerm{{
+											MatchExpressions: []v1.NodeSelectorRequirement{{
+												Key:      "node-pool",
+												Operator: v1.NodeSelectorOpIn,
+											}},
+										}},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			err := validateCustomResource(instance)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.TyphaDeployment"))
+		})
+	})
+
+	Describe("validate TyphaAffinity", func() {
+		It("should accept a valid node affinity", func() {
+			instance.Spec.TyphaAffinity = &operator.TyphaAffinity{
+				NodeAffinity: &operator.NodeAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{{
+						Weight: 1,
+						Preference: v1.NodeSelectorTerm{
+							MatchExpressions: []v1.NodeSelectorRequirement{{
+								Key:      "node-pool",
+								Operator: v1.NodeSelectorOpExists,
+							}},
+						},
+					}},
+				},
+			}
+			Expect(validateCustomResource(instance)).NotTo(HaveOccurred())
+		})
+
+		It("should return an error if the node selector has no terms", func() {
+			instance.Spec.TyphaAffinity = &operator.TyphaAffinity{
+				NodeAffinity: &operator.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{},
+				},
+			}
+			err := validateCustomResource(instance)
 			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Installation spec.TyphaAffinity is not valid"))
+		})
+
+		It("should return an error if a preferred term has an invalid weight", func() {
+			instance.Spec.TyphaAffinity = &operator.TyphaAffinity{
+				NodeAffinity: &operator.NodeAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []v1.PreferredSchedulingTerm{{
+						Weight: 0,
+						Preference: v1.NodeSelectorTerm{
+							MatchExpressions: []v1.NodeSelectorRequirement{{
+								Key:      "node-pool",
+								Operator: v1.NodeSelectorOpExists,
+							}},
+						},
+					}},
+				},
+			}
+			Expect(validateCustomResource(instance)).To(HaveOccurred())
 		})
 	})
 	Describe("validate Windows configuration", func() {
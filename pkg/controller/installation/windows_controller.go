@@ -184,6 +184,7 @@ type ReconcileWindows struct {
 	status               status.StatusManager
 	enterpriseCRDsExist  bool
 	clusterDomain        string
+	auditLog             bool
 	ipamConfigWatchReady *utils.ReadyFlag
 }
 
@@ -200,6 +201,7 @@ func newWindowsReconciler(mgr manager.Manager, opts options.AddOptions) (*Reconc
 		status:               statusManager,
 		enterpriseCRDsExist:  opts.EnterpriseCRDExists,
 		clusterDomain:        opts.ClusterDomain,
+		auditLog:             opts.AuditLog,
 		ipamConfigWatchReady: &utils.ReadyFlag{},
 	}
 	r.status.Run(opts.ShutdownContext)
@@ -409,7 +411,7 @@ func (r *ReconcileWindows) Reconcile(ctx context.Context, request reconcile.Requ
 	}
 
 	// Create a component handler to create or update the rendered components.
-	handler := utils.NewComponentHandler(logw, r.client, r.scheme, instance)
+	handler := utils.NewComponentHandler(logw, r.client, r.scheme, instance, utils.WithInstallation(&instance.Spec), utils.WithAuditLog(r.auditLog, "tigera-windows-controller"))
 	if err := handler.CreateOrUpdateOrDelete(ctx, component, nil); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
 		return reconcile.Result{}, err
@@ -171,6 +171,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, licenseAPIReady
 		provider:        opts.DetectedProvider,
 		status:          status.New(mgr.GetClient(), tigeraStatusName, opts.KubernetesVersion),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		licenseAPIReady: licenseAPIReady,
 		dpiAPIReady:     dpiAPIReady,
 		tierWatchReady:  tierWatchReady,
@@ -193,6 +194,7 @@ type ReconcileIntrusionDetection struct {
 	provider        operatorv1.Provider
 	status          status.StatusManager
 	clusterDomain   string
+	auditLog        bool
 	licenseAPIReady *utils.ReadyFlag
 	dpiAPIReady     *utils.ReadyFlag
 	tierWatchReady  *utils.ReadyFlag
@@ -439,7 +441,7 @@ func (r *ReconcileIntrusionDetection) Reconcile(ctx context.Context, request rec
 	}
 
 	// Create a component handler to manage the rendered component.
-	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance, utils.WithInstallation(network), utils.WithAuditLog(r.auditLog, "intrusiondetection-controller"))
 
 	// Determine the namespaces to which we must bind the cluster role.
 	namespaces, err := helper.TenantNamespaces(r.client)
@@ -57,6 +57,9 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		scheme:               mgr.GetScheme(),
 		watches:              make(map[runtime.Object]struct{}),
 		autoDetectedProvider: opts.DetectedProvider,
+		expectedNodeCIDRs:    opts.ExpectedNodeCIDRs,
+		requireConsistentNAT: opts.RequireConsistentNATOutgoing,
+		auditLog:             opts.AuditLog,
 		status:               status.New(mgr.GetClient(), tigeraStatusName, opts.KubernetesVersion),
 	}
 	r.status.Run(opts.ShutdownContext)
@@ -118,6 +121,16 @@ type Reconciler struct {
 	watches              map[runtime.Object]struct{}
 	autoDetectedProvider operator.Provider
 	status               status.StatusManager
+
+	// expectedNodeCIDRs are the CIDRs that IP pools are expected to fall within, if configured.
+	expectedNodeCIDRs []string
+
+	// requireConsistentNAT rejects IP pools of the same family with different natOutgoing settings, rather than
+	// only warning about them.
+	requireConsistentNAT bool
+
+	// auditLog enables audit log entries for the objects the controller writes.
+	auditLog bool
 }
 
 const (
@@ -125,6 +138,10 @@ const (
 	// with this label key/value pair is assumed to be solely managed and reconciled by this controller.
 	managedByLabel = "app.kubernetes.io/managed-by"
 	managedByValue = "tigera-operator"
+
+	// defaultMaxRecommendedIPPools is the number of IP pools above which the status is reported as degraded, unless
+	// spec.calicoNetwork.maxRecommendedIPPools is set on the Installation.
+	defaultMaxRecommendedIPPools = 10
 )
 
 // hasOwnerLabel returns true if the given IP pool is owned by the tigera/operator, and false otheriwse.
@@ -202,6 +219,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		r.status.SetDegraded(operator.InvalidConfigurationError, "error validating IP pool configuration", err, reqLogger)
 		return reconcile.Result{}, err
 	}
+	if err = validatePoolsWithinCIDRs(installation, r.expectedNodeCIDRs); err != nil {
+		r.status.SetDegraded(operator.InvalidConfigurationError, "IP pool is outside of the expected node CIDRs", err, reqLogger)
+		return reconcile.Result{}, err
+	}
+	if err = validatePoolsNATOutgoingConsistent(installation); err != nil {
+		if r.requireConsistentNAT {
+			r.status.SetDegraded(operator.InvalidConfigurationError, "IP pools have inconsistent natOutgoing settings", err, reqLogger)
+			return reconcile.Result{}, err
+		}
+		reqLogger.Info("IP pools have inconsistent natOutgoing settings, which causes asymmetric NAT unless intended", "reason", err.Error())
+	}
 	if err := r.client.Patch(ctx, installation, preDefaultPatchFrom); err != nil {
 		r.status.SetDegraded(operator.ResourceUpdateError, "Failed to write defaults", err, reqLogger)
 		return reconcile.Result{}, err
@@ -358,7 +386,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// will remain even though all other Calico resources will be deleted. This is intentional - deleting IP pools requires the Calico API server to be
 	// running, and we don't want to block the deletion of the Installation on the API server being available, as it introduces too many ways for
 	// things to go wrong upon deleting the Installation API. Users can manually delete the IP pools if they are no longer needed.
-	handler := utils.NewComponentHandler(log, r.client, r.scheme, nil)
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, nil, utils.WithAuditLog(r.auditLog, "tigera-ippool-controller"))
 
 	passThru := render.NewPassthroughWithLog(log, toCreateOrUpdate...)
 	if err := handler.CreateOrUpdateOrDelete(ctx, passThru, nil); err != nil {
@@ -374,6 +402,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// Tell the status manager that we're ready to monitor the resources we've told it about and receive statuses.
 	r.status.ReadyToMonitor()
 
+	// Warn about too many IP pools. This is advisory, so it is checked only once the pools have been reconciled.
+	maxPools := defaultMaxRecommendedIPPools
+	if m := installation.Spec.CalicoNetwork.MaxRecommendedIPPools; m != nil {
+		maxPools = int(*m)
+	}
+	if n := countIPPools(installation, notOurs); n > maxPools {
+		r.status.SetDegraded(operator.InvalidConfigurationError, fmt.Sprintf("There are %d IP pools, more than the recommended maximum of %d", n, maxPools), nil, reqLogger)
+		return reconcile.Result{}, nil
+	}
+
 	// We can clear the degraded state now since as far as we know everything is in order.
 	r.status.ClearDegraded()
 
@@ -386,6 +424,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{}, nil
 }
 
+// countIPPools returns the number of IP pools in the cluster once the IP pools in the Installation have been
+// reconciled, given the CIDRs of the IP pools in the cluster that are not owned by the operator.
+func countIPPools(installation *operator.Installation, notOurs map[string]bool) int {
+	cidrs := map[string]bool{}
+	for cidr := range notOurs {
+		cidrs[cidr] = true
+	}
+	for _, p := range installation.Spec.CalicoNetwork.IPPools {
+		cidrs[p.CIDR] = true
+	}
+	return len(cidrs)
+}
+
 func CRDPoolsToOperator(crds []crdv1.IPPool) []v1.IPPool {
 	pools := []v1.IPPool{}
 	for _, p := range crds {
@@ -32,6 +32,7 @@ import (
 	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils"
+	"github.com/tigera/operator/pkg/ptr"
 	"github.com/tigera/operator/pkg/render"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -261,6 +262,209 @@ var _ = Describe("IP Pool controller tests", func() {
 		}
 	})
 
+	It("should degrade if an IP pool is outside of the expected node CIDRs", func() {
+		r.expectedNodeCIDRs = []string{"10.0.0.0/8"}
+		instance := &operator.Installation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "default",
+				Finalizers: []string{"tigera.io/operator-cleanup"},
+			},
+			Spec: operator.InstallationSpec{
+				Variant:  operator.Calico,
+				Registry: "some.registry.org/",
+				CNI: &operator.CNISpec{
+					Type: operator.PluginCalico,
+					IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginCalico},
+				},
+				CalicoNetwork: &operator.CalicoNetworkSpec{
+					IPPools: []operator.IPPool{{CIDR: "192.168.0.0/16"}},
+				},
+			},
+		}
+		Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
+
+		mockStatus.On("OnCRFound")
+		mockStatus.On("SetMetaData", mock.Anything)
+		mockStatus.On("SetDegraded", operator.InvalidConfigurationError, "IP pool is outside of the expected node CIDRs", mock.Anything, mock.Anything)
+
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).Should(HaveOccurred())
+		mockStatus.AssertExpectations(GinkgoT())
+
+		// Expect no IP pools to have been created.
+		ipPools := crdv1.IPPoolList{}
+		Expect(c.List(ctx, &ipPools)).ShouldNot(HaveOccurred())
+		Expect(ipPools.Items).To(HaveLen(0))
+	})
+
+	Context("with inconsistent natOutgoing settings", func() {
+		var instance *operator.Installation
+
+		BeforeEach(func() {
+			instance = &operator.Installation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "default",
+					Finalizers: []string{"tigera.io/operator-cleanup"},
+				},
+				Spec: operator.InstallationSpec{
+					Variant:  operator.Calico,
+					Registry: "some.registry.org/",
+					CNI: &operator.CNISpec{
+						Type: operator.PluginCalico,
+						IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginCalico},
+					},
+					CalicoNetwork: &operator.CalicoNetworkSpec{
+						IPPools: []operator.IPPool{
+							{Name: "nat", CIDR: "192.168.0.0/16", NATOutgoing: operator.NATOutgoingEnabled},
+							{Name: "routable", CIDR: "10.10.0.0/16", NATOutgoing: operator.NATOutgoingDisabled},
+						},
+					},
+				},
+			}
+			Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
+			mockStatus.On("OnCRFound")
+			mockStatus.On("SetMetaData", mock.Anything)
+		})
+
+		It("should degrade when consistent natOutgoing is required", func() {
+			r.requireConsistentNAT = true
+			mockStatus.On("SetDegraded", operator.InvalidConfigurationError, "IP pools have inconsistent natOutgoing settings", mock.Anything, mock.Anything)
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("IPv4 IP pools have inconsistent natOutgoing settings"))
+			mockStatus.AssertExpectations(GinkgoT())
+
+			// Expect no IP pools to have been created.
+			ipPools := crdv1.IPPoolList{}
+			Expect(c.List(ctx, &ipPools)).ShouldNot(HaveOccurred())
+			Expect(ipPools.Items).To(HaveLen(0))
+		})
+
+		It("should only warn when consistent natOutgoing is not required", func() {
+			mockStatus.On("IsAvailable").Return(true)
+			mockStatus.On("ReadyToMonitor")
+			mockStatus.On("ClearDegraded")
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertExpectations(GinkgoT())
+
+			ipPools := crdv1.IPPoolList{}
+			Expect(c.List(ctx, &ipPools)).ShouldNot(HaveOccurred())
+			Expect(ipPools.Items).To(HaveLen(2))
+		})
+	})
+
+	Context("recommended maximum number of IP pools", func() {
+		var instance *operator.Installation
+
+		BeforeEach(func() {
+			instance = &operator.Installation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "default",
+					Finalizers: []string{"tigera.io/operator-cleanup"},
+				},
+				Spec: operator.InstallationSpec{
+					Variant:  operator.Calico,
+					Registry: "some.registry.org/",
+					CNI: &operator.CNISpec{
+						Type: operator.PluginCalico,
+						IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginCalico},
+					},
+					CalicoNetwork: &operator.CalicoNetworkSpec{
+						IPPools: []operator.IPPool{
+							{Name: "pool-a", CIDR: "192.168.0.0/16"},
+							{Name: "pool-b", CIDR: "10.10.0.0/16"},
+						},
+					},
+				},
+			}
+			mockStatus.On("OnCRFound")
+			mockStatus.On("SetMetaData", mock.Anything)
+		})
+
+		It("should not degrade when the number of IP pools is within the recommended maximum", func() {
+			instance.Spec.CalicoNetwork.MaxRecommendedIPPools = ptr.Int32ToPtr(2)
+			Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
+			mockStatus.On("IsAvailable").Return(true)
+			mockStatus.On("ReadyToMonitor")
+			mockStatus.On("ClearDegraded")
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertExpectations(GinkgoT())
+		})
+
+		It("should degrade but still create the IP pools when there are more than the recommended maximum", func() {
+			instance.Spec.CalicoNetwork.MaxRecommendedIPPools = ptr.Int32ToPtr(1)
+			Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
+			mockStatus.On("ReadyToMonitor")
+			mockStatus.On("SetDegraded", operator.InvalidConfigurationError, "There are 2 IP pools, more than the recommended maximum of 1", nil, mock.Anything)
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockStatus.AssertExpectations(GinkgoT())
+			mockStatus.AssertNotCalled(GinkgoT(), "ClearDegraded")
+
+			ipPools := crdv1.IPPoolList{}
+			Expect(c.List(ctx, &ipPools)).ShouldNot(HaveOccurred())
+			Expect(ipPools.Items).To(HaveLen(2))
+		})
+
+		It("should count IP pools that are not owned by the operator", func() {
+			notOurs := map[string]bool{"172.16.0.0/16": true, "10.10.0.0/16": true}
+			Expect(countIPPools(instance, notOurs)).To(Equal(3))
+		})
+
+		It("should reject a non-positive recommended maximum", func() {
+			instance.Spec.CalicoNetwork.MaxRecommendedIPPools = ptr.Int32ToPtr(0)
+			Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
+			mockStatus.On("SetDegraded", operator.InvalidConfigurationError, "error validating IP pool configuration", mock.Anything, mock.Anything)
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("maxRecommendedIPPools must be positive"))
+			mockStatus.AssertExpectations(GinkgoT())
+		})
+	})
+
+	It("should create IP pools within the expected node CIDRs", func() {
+		r.expectedNodeCIDRs = []string{"10.0.0.0/8"}
+		instance := &operator.Installation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "default",
+				Finalizers: []string{"tigera.io/operator-cleanup"},
+			},
+			Spec: operator.InstallationSpec{
+				Variant:  operator.Calico,
+				Registry: "some.registry.org/",
+				CNI: &operator.CNISpec{
+					Type: operator.PluginCalico,
+					IPAM: &operator.IPAMSpec{Type: operator.IPAMPluginCalico},
+				},
+				CalicoNetwork: &operator.CalicoNetworkSpec{
+					IPPools: []operator.IPPool{{CIDR: "10.244.0.0/16"}},
+				},
+			},
+		}
+		Expect(c.Create(ctx, instance)).ShouldNot(HaveOccurred())
+
+		mockStatus.On("OnCRFound")
+		mockStatus.On("SetMetaData", mock.Anything)
+		mockStatus.On("IsAvailable").Return(true)
+		mockStatus.On("ReadyToMonitor")
+		mockStatus.On("ClearDegraded")
+
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).ShouldNot(HaveOccurred())
+		mockStatus.AssertExpectations(GinkgoT())
+
+		ipPools := crdv1.IPPoolList{}
+		Expect(c.List(ctx, &ipPools)).ShouldNot(HaveOccurred())
+		Expect(ipPools.Items).To(HaveLen(1))
+	})
+
 	It("should disallow modification if there is no API server", func() {
 		instance := &operator.Installation{
 			ObjectMeta: metav1.ObjectMeta{
@@ -845,6 +1049,69 @@ var _ = Describe("validate()", func() {
 	})
 })
 
+var _ = table.DescribeTable("validatePoolsWithinCIDRs",
+	func(expected []string, pools []string, expectValid bool) {
+		instance := &operator.Installation{
+			Spec: operator.InstallationSpec{CalicoNetwork: &operator.CalicoNetworkSpec{}},
+		}
+		for _, cidr := range pools {
+			instance.Spec.CalicoNetwork.IPPools = append(instance.Spec.CalicoNetwork.IPPools, operator.IPPool{CIDR: cidr})
+		}
+		err := validatePoolsWithinCIDRs(instance, expected)
+		if expectValid {
+			Expect(err).NotTo(HaveOccurred())
+		} else {
+			Expect(err).To(HaveOccurred())
+		}
+	},
+
+	table.Entry("No expected CIDRs", nil, []string{"192.168.0.0/16"}, true),
+	table.Entry("Pool within expected CIDR", []string{"10.0.0.0/8"}, []string{"10.244.0.0/16"}, true),
+	table.Entry("Pool equal to expected CIDR", []string{"10.0.0.0/16"}, []string{"10.0.0.0/16"}, true),
+	table.Entry("Pool within one of several expected CIDRs", []string{"172.16.0.0/12", "10.0.0.0/8"}, []string{"10.244.0.0/16"}, true),
+	table.Entry("Pool outside expected CIDR", []string{"10.0.0.0/8"}, []string{"192.168.0.0/16"}, false),
+	table.Entry("Pool larger than expected CIDR", []string{"10.0.0.0/16"}, []string{"10.0.0.0/8"}, false),
+	table.Entry("One of several pools outside expected CIDR", []string{"10.0.0.0/8"}, []string{"10.244.0.0/16", "192.168.0.0/16"}, false),
+	table.Entry("IPv6 pool within expected CIDR", []string{"fd00::/8"}, []string{"fd00:1234::/64"}, true),
+	table.Entry("IPv6 pool outside expected CIDR", []string{"fd00::/16"}, []string{"fd01::/64"}, false),
+	table.Entry("IPv6 pool with only IPv4 expected CIDRs", []string{"10.0.0.0/8"}, []string{"10.244.0.0/16", "fd00::/64"}, true),
+)
+
+var _ = table.DescribeTable("validatePoolsNATOutgoingConsistent",
+	func(pools []operator.IPPool, expectValid bool) {
+		instance := &operator.Installation{
+			Spec: operator.InstallationSpec{CalicoNetwork: &operator.CalicoNetworkSpec{IPPools: pools}},
+		}
+		err := validatePoolsNATOutgoingConsistent(instance)
+		if expectValid {
+			Expect(err).NotTo(HaveOccurred())
+		} else {
+			Expect(err).To(HaveOccurred())
+		}
+	},
+
+	table.Entry("No pools", nil, true),
+	table.Entry("Single pool", []operator.IPPool{
+		{Name: "a", CIDR: "192.168.0.0/16", NATOutgoing: operator.NATOutgoingEnabled},
+	}, true),
+	table.Entry("Consistent IPv4 pools", []operator.IPPool{
+		{Name: "a", CIDR: "192.168.0.0/16", NATOutgoing: operator.NATOutgoingEnabled},
+		{Name: "b", CIDR: "10.10.0.0/16", NATOutgoing: operator.NATOutgoingEnabled},
+	}, true),
+	table.Entry("Mixed IPv4 pools", []operator.IPPool{
+		{Name: "a", CIDR: "192.168.0.0/16", NATOutgoing: operator.NATOutgoingEnabled},
+		{Name: "b", CIDR: "10.10.0.0/16", NATOutgoing: operator.NATOutgoingDisabled},
+	}, false),
+	table.Entry("Mixed IPv6 pools", []operator.IPPool{
+		{Name: "a", CIDR: "fd00::/64", NATOutgoing: operator.NATOutgoingDisabled},
+		{Name: "b", CIDR: "fd01::/64", NATOutgoing: operator.NATOutgoingEnabled},
+	}, false),
+	table.Entry("Different settings across families", []operator.IPPool{
+		{Name: "a", CIDR: "192.168.0.0/16", NATOutgoing: operator.NATOutgoingEnabled},
+		{Name: "b", CIDR: "fd00::/64", NATOutgoing: operator.NATOutgoingDisabled},
+	}, true),
+)
+
 // fillPrerequisiteDefaults fills in some defaults the IP pool controller relies on.
 // This mimics the behavior of the core Installation controller by setting some defaults that the IP pool
 // controller relies on.
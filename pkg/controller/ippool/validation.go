@@ -105,5 +105,66 @@ func ValidatePools(instance *operator.Installation) error {
 			}
 		}
 	}
+	if m := instance.Spec.CalicoNetwork.MaxRecommendedIPPools; m != nil && *m <= 0 {
+		return fmt.Errorf("maxRecommendedIPPools must be positive, got %d", *m)
+	}
+	return nil
+}
+
+// validatePoolsWithinCIDRs verifies that each IP pool in the Installation falls within one of the given
+// expected CIDRs of the same IP family. Pools are not checked if no expected CIDR of their family is given.
+func validatePoolsWithinCIDRs(instance *operator.Installation, expected []string) error {
+	if len(expected) == 0 || instance.Spec.CalicoNetwork == nil {
+		return nil
+	}
+	for _, pool := range instance.Spec.CalicoNetwork.IPPools {
+		isIPv6 := strings.Contains(pool.CIDR, ":")
+		sameFamily := []string{}
+		for _, cidr := range expected {
+			if strings.Contains(cidr, ":") == isIPv6 {
+				sameFamily = append(sameFamily, cidr)
+			}
+		}
+		if len(sameFamily) == 0 {
+			continue
+		}
+
+		within := false
+		for _, cidr := range sameFamily {
+			if cidrWithinCidr(cidr, pool.CIDR) {
+				within = true
+				break
+			}
+		}
+		if !within {
+			return fmt.Errorf("IP pool CIDR (%s) is not within the expected node CIDRs (%s)", pool.CIDR, strings.Join(sameFamily, ","))
+		}
+	}
+	return nil
+}
+
+// validatePoolsNATOutgoingConsistent verifies that all IP pools of the same IP family in the Installation use the
+// same natOutgoing setting. Mixing settings within a family causes asymmetric NAT for traffic leaving the cluster.
+func validatePoolsNATOutgoingConsistent(instance *operator.Installation) error {
+	if instance.Spec.CalicoNetwork == nil {
+		return nil
+	}
+	first := map[bool]operator.IPPool{}
+	for _, pool := range instance.Spec.CalicoNetwork.IPPools {
+		isIPv6 := strings.Contains(pool.CIDR, ":")
+		prev, ok := first[isIPv6]
+		if !ok {
+			first[isIPv6] = pool
+			continue
+		}
+		if prev.NATOutgoing != pool.NATOutgoing {
+			family := "IPv4"
+			if isIPv6 {
+				family = "IPv6"
+			}
+			return fmt.Errorf("%s IP pools have inconsistent natOutgoing settings: %s (%s) is %s but %s (%s) is %s",
+				family, prev.Name, prev.CIDR, prev.NATOutgoing, pool.Name, pool.CIDR, pool.NATOutgoing)
+		}
+	}
 	return nil
 }
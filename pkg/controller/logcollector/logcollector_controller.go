@@ -105,6 +105,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, licenseAPIReady
 		provider:        opts.DetectedProvider,
 		status:          status.New(mgr.GetClient(), "log-collector", opts.KubernetesVersion),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		licenseAPIReady: licenseAPIReady,
 		tierWatchReady:  tierWatchReady,
 		usePSP:          opts.UsePSP,
@@ -181,6 +182,7 @@ type ReconcileLogCollector struct {
 	provider        operatorv1.Provider
 	status          status.StatusManager
 	clusterDomain   string
+	auditLog        bool
 	licenseAPIReady *utils.ReadyFlag
 	tierWatchReady  *utils.ReadyFlag
 	usePSP          bool
@@ -568,7 +570,7 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 	}
 
 	// Create a component handler to manage the rendered component.
-	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, instance, utils.WithInstallation(installation), utils.WithAuditLog(r.auditLog, "logcollector-controller"))
 
 	fluentdCfg := &render.FluentdConfiguration{
 		LogCollector:           instance,
@@ -661,7 +663,7 @@ func (r *ReconcileLogCollector) Reconcile(ctx context.Context, request reconcile
 		}
 
 		// Create a component handler to manage the rendered component.
-		handler = utils.NewComponentHandler(log, r.client, r.scheme, instance)
+		handler = utils.NewComponentHandler(log, r.client, r.scheme, instance, utils.WithInstallation(installation), utils.WithAuditLog(r.auditLog, "logcollector-controller"))
 
 		if err := handler.CreateOrUpdateOrDelete(ctx, comp, r.status); err != nil {
 			r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
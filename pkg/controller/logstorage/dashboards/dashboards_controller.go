@@ -63,6 +63,7 @@ type DashboardsSubController struct {
 	status          status.StatusManager
 	provider        operatorv1.Provider
 	clusterDomain   string
+	auditLog        bool
 	usePSP          bool
 	multiTenant     bool
 	elasticExternal bool
@@ -79,6 +80,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		scheme:          mgr.GetScheme(),
 		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageDashboards, opts.KubernetesVersion),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		provider:        opts.DetectedProvider,
 		tierWatchReady:  &utils.ReadyFlag{},
 		multiTenant:     opts.MultiTenant,
@@ -368,9 +370,9 @@ func (d DashboardsSubController) Reconcile(ctx context.Context, request reconcil
 	// In standard installs, the LogStorage owns the dashboards. For multi-tenant, it's owned by the Tenant instance.
 	var hdler utils.ComponentHandler
 	if d.multiTenant {
-		hdler = utils.NewComponentHandler(reqLogger, d.client, d.scheme, tenant)
+		hdler = utils.NewComponentHandler(reqLogger, d.client, d.scheme, tenant, utils.WithInstallation(install), utils.WithAuditLog(d.auditLog, "log-storage-dashboards-controller"))
 	} else {
-		hdler = utils.NewComponentHandler(reqLogger, d.client, d.scheme, logStorage)
+		hdler = utils.NewComponentHandler(reqLogger, d.client, d.scheme, logStorage, utils.WithInstallation(install), utils.WithAuditLog(d.auditLog, "log-storage-dashboards-controller"))
 	}
 	if err := hdler.CreateOrUpdateOrDelete(ctx, dashboardsComponent, d.status); err != nil {
 		d.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating / deleting resource", err, reqLogger)
@@ -59,6 +59,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -77,6 +78,7 @@ type ElasticSubController struct {
 	provider       operatorv1.Provider
 	esCliCreator   utils.ElasticsearchClientCreator
 	clusterDomain  string
+	auditLog       bool
 	tierWatchReady *utils.ReadyFlag
 	usePSP         bool
 	multiTenant    bool
@@ -101,6 +103,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		status:         status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageElastic, opts.KubernetesVersion),
 		usePSP:         opts.UsePSP,
 		clusterDomain:  opts.ClusterDomain,
+		auditLog:       opts.AuditLog,
 		provider:       opts.DetectedProvider,
 		multiTenant:    opts.MultiTenant,
 	}
@@ -196,6 +199,15 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		}
 	}
 
+	// Watch the snapshot repository credentials secret. Its name is configured on the LogStorage, so watch secrets in
+	// the operator namespace and filter on the configured name.
+	isSnapshotCredentials := func(o client.Object) bool {
+		return o.GetNamespace() == common.OperatorNamespace() && isSnapshotRepositoryCredentialsSecret(mgr.GetClient(), o.GetName())
+	}
+	if err = c.WatchObject(&corev1.Secret{}, &handler.EnqueueRequestForObject{}, predicate.NewPredicateFuncs(isSnapshotCredentials)); err != nil {
+		return fmt.Errorf("log-storage-elastic-controller failed to watch the snapshot repository credentials Secret: %w", err)
+	}
+
 	// Establish watches for secrets in the tigera-elasticsearch namespace.
 	for _, secretName := range []string{
 		render.ElasticsearchAdminUserSecret,
@@ -394,8 +406,10 @@ func (r *ElasticSubController) Reconcile(ctx context.Context, request reconcile.
 	// Check if there is a StorageClass available to run Elasticsearch on.
 	if err = r.client.Get(ctx, client.ObjectKey{Name: ls.Spec.StorageClassName}, &storagev1.StorageClass{}); err != nil {
 		if errors.IsNotFound(err) {
+			// Don't render Elasticsearch until the storage class exists, its volume claims would remain pending otherwise.
+			// StorageClasses are watched, so we reconcile again once it is created.
 			err := fmt.Errorf("couldn't find storage class %s, this must be provided", ls.Spec.StorageClassName)
-			r.status.SetDegraded(operatorv1.ResourceNotFound, "Failed to get storage class", err, reqLogger)
+			r.status.SetDegraded(operatorv1.ResourceNotFound, fmt.Sprintf("Storage class %s not found", ls.Spec.StorageClassName), err, reqLogger)
 			return reconcile.Result{}, nil
 		}
 		r.status.SetDegraded(operatorv1.ResourceReadError, "Failed to get storage class", err, reqLogger)
@@ -422,6 +436,18 @@ func (r *ElasticSubController) Reconcile(ctx context.Context, request reconcile.
 		}
 	}
 
+	var snapshotRepositoryCredentials *corev1.Secret
+	if repo := ls.Spec.SnapshotRepository; repo != nil && repo.CredentialsSecretName != "" {
+		snapshotRepositoryCredentials, err = utils.GetSecret(ctx, r.client, repo.CredentialsSecretName, common.OperatorNamespace())
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Failed to read the snapshot repository credentials secret", err, reqLogger)
+			return reconcile.Result{}, err
+		} else if snapshotRepositoryCredentials == nil {
+			r.status.SetDegraded(operatorv1.ResourceNotFound, fmt.Sprintf("Waiting for snapshot repository credentials secret %s/%s", common.OperatorNamespace(), repo.CredentialsSecretName), nil, reqLogger)
+			return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+		}
+	}
+
 	// Get the admin user secret to copy to the operator namespace.
 	esAdminUserSecret, err = utils.GetSecret(ctx, r.client, render.ElasticsearchAdminUserSecret, render.ElasticsearchNamespace)
 	if err != nil {
@@ -517,7 +543,7 @@ func (r *ElasticSubController) Reconcile(ctx context.Context, request reconcile.
 		return reconcile.Result{}, err
 	}
 
-	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, ls)
+	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, ls, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, "log-storage-elastic-controller"))
 
 	logStorageCfg := &render.ElasticsearchConfiguration{
 		LogStorage:              ls,
@@ -542,6 +568,9 @@ func (r *ElasticSubController) Reconcile(ctx context.Context, request reconcile.
 		ApplyTrial:              applyTrial,
 		KeyStoreSecret:          keyStoreSecret,
 		KibanaEnabled:           kibanaEnabled,
+		Authentication:          authentication,
+
+		SnapshotRepositoryCredentials: snapshotRepositoryCredentials,
 	}
 
 	component := render.LogStorage(logStorageCfg)
@@ -574,6 +603,10 @@ func (r *ElasticSubController) Reconcile(ctx context.Context, request reconcile.
 			r.status.SetDegraded(operatorv1.ResourceNotReady, "Error applying ILM policies", nil, reqLogger)
 			return reconcile.Result{}, err
 		}
+		if err := r.applySnapshotRepository(ls, ctx); err != nil {
+			r.status.SetDegraded(operatorv1.ResourceNotReady, "Error applying snapshot repository", err, reqLogger)
+			return reconcile.Result{}, err
+		}
 	}
 
 	if kibanaEnabled && esLicenseType == render.ElasticsearchLicenseTypeBasic {
@@ -671,6 +704,30 @@ func (r *ElasticSubController) applyILMPolicies(ls *operatorv1.LogStorage, reqLo
 	return nil
 }
 
+// isSnapshotRepositoryCredentialsSecret returns whether the named secret in the operator namespace holds the snapshot
+// repository credentials configured on the LogStorage.
+func isSnapshotRepositoryCredentialsSecret(cli client.Client, name string) bool {
+	ls := &operatorv1.LogStorage{}
+	if err := cli.Get(context.Background(), utils.DefaultTSEEInstanceKey, ls); err != nil {
+		if !errors.IsNotFound(err) {
+			log.Error(err, "Failed to query LogStorage")
+		}
+		return false
+	}
+	return ls.Spec.SnapshotRepository != nil && ls.Spec.SnapshotRepository.CredentialsSecretName == name
+}
+
+func (r *ElasticSubController) applySnapshotRepository(ls *operatorv1.LogStorage, ctx context.Context) error {
+	if ls.Spec.SnapshotRepository == nil {
+		return nil
+	}
+	esClient, err := r.esCliCreator(r.client, ctx, relasticsearch.ECKElasticEndpoint())
+	if err != nil {
+		return err
+	}
+	return esClient.SetSnapshotRepository(ctx, ls)
+}
+
 func (r *ElasticSubController) getElasticsearchService(ctx context.Context) (*corev1.Service, error) {
 	svc := corev1.Service{}
 	err := r.client.Get(ctx, client.ObjectKey{Name: render.ElasticsearchServiceName, Namespace: render.ElasticsearchNamespace}, &svc)
@@ -319,6 +319,51 @@ var _ = Describe("LogStorage controller", func() {
 				mockStatus.On("RemoveCronJobs", mock.Anything)
 			})
 
+			It("should degrade and not render Elasticsearch when the storage class does not exist", func() {
+				CreateLogStorage(cli, &operatorv1.LogStorage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "tigera-secure",
+					},
+					Spec: operatorv1.LogStorageSpec{
+						Nodes: &operatorv1.Nodes{
+							Count: int64(1),
+						},
+						StorageClassName: storageClassName,
+					},
+					Status: operatorv1.LogStorageStatus{
+						State: operatorv1.TigeraStatusReady,
+					},
+				})
+
+				r, err := NewReconcilerWithShims(cli, scheme, mockStatus, operatorv1.ProviderNone, MockESCLICreator, dns.DefaultClusterDomain, readyFlag)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, fmt.Sprintf("Storage class %s not found", storageClassName), mock.Anything, mock.Anything).Return()
+				result, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).Should(Equal(reconcile.Result{}))
+				mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, fmt.Sprintf("Storage class %s not found", storageClassName), mock.Anything, mock.Anything)
+
+				err = cli.Get(ctx, esObjKey, &esv1.Elasticsearch{})
+				Expect(errors.IsNotFound(err)).Should(BeTrue())
+
+				By("creating the storage class")
+				Expect(cli.Create(ctx, &storagev1.StorageClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: storageClassName,
+					},
+				})).ShouldNot(HaveOccurred())
+				Expect(cli.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: render.ECKOperatorNamespace, Name: render.ECKLicenseConfigMapName},
+					Data:       map[string]string{"eck_license_level": string(render.ElasticsearchLicenseTypeEnterprise)},
+				})).ShouldNot(HaveOccurred())
+
+				mockStatus.On("SetDegraded", operatorv1.ResourceNotReady, "Waiting for Elasticsearch cluster to be operational", mock.Anything, mock.Anything).Return()
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(cli.Get(ctx, esObjKey, &esv1.Elasticsearch{})).ShouldNot(HaveOccurred())
+			})
+
 			It("test LogStorage reconciles successfully", func() {
 				Expect(cli.Create(ctx, &storagev1.StorageClass{
 					ObjectMeta: metav1.ObjectMeta{
@@ -427,6 +472,108 @@ var _ = Describe("LogStorage controller", func() {
 				mockStatus.AssertExpectations(GinkgoT())
 			})
 
+			It("configures the snapshot repository in Elasticsearch", func() {
+				Expect(cli.Create(ctx, &storagev1.StorageClass{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: storageClassName,
+					},
+				})).ShouldNot(HaveOccurred())
+
+				CreateLogStorage(cli, &operatorv1.LogStorage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "tigera-secure",
+					},
+					Spec: operatorv1.LogStorageSpec{
+						Nodes: &operatorv1.Nodes{
+							Count: int64(1),
+						},
+						StorageClassName: storageClassName,
+						SnapshotRepository: &operatorv1.SnapshotRepository{
+							Type:                  operatorv1.SnapshotRepositoryTypeS3,
+							Bucket:                "es-backups",
+							CredentialsSecretName: "es-snapshot-credentials",
+						},
+					},
+					Status: operatorv1.LogStorageStatus{
+						State: operatorv1.TigeraStatusReady,
+					},
+				})
+
+				Expect(cli.Create(ctx, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: render.ECKOperatorNamespace, Name: render.ECKLicenseConfigMapName},
+					Data:       map[string]string{"eck_license_level": string(render.ElasticsearchLicenseTypeEnterprise)},
+				})).ShouldNot(HaveOccurred())
+
+				mockESClient := &MockESClient{}
+				ctx = context.WithValue(ctx, MockESClientKey("mockESClient"), mockESClient)
+
+				r, err := NewReconcilerWithShims(cli, scheme, mockStatus, operatorv1.ProviderNone, MockESCLICreator, dns.DefaultClusterDomain, readyFlag)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				By("waiting for the credentials secret")
+				mockStatus.On("SetDegraded", operatorv1.ResourceNotFound, "Waiting for snapshot repository credentials secret tigera-operator/es-snapshot-credentials", mock.Anything, mock.Anything).Return()
+				result, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).Should(Equal(reconcile.Result{RequeueAfter: utils.StandardRetry}))
+
+				// The credentials secret is watched by the name configured on the LogStorage.
+				Expect(isSnapshotRepositoryCredentialsSecret(cli, "es-snapshot-credentials")).To(BeTrue())
+				Expect(isSnapshotRepositoryCredentialsSecret(cli, render.ElasticsearchAdminUserSecret)).To(BeFalse())
+
+				Expect(cli.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "es-snapshot-credentials", Namespace: common.OperatorNamespace()},
+					Data: map[string][]byte{
+						"s3.client.default.access_key": []byte("access"),
+						"s3.client.default.secret_key": []byte("secret"),
+					},
+				})).ShouldNot(HaveOccurred())
+
+				By("adding the credentials to the Elasticsearch keystore")
+				mockStatus.On("SetDegraded", operatorv1.ResourceNotReady, "Waiting for Elasticsearch cluster to be operational", mock.Anything, mock.Anything).Return()
+				_, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(cli.Get(ctx, types.NamespacedName{Name: "es-snapshot-credentials", Namespace: render.ElasticsearchNamespace}, &corev1.Secret{})).ShouldNot(HaveOccurred())
+				es := &esv1.Elasticsearch{}
+				Expect(cli.Get(ctx, esObjKey, es)).ShouldNot(HaveOccurred())
+				Expect(es.Spec.SecureSettings).To(ConsistOf(cmnv1.SecretSource{SecretName: "es-snapshot-credentials"}))
+
+				es.Status.Phase = esv1.ElasticsearchReadyPhase
+				Expect(cli.Update(ctx, es)).ShouldNot(HaveOccurred())
+
+				kb := &kbv1.Kibana{}
+				Expect(cli.Get(ctx, kbObjKey, kb)).ShouldNot(HaveOccurred())
+				kb.Status.AssociationStatus = cmnv1.AssociationEstablished
+				Expect(cli.Update(ctx, kb)).ShouldNot(HaveOccurred())
+
+				kibanaKeyPair, err := certificateManager.GetOrCreateKeyPair(r.client, render.TigeraKibanaCertSecret, common.OperatorNamespace(), kbDNSNames)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(cli.Create(ctx, kibanaKeyPair.Secret(render.KibanaNamespace))).ShouldNot(HaveOccurred())
+				Expect(cli.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      render.ElasticsearchAdminUserSecret,
+						Namespace: render.ElasticsearchNamespace,
+					},
+					Data: map[string][]byte{
+						"elastic": []byte("password"),
+					},
+				})).ShouldNot(HaveOccurred())
+
+				By("registering the repository once Elasticsearch is ready")
+				mockESClient.On("SetSnapshotRepository", mock.Anything, &operatorv1.SnapshotRepository{
+					Name:                  initializer.DefaultSnapshotRepositoryName,
+					Type:                  operatorv1.SnapshotRepositoryTypeS3,
+					Bucket:                "es-backups",
+					CredentialsSecretName: "es-snapshot-credentials",
+				}).Return(nil)
+				mockStatus.On("ClearDegraded")
+				result, err = r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(result).Should(Equal(successResult))
+
+				mockESClient.AssertExpectations(GinkgoT())
+			})
+
 			It("test LogStorage reconciles successfully for elasticsearch basic license", func() {
 				Expect(cli.Create(ctx, &operatorv1.Authentication{
 					ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
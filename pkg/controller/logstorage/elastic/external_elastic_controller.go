@@ -48,6 +48,7 @@ type ExternalESController struct {
 	status        status.StatusManager
 	provider      operatorv1.Provider
 	clusterDomain string
+	auditLog      bool
 	usePSP        bool
 }
 
@@ -66,6 +67,7 @@ func AddExternalES(mgr manager.Manager, opts options.AddOptions) error {
 		status:        status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageElastic, opts.KubernetesVersion),
 		usePSP:        opts.UsePSP,
 		clusterDomain: opts.ClusterDomain,
+		auditLog:      opts.AuditLog,
 		provider:      opts.DetectedProvider,
 	}
 	r.status.Run(opts.ShutdownContext)
@@ -141,7 +143,7 @@ func (r *ExternalESController) Reconcile(ctx context.Context, request reconcile.
 	flowShards := logstoragecommon.CalculateFlowShards(ls.Spec.Nodes, logstoragecommon.DefaultElasticsearchShards)
 	clusterConfig := relasticsearch.NewClusterConfig(render.DefaultElasticsearchClusterName, ls.Replicas(), logstoragecommon.DefaultElasticsearchShards, flowShards)
 
-	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, ls)
+	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, ls, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, "log-storage-external-es-controller"))
 	externalElasticsearch := externalelasticsearch.ExternalElasticsearch(install, clusterConfig, pullSecrets)
 	if err := hdler.CreateOrUpdateOrDelete(ctx, externalElasticsearch, r.status); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
@@ -45,6 +45,14 @@ func (m *MockESClient) SetILMPolicies(_ context.Context, _ *operatorv1.LogStorag
 	return nil
 }
 
+func (m *MockESClient) SetSnapshotRepository(ctx context.Context, ls *operatorv1.LogStorage) error {
+	if ls.Spec.SnapshotRepository == nil {
+		return nil
+	}
+	ret := m.Called(ctx, ls.Spec.SnapshotRepository)
+	return ret.Error(0)
+}
+
 func (m *MockESClient) DeleteRoles(ctx context.Context, roles []utils.Role) error {
 	var ret mock.Arguments
 	for _, role := range roles {
@@ -58,6 +58,7 @@ type ESMetricsSubController struct {
 	status         status.StatusManager
 	provider       operatorv1.Provider
 	clusterDomain  string
+	auditLog       bool
 	usePSP         bool
 	multiTenant    bool
 	tierWatchReady *utils.ReadyFlag
@@ -79,6 +80,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		scheme:         mgr.GetScheme(),
 		status:         status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageESMetrics, opts.KubernetesVersion),
 		clusterDomain:  opts.ClusterDomain,
+		auditLog:       opts.AuditLog,
 		provider:       opts.DetectedProvider,
 		tierWatchReady: &utils.ReadyFlag{},
 	}
@@ -252,7 +254,7 @@ func (r *ESMetricsSubController) Reconcile(ctx context.Context, request reconcil
 		return reconcile.Result{}, err
 	}
 
-	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, logStorage)
+	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, logStorage, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, "log-storage-esmetrics-controller"))
 
 	if err = hdler.CreateOrUpdateOrDelete(ctx, esMetricsComponent, r.status); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
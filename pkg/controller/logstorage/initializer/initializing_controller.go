@@ -17,6 +17,8 @@ package initializer
 import (
 	"context"
 	"fmt"
+	"net"
+	"strings"
 
 	"github.com/go-logr/logr"
 
@@ -24,6 +26,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -32,17 +36,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/common/k8svalidation"
 	"github.com/tigera/operator/pkg/controller/options"
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/controller/utils"
 	"github.com/tigera/operator/pkg/ctrlruntime"
 	"github.com/tigera/operator/pkg/render"
+	"github.com/tigera/operator/pkg/render/logstorage/esgateway"
+	"github.com/tigera/operator/pkg/tls/certificatemanagement"
 )
 
 var log = logf.Log.WithName("controller_logstorage")
 
 const (
 	DefaultElasticsearchStorageClass     = "tigera-elasticsearch"
+	DefaultSnapshotRepositoryName        = "tigera-backup"
 	TigeraStatusName                     = "log-storage"
 	defaultEckOperatorMemorySetting      = "512Mi"
 	TigeraStatusLogStorageKubeController = "log-storage-kubecontrollers"
@@ -66,6 +74,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		client:      mgr.GetClient(),
 		scheme:      mgr.GetScheme(),
 		multiTenant: opts.MultiTenant,
+		auditLog:    opts.AuditLog,
 		status:      status.New(mgr.GetClient(), TigeraStatusName, opts.KubernetesVersion),
 	}
 	r.status.Run(opts.ShutdownContext)
@@ -98,6 +107,7 @@ type LogStorageInitializer struct {
 	status      status.StatusManager
 	provider    operatorv1.Provider
 	multiTenant bool
+	auditLog    bool
 }
 
 // FillDefaults populates the default values onto an LogStorage object.
@@ -148,6 +158,10 @@ func FillDefaults(opr *operatorv1.LogStorage) {
 		opr.Spec.Nodes = &operatorv1.Nodes{Count: 1}
 	}
 
+	if opr.Spec.SnapshotRepository != nil && opr.Spec.SnapshotRepository.Name == "" {
+		opr.Spec.SnapshotRepository.Name = DefaultSnapshotRepositoryName
+	}
+
 	if opr.Spec.ComponentResources == nil {
 		limits := corev1.ResourceList{}
 		requests := corev1.ResourceList{}
@@ -181,6 +195,109 @@ func validateComponentResources(spec *operatorv1.LogStorageSpec) error {
 	return nil
 }
 
+func validateESGateway(spec *operatorv1.LogStorageSpec) error {
+	if spec.ESGateway == nil {
+		return nil
+	}
+	if t := spec.ESGateway.IdleConnectionTimeout; t != nil && t.Duration <= 0 {
+		return fmt.Errorf("LogStorage spec.esGateway.idleConnectionTimeout must be a positive duration, got %s", t.Duration)
+	}
+	if rl := spec.ESGateway.RateLimit; rl != nil {
+		if rl.RequestsPerSecond <= 0 {
+			return fmt.Errorf("LogStorage spec.esGateway.rateLimit.requestsPerSecond must be positive, got %d", rl.RequestsPerSecond)
+		}
+		if rl.Burst != nil && *rl.Burst <= 0 {
+			return fmt.Errorf("LogStorage spec.esGateway.rateLimit.burst must be positive, got %d", *rl.Burst)
+		}
+	}
+	if v := spec.ESGateway.ElasticHTTPVersion; v != nil && *v != operatorv1.ESGatewayHTTP1 && *v != operatorv1.ESGatewayHTTP2 {
+		return fmt.Errorf("LogStorage spec.esGateway.elasticHTTPVersion %q is not supported", *v)
+	}
+	if l := spec.ESGateway.RevisionHistoryLimit; l != nil && *l < 0 {
+		return fmt.Errorf("LogStorage spec.esGateway.revisionHistoryLimit must not be negative, got %d", *l)
+	}
+	if p := spec.ESGateway.MetricsPort; p != nil {
+		if *p < 1 || *p > 65535 {
+			return fmt.Errorf("LogStorage spec.esGateway.metricsPort must be between 1 and 65535, got %d", *p)
+		}
+		if *p == esgateway.Port {
+			return fmt.Errorf("LogStorage spec.esGateway.metricsPort must not be %d, the port ES Gateway serves Elasticsearch and Kibana traffic on", esgateway.Port)
+		}
+	}
+	if s := spec.ESGateway.MaxRequestBodySize; s != nil && s.Sign() <= 0 {
+		return fmt.Errorf("LogStorage spec.esGateway.maxRequestBodySize must be positive, got %s", s.String())
+	}
+	if c := spec.ESGateway.AccessLogSidecar; c != nil {
+		if errs := validation.IsDNS1123Label(c.Name); len(errs) > 0 {
+			return fmt.Errorf("LogStorage spec.esGateway.accessLogSidecar name %q is invalid: %v", c.Name, errs)
+		}
+		if c.Name == esgateway.DeploymentName || c.Name == certificatemanagement.CSRInitContainerName {
+			return fmt.Errorf("LogStorage spec.esGateway.accessLogSidecar name %q is not unique", c.Name)
+		}
+		if c.Image == "" {
+			return fmt.Errorf("LogStorage spec.esGateway.accessLogSidecar must specify an image")
+		}
+		if len(c.VolumeMounts) > 0 {
+			return fmt.Errorf("LogStorage spec.esGateway.accessLogSidecar must not specify volume mounts, the access log volume is mounted by the operator")
+		}
+	}
+	if a := spec.ESGateway.ServiceAccountAnnotations; a != nil {
+		if errs := k8svalidation.ValidateAnnotations(a, field.NewPath("spec", "esGateway", "serviceAccountAnnotations")); len(errs) > 0 {
+			return fmt.Errorf("LogStorage spec.esGateway.serviceAccountAnnotations is not valid: %w", errs.ToAggregate())
+		}
+	}
+	return nil
+}
+
+func validateHeapSize(ls *operatorv1.LogStorage) error {
+	if ls.Spec.Nodes == nil || ls.Spec.Nodes.HeapSize == nil {
+		return nil
+	}
+	heapSize := ls.Spec.Nodes.HeapSize
+	if heapSize.Sign() <= 0 {
+		return fmt.Errorf("LogStorage spec.nodes.heapSize must be positive, got %s", heapSize.String())
+	}
+	resources := render.ElasticsearchResourceRequirements(ls)
+	memoryLimit := resources.Limits.Memory()
+	if heapSize.Value()*2 > memoryLimit.Value() {
+		return fmt.Errorf("LogStorage spec.nodes.heapSize %s must not be more than half of the Elasticsearch memory limit %s", heapSize.String(), memoryLimit.String())
+	}
+	return nil
+}
+
+func validateSnapshotRepository(spec *operatorv1.LogStorageSpec) error {
+	repo := spec.SnapshotRepository
+	if repo == nil {
+		return nil
+	}
+	switch repo.Type {
+	case operatorv1.SnapshotRepositoryTypeS3, operatorv1.SnapshotRepositoryTypeGCS:
+	default:
+		return fmt.Errorf("LogStorage spec.snapshotRepository.type %q is not supported", repo.Type)
+	}
+	if repo.Bucket == "" {
+		return fmt.Errorf("LogStorage spec.snapshotRepository.bucket must be set")
+	}
+	if strings.HasPrefix(repo.Name, "_") || strings.ContainsAny(repo.Name, " ,\\/*?\"<>|#") {
+		return fmt.Errorf("LogStorage spec.snapshotRepository.name %q is not a valid repository name", repo.Name)
+	}
+	for _, e := range repo.Endpoints {
+		errs := validation.IsDNS1123Subdomain(e)
+		if strings.HasPrefix(e, "*.") {
+			errs = validation.IsWildcardDNS1123Subdomain(e)
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("LogStorage spec.snapshotRepository.endpoints %q is not a valid domain name: %s", e, strings.Join(errs, ", "))
+		}
+	}
+	for _, c := range repo.CIDRs {
+		if _, _, err := net.ParseCIDR(c); err != nil {
+			return fmt.Errorf("LogStorage spec.snapshotRepository.cidrs %q is not a valid CIDR", c)
+		}
+	}
+	return nil
+}
+
 func (r *LogStorageInitializer) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	reqLogger.Info("Reconciling LogStorage")
@@ -233,6 +350,15 @@ func (r *LogStorageInitializer) Reconcile(ctx context.Context, request reconcile
 	// Default and validate the object.
 	FillDefaults(ls)
 	err = validateComponentResources(&ls.Spec)
+	if err == nil {
+		err = validateESGateway(&ls.Spec)
+	}
+	if err == nil {
+		err = validateSnapshotRepository(&ls.Spec)
+	}
+	if err == nil {
+		err = validateHeapSize(ls)
+	}
 	if err != nil {
 		// Invalid - mark it as such and return.
 		r.setConditionDegraded(ctx, ls, reqLogger)
@@ -241,15 +367,15 @@ func (r *LogStorageInitializer) Reconcile(ctx context.Context, request reconcile
 	}
 
 	// Before we can create secrets, we need to ensure the tigera-elasticsearch namespace exists.
-	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, ls)
-	esNamespace := render.CreateNamespace(render.ElasticsearchNamespace, install.KubernetesProvider, render.PSSPrivileged)
+	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, ls, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, "log-storage-initializing-controller"))
+	esNamespace := render.CreateNamespace(render.ElasticsearchNamespace, install, render.PSSPrivileged)
 	if err = hdler.CreateOrUpdateOrDelete(ctx, render.NewPassthrough(esNamespace), r.status); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
 		return reconcile.Result{}, err
 	}
 	if kibanaEnabled {
 		// Create the Namespace.
-		kbNamespace := render.CreateNamespace(render.KibanaNamespace, install.KubernetesProvider, render.PSSBaseline)
+		kbNamespace := render.CreateNamespace(render.KibanaNamespace, install, render.PSSBaseline)
 		if err = hdler.CreateOrUpdateOrDelete(ctx, render.NewPassthrough(kbNamespace), r.status); err != nil {
 			r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
 			return reconcile.Result{}, err
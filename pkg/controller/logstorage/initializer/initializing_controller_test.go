@@ -17,6 +17,7 @@ package initializer
 import (
 	"context"
 	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -299,6 +300,227 @@ var _ = Describe("LogStorage Initializing controller", func() {
 		})
 	})
 
+	Context("validateESGateway", func() {
+		It("should return nil when spec.ESGateway is nil", func() {
+			Expect(validateESGateway(&operatorv1.LogStorageSpec{})).To(BeNil())
+		})
+
+		It("should return nil for a positive idle connection timeout", func() {
+			spec := operatorv1.LogStorageSpec{ESGateway: &operatorv1.ESGateway{
+				IdleConnectionTimeout: &metav1.Duration{Duration: 30 * time.Second},
+			}}
+			Expect(validateESGateway(&spec)).To(BeNil())
+		})
+
+		It("should return an error for a non-positive idle connection timeout", func() {
+			spec := operatorv1.LogStorageSpec{ESGateway: &operatorv1.ESGateway{
+				IdleConnectionTimeout: &metav1.Duration{},
+			}}
+			Expect(validateESGateway(&spec)).NotTo(BeNil())
+
+			spec.ESGateway.IdleConnectionTimeout.Duration = -time.Second
+			Expect(validateESGateway(&spec)).NotTo(BeNil())
+		})
+
+		It("should return nil for a positive rate limit", func() {
+			burst := int32(20)
+			spec := operatorv1.LogStorageSpec{ESGateway: &operatorv1.ESGateway{
+				RateLimit: &operatorv1.ESGatewayRateLimit{RequestsPerSecond: 10, Burst: &burst},
+			}}
+			Expect(validateESGateway(&spec)).To(BeNil())
+
+			spec.ESGateway.RateLimit.Burst = nil
+			Expect(validateESGateway(&spec)).To(BeNil())
+		})
+
+		It("should return an error for a non-positive rate limit", func() {
+			spec := operatorv1.LogStorageSpec{ESGateway: &operatorv1.ESGateway{
+				RateLimit: &operatorv1.ESGatewayRateLimit{},
+			}}
+			Expect(validateESGateway(&spec)).NotTo(BeNil())
+
+			burst := int32(0)
+			spec.ESGateway.RateLimit = &operatorv1.ESGatewayRateLimit{RequestsPerSecond: 10, Burst: &burst}
+			Expect(validateESGateway(&spec)).NotTo(BeNil())
+		})
+
+		It("should validate the Elasticsearch HTTP version", func() {
+			version := operatorv1.ESGatewayHTTP2
+			spec := operatorv1.LogStorageSpec{ESGateway: &operatorv1.ESGateway{ElasticHTTPVersion: &version}}
+			Expect(validateESGateway(&spec)).To(BeNil())
+
+			version = "HTTP3"
+			Expect(validateESGateway(&spec)).NotTo(BeNil())
+		})
+
+		It("should validate the revisionHistoryLimit", func() {
+			var limit int32 = 0
+			spec := operatorv1.LogStorageSpec{ESGateway: &operatorv1.ESGateway{RevisionHistoryLimit: &limit}}
+			Expect(validateESGateway(&spec)).To(BeNil())
+
+			limit = -1
+			Expect(validateESGateway(&spec)).To(MatchError("LogStorage spec.esGateway.revisionHistoryLimit must not be negative, got -1"))
+		})
+
+		It("should validate the metrics port", func() {
+			var port int32 = 9095
+			spec := operatorv1.LogStorageSpec{ESGateway: &operatorv1.ESGateway{MetricsPort: &port}}
+			Expect(validateESGateway(&spec)).To(BeNil())
+
+			port = 0
+			Expect(validateESGateway(&spec)).To(MatchError("LogStorage spec.esGateway.metricsPort must be between 1 and 65535, got 0"))
+
+			port = 5554
+			Expect(validateESGateway(&spec)).To(MatchError("LogStorage spec.esGateway.metricsPort must not be 5554, the port ES Gateway serves Elasticsearch and Kibana traffic on"))
+		})
+
+		It("should validate the max request body size", func() {
+			size := resource.MustParse("10Mi")
+			spec := operatorv1.LogStorageSpec{ESGateway: &operatorv1.ESGateway{MaxRequestBodySize: &size}}
+			Expect(validateESGateway(&spec)).To(BeNil())
+
+			size = resource.MustParse("0")
+			Expect(validateESGateway(&spec)).To(MatchError("LogStorage spec.esGateway.maxRequestBodySize must be positive, got 0"))
+
+			size = resource.MustParse("-1Mi")
+			Expect(validateESGateway(&spec)).To(MatchError("LogStorage spec.esGateway.maxRequestBodySize must be positive, got -1Mi"))
+		})
+
+		It("should validate the access log sidecar", func() {
+			spec := operatorv1.LogStorageSpec{ESGateway: &operatorv1.ESGateway{
+				AccessLogSidecar: &corev1.Container{Name: "log-shipper", Image: "example.com/log-shipper:v1"},
+			}}
+			Expect(validateESGateway(&spec)).To(BeNil())
+
+			spec.ESGateway.AccessLogSidecar.Name = "tigera-secure-es-gateway"
+			Expect(validateESGateway(&spec)).To(MatchError(`LogStorage spec.esGateway.accessLogSidecar name "tigera-secure-es-gateway" is not unique`))
+
+			spec.ESGateway.AccessLogSidecar.Name = "key-cert-provisioner"
+			Expect(validateESGateway(&spec)).To(MatchError(`LogStorage spec.esGateway.accessLogSidecar name "key-cert-provisioner" is not unique`))
+
+			spec.ESGateway.AccessLogSidecar.Name = "Log_Shipper"
+			Expect(validateESGateway(&spec)).To(HaveOccurred())
+
+			spec.ESGateway.AccessLogSidecar.Name = "log-shipper"
+			spec.ESGateway.AccessLogSidecar.Image = ""
+			Expect(validateESGateway(&spec)).To(MatchError("LogStorage spec.esGateway.accessLogSidecar must specify an image"))
+
+			spec.ESGateway.AccessLogSidecar.Image = "example.com/log-shipper:v1"
+			spec.ESGateway.AccessLogSidecar.VolumeMounts = []corev1.VolumeMount{{Name: "data", MountPath: "/data"}}
+			Expect(validateESGateway(&spec)).To(HaveOccurred())
+		})
+
+		It("should validate the service account annotations", func() {
+			spec := operatorv1.LogStorageSpec{ESGateway: &operatorv1.ESGateway{
+				ServiceAccountAnnotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/es-gateway"},
+			}}
+			Expect(validateESGateway(&spec)).To(BeNil())
+
+			spec.ESGateway.ServiceAccountAnnotations = map[string]string{"not a valid key": "value"}
+			err := validateESGateway(&spec)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("LogStorage spec.esGateway.serviceAccountAnnotations is not valid"))
+		})
+	})
+
+	Context("validateHeapSize", func() {
+		It("should return nil when the heap size is not set", func() {
+			Expect(validateHeapSize(&operatorv1.LogStorage{Spec: operatorv1.LogStorageSpec{Nodes: &operatorv1.Nodes{Count: 1}}})).To(BeNil())
+		})
+
+		It("should validate the heap size against the default memory limit", func() {
+			heapSize := resource.MustParse("2Gi")
+			ls := &operatorv1.LogStorage{Spec: operatorv1.LogStorageSpec{Nodes: &operatorv1.Nodes{Count: 1, HeapSize: &heapSize}}}
+			Expect(validateHeapSize(ls)).To(BeNil())
+
+			heapSize = resource.MustParse("3Gi")
+			ls.Spec.Nodes.HeapSize = &heapSize
+			Expect(validateHeapSize(ls)).NotTo(BeNil())
+		})
+
+		It("should validate the heap size against the configured memory limit", func() {
+			heapSize := resource.MustParse("4Gi")
+			ls := &operatorv1.LogStorage{Spec: operatorv1.LogStorageSpec{Nodes: &operatorv1.Nodes{
+				Count:    1,
+				HeapSize: &heapSize,
+				ResourceRequirements: &corev1.ResourceRequirements{
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("8Gi")},
+				},
+			}}}
+			Expect(validateHeapSize(ls)).To(BeNil())
+
+			ls.Spec.Nodes.ResourceRequirements.Limits[corev1.ResourceMemory] = resource.MustParse("6Gi")
+			ls.Spec.Nodes.ResourceRequirements.Requests[corev1.ResourceMemory] = resource.MustParse("6Gi")
+			Expect(validateHeapSize(ls)).NotTo(BeNil())
+		})
+
+		It("should return an error for a heap size that is not positive", func() {
+			heapSize := resource.MustParse("0")
+			ls := &operatorv1.LogStorage{Spec: operatorv1.LogStorageSpec{Nodes: &operatorv1.Nodes{Count: 1, HeapSize: &heapSize}}}
+			Expect(validateHeapSize(ls)).NotTo(BeNil())
+		})
+	})
+
+	Context("validateSnapshotRepository", func() {
+		It("should return nil when spec.SnapshotRepository is nil", func() {
+			Expect(validateSnapshotRepository(&operatorv1.LogStorageSpec{})).To(BeNil())
+		})
+
+		It("should return nil for a valid repository", func() {
+			spec := operatorv1.LogStorageSpec{SnapshotRepository: &operatorv1.SnapshotRepository{
+				Name:   DefaultSnapshotRepositoryName,
+				Type:   operatorv1.SnapshotRepositoryTypeS3,
+				Bucket: "es-backups",
+			}}
+			Expect(validateSnapshotRepository(&spec)).To(BeNil())
+		})
+
+		It("should return an error for an unsupported type", func() {
+			spec := operatorv1.LogStorageSpec{SnapshotRepository: &operatorv1.SnapshotRepository{
+				Type:   "HDFS",
+				Bucket: "es-backups",
+			}}
+			Expect(validateSnapshotRepository(&spec)).NotTo(BeNil())
+		})
+
+		It("should return an error when the bucket is missing", func() {
+			spec := operatorv1.LogStorageSpec{SnapshotRepository: &operatorv1.SnapshotRepository{
+				Type: operatorv1.SnapshotRepositoryTypeGCS,
+			}}
+			Expect(validateSnapshotRepository(&spec)).NotTo(BeNil())
+		})
+
+		It("should return an error for an invalid repository name", func() {
+			spec := operatorv1.LogStorageSpec{SnapshotRepository: &operatorv1.SnapshotRepository{
+				Name:   "_backups",
+				Type:   operatorv1.SnapshotRepositoryTypeS3,
+				Bucket: "es-backups",
+			}}
+			Expect(validateSnapshotRepository(&spec)).NotTo(BeNil())
+
+			spec.SnapshotRepository.Name = "my backups"
+			Expect(validateSnapshotRepository(&spec)).NotTo(BeNil())
+		})
+
+		It("should validate the endpoints and CIDRs", func() {
+			spec := operatorv1.LogStorageSpec{SnapshotRepository: &operatorv1.SnapshotRepository{
+				Type:      operatorv1.SnapshotRepositoryTypeS3,
+				Bucket:    "es-backups",
+				Endpoints: []string{"s3.eu-west-1.amazonaws.com", "*.minio.example.com"},
+				CIDRs:     []string{"10.0.0.0/24"},
+			}}
+			Expect(validateSnapshotRepository(&spec)).To(BeNil())
+
+			spec.SnapshotRepository.Endpoints = []string{"https://s3.amazonaws.com"}
+			Expect(validateSnapshotRepository(&spec)).To(MatchError(ContainSubstring("is not a valid domain name")))
+
+			spec.SnapshotRepository.Endpoints = nil
+			spec.SnapshotRepository.CIDRs = []string{"10.0.0.0"}
+			Expect(validateSnapshotRepository(&spec)).To(MatchError(ContainSubstring("is not a valid CIDR")))
+		})
+	})
+
 	Context("FillDefaults", func() {
 		It("should set the replica values to the default settings", func() {
 			retain8 := int32(8)
@@ -330,6 +552,17 @@ var _ = Describe("LogStorage Initializing controller", func() {
 			Expect(ls.Spec.StorageClassName).To(Equal(DefaultElasticsearchStorageClass))
 		})
 
+		It("should default the snapshot repository name", func() {
+			ls := &operatorv1.LogStorage{}
+			ls.Name = "tigera-secure"
+			FillDefaults(ls)
+			Expect(ls.Spec.SnapshotRepository).To(BeNil())
+
+			ls.Spec.SnapshotRepository = &operatorv1.SnapshotRepository{Type: operatorv1.SnapshotRepositoryTypeS3, Bucket: "es-backups"}
+			FillDefaults(ls)
+			Expect(ls.Spec.SnapshotRepository.Name).To(Equal(DefaultSnapshotRepositoryName))
+		})
+
 		It("should default the spec.nodes structure", func() {
 			ls := &operatorv1.LogStorage{}
 			ls.Name = "tigera-secure"
@@ -32,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
@@ -60,6 +61,7 @@ type ESKubeControllersController struct {
 	scheme          *runtime.Scheme
 	status          status.StatusManager
 	clusterDomain   string
+	auditLog        bool
 	usePSP          bool
 	elasticExternal bool
 	multiTenant     bool
@@ -76,6 +78,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageKubeController, opts.KubernetesVersion),
 		elasticExternal: opts.ElasticExternal,
 		multiTenant:     opts.MultiTenant,
@@ -109,6 +112,9 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	if err = c.WatchObject(&operatorv1.ManagementClusterConnection{}, eventHandler); err != nil {
 		return fmt.Errorf("log-storage-kubecontrollers failed to watch ManagementClusterConnection resource: %w", err)
 	}
+	if err = c.WatchObject(&operatorv1.Authentication{}, eventHandler); err != nil {
+		return fmt.Errorf("log-storage-kubecontrollers failed to watch Authentication resource: %w", err)
+	}
 	if err = utils.AddTigeraStatusWatch(c, initializer.TigeraStatusLogStorageKubeController); err != nil {
 		return fmt.Errorf("logstorage-controller failed to watch logstorage Tigerastatus: %w", err)
 	}
@@ -134,6 +140,15 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		}
 	}
 
+	// Watch the ConfigMap holding a custom ES Gateway configuration. Its name is configured on the LogStorage, so
+	// watch ConfigMaps in the operator namespace and filter on the configured name.
+	isESGatewayConfigMap := func(o client.Object) bool {
+		return o.GetNamespace() == common.OperatorNamespace() && isESGatewayConfigMapName(mgr.GetClient(), o.GetName())
+	}
+	if err = c.WatchObject(&core.ConfigMap{}, eventHandler, predicate.NewPredicateFuncs(isESGatewayConfigMap)); err != nil {
+		return fmt.Errorf("log-storage-kubecontrollers failed to watch the ES Gateway config ConfigMap: %w", err)
+	}
+
 	// The namespace(s) we need to monitor depend upon what tenancy mode we're running in.
 	// For single-tenant, everything is installed in the calico-system namespace.
 	// Make a helper for determining which namespaces to use based on tenancy mode.
@@ -185,6 +200,19 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	return nil
 }
 
+// isESGatewayConfigMapName returns whether the named ConfigMap in the operator namespace holds the custom ES Gateway
+// configuration referenced by the LogStorage.
+func isESGatewayConfigMapName(cli client.Client, name string) bool {
+	logStorage := &operatorv1.LogStorage{}
+	if err := cli.Get(context.Background(), utils.DefaultTSEEInstanceKey, logStorage); err != nil {
+		if !errors.IsNotFound(err) {
+			log.Error(err, "Failed to query LogStorage")
+		}
+		return false
+	}
+	return logStorage.Spec.ESGateway != nil && logStorage.Spec.ESGateway.ConfigMapName == name
+}
+
 func (r *ESKubeControllersController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	helper := utils.NewNamespaceHelper(r.multiTenant, common.CalicoNamespace, request.Namespace)
 	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name, "installNS", helper.InstallNamespace(), "truthNS", helper.TruthNamespace())
@@ -310,7 +338,7 @@ func (r *ESKubeControllersController) Reconcile(ctx context.Context, request rec
 		return reconcile.Result{}, err
 	}
 
-	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, logStorage)
+	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, logStorage, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, "log-storage-kubecontrollers-controller"))
 
 	// Get the Authentication resource.
 	authentication, err := utils.GetAuthentication(ctx, r.client)
@@ -346,6 +374,8 @@ func (r *ESKubeControllersController) Reconcile(ctx context.Context, request rec
 			reqLogger,
 			gwTrustedBundle,
 			r.usePSP,
+			logStorage.Spec.ESGateway,
+			authentication,
 		); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -50,6 +50,7 @@ import (
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
 	"github.com/tigera/operator/pkg/dns"
 	"github.com/tigera/operator/pkg/render"
+	rtest "github.com/tigera/operator/pkg/render/common/test"
 	"github.com/tigera/operator/pkg/render/kubecontrollers"
 	"github.com/tigera/operator/pkg/render/logstorage"
 	"github.com/tigera/operator/pkg/render/logstorage/esgateway"
@@ -247,6 +248,113 @@ var _ = Describe("LogStorage ES kube-controllers controller", func() {
 		Expect(test.GetResource(cli, &dep)).To(BeNil())
 	})
 
+	It("should roll es-gateway when a certificate in its trusted bundle changes", func() {
+		result, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).Should(Equal(successResult))
+
+		dep := appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: esgateway.DeploymentName, Namespace: render.ElasticsearchNamespace},
+		}
+		Expect(test.GetResource(cli, &dep)).To(BeNil())
+		oldAnnotations := dep.Spec.Template.Annotations
+
+		// Add the external Elasticsearch CA to the trusted bundle, as the secrets controller does when it is rotated.
+		cm, err := certificatemanager.Create(cli, &install.Spec, dns.DefaultClusterDomain, common.OperatorNamespace())
+		Expect(err).ShouldNot(HaveOccurred())
+		externalESSecret := rtest.CreateCertSecret(logstorage.ExternalESPublicCertName, common.OperatorNamespace(), "external.es.com")
+		Expect(cli.Create(ctx, externalESSecret)).ShouldNot(HaveOccurred())
+		externalESCert, err := cm.GetCertificate(cli, logstorage.ExternalESPublicCertName, common.OperatorNamespace())
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(cli.Update(ctx, cm.CreateTrustedBundle(externalESCert).ConfigMap(render.ElasticsearchNamespace))).ShouldNot(HaveOccurred())
+
+		result, err = r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(result).Should(Equal(successResult))
+
+		Expect(test.GetResource(cli, &dep)).To(BeNil())
+		Expect(dep.Spec.Template.Annotations).NotTo(Equal(oldAnnotations))
+		Expect(dep.Spec.Template.Annotations).To(HaveLen(len(oldAnnotations) + 1))
+	})
+
+	Context("ES Gateway custom config", func() {
+		BeforeEach(func() {
+			ls := &operatorv1.LogStorage{}
+			Expect(cli.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, ls)).ShouldNot(HaveOccurred())
+			ls.Spec.ESGateway = &operatorv1.ESGateway{ConfigMapName: "my-es-gateway-config"}
+			Expect(cli.Update(ctx, ls)).ShouldNot(HaveOccurred())
+			ls.Status.State = operatorv1.TigeraStatusReady
+			Expect(cli.Status().Update(ctx, ls)).ShouldNot(HaveOccurred())
+		})
+
+		It("should mount the referenced ConfigMap into es-gateway", func() {
+			Expect(cli.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-es-gateway-config", Namespace: common.OperatorNamespace()},
+				Data:       map[string]string{esgateway.ConfigFileKey: "maxIdleConns: 10"},
+			})).ShouldNot(HaveOccurred())
+
+			result, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(result).Should(Equal(successResult))
+
+			cm := corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: esgateway.ConfigMapName, Namespace: render.ElasticsearchNamespace},
+			}
+			Expect(test.GetResource(cli, &cm)).To(BeNil())
+			Expect(cm.Data).To(Equal(map[string]string{esgateway.ConfigFileKey: "maxIdleConns: 10"}))
+		})
+
+		It("should degrade when the referenced ConfigMap does not exist", func() {
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).Should(HaveOccurred())
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceNotFound, `ES Gateway config ConfigMap "my-es-gateway-config" not found`, mock.Anything, mock.Anything)
+		})
+
+		It("should degrade when the referenced ConfigMap has no config file", func() {
+			Expect(cli.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-es-gateway-config", Namespace: common.OperatorNamespace()},
+				Data:       map[string]string{"other.yaml": "foo"},
+			})).ShouldNot(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring(`does not contain the key "config.yaml"`))
+		})
+
+		It("should only watch the referenced ConfigMap", func() {
+			Expect(isESGatewayConfigMapName(cli, "my-es-gateway-config")).To(BeTrue())
+			Expect(isESGatewayConfigMapName(cli, "other-config")).To(BeFalse())
+		})
+
+		It("should roll es-gateway when the referenced ConfigMap changes", func() {
+			customConfig := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-es-gateway-config", Namespace: common.OperatorNamespace()},
+				Data:       map[string]string{esgateway.ConfigFileKey: "maxIdleConns: 10"},
+			}
+			Expect(cli.Create(ctx, customConfig)).ShouldNot(HaveOccurred())
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			dep := appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: esgateway.DeploymentName, Namespace: render.ElasticsearchNamespace},
+			}
+			Expect(test.GetResource(cli, &dep)).To(BeNil())
+			hash := dep.Spec.Template.Annotations["hash.operator.tigera.io/es-gateway-config"]
+			Expect(hash).NotTo(BeEmpty())
+
+			customConfig.Data[esgateway.ConfigFileKey] = "maxIdleConns: 20"
+			Expect(cli.Update(ctx, customConfig)).ShouldNot(HaveOccurred())
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(test.GetResource(cli, &dep)).To(BeNil())
+			Expect(dep.Spec.Template.Annotations["hash.operator.tigera.io/es-gateway-config"]).NotTo(Equal(hash))
+		})
+	})
+
 	It("should use images from ImageSet", func() {
 		Expect(cli.Create(ctx, &operatorv1.ImageSet{
 			ObjectMeta: metav1.ObjectMeta{Name: "enterprise-" + components.EnterpriseRelease},
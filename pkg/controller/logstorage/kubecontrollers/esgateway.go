@@ -16,9 +16,12 @@ package kubecontrollers
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
@@ -42,6 +45,8 @@ func (r *ESKubeControllersController) createESGateway(
 	reqLogger logr.Logger,
 	trustedBundle certificatemanagement.TrustedBundleRO,
 	usePSP bool,
+	esGateway *operatorv1.ESGateway,
+	authentication *operatorv1.Authentication,
 ) error {
 	// Get the ES admin user secret. For internal ES, this is provisioned by the ECK operator as part of installing Elasticsearch,
 	// and so may not be immediately available.
@@ -110,6 +115,36 @@ func (r *ESKubeControllersController) createESGateway(
 		UsePSP:                     usePSP,
 		Namespace:                  helper.InstallNamespace(),
 		TruthNamespace:             helper.TruthNamespace(),
+		Authentication:             authentication,
+	}
+	if esGateway != nil {
+		cfg.IdleConnectionTimeout = esGateway.IdleConnectionTimeout
+		cfg.RateLimit = esGateway.RateLimit
+		cfg.ElasticHTTPVersion = esGateway.ElasticHTTPVersion
+		cfg.AccessLogSidecar = esGateway.AccessLogSidecar
+		cfg.RevisionHistoryLimit = esGateway.RevisionHistoryLimit
+		cfg.MetricsPort = esGateway.MetricsPort
+		cfg.MaxRequestBodySize = esGateway.MaxRequestBodySize
+		cfg.ServiceAccountAnnotations = esGateway.ServiceAccountAnnotations
+
+		if esGateway.ConfigMapName != "" {
+			customConfig := &corev1.ConfigMap{}
+			key := types.NamespacedName{Name: esGateway.ConfigMapName, Namespace: common.OperatorNamespace()}
+			if err = r.client.Get(ctx, key, customConfig); err != nil {
+				if errors.IsNotFound(err) {
+					r.status.SetDegraded(operatorv1.ResourceNotFound, fmt.Sprintf("ES Gateway config ConfigMap %q not found", esGateway.ConfigMapName), err, reqLogger)
+				} else {
+					r.status.SetDegraded(operatorv1.ResourceReadError, fmt.Sprintf("Failed to read ES Gateway config ConfigMap %q", esGateway.ConfigMapName), err, reqLogger)
+				}
+				return err
+			}
+			if _, ok := customConfig.Data[esgateway.ConfigFileKey]; !ok {
+				err = fmt.Errorf("ES Gateway config ConfigMap %q does not contain the key %q", esGateway.ConfigMapName, esgateway.ConfigFileKey)
+				r.status.SetDegraded(operatorv1.ResourceValidationError, err.Error(), err, reqLogger)
+				return err
+			}
+			cfg.CustomConfig = customConfig
+		}
 	}
 
 	esGatewayComponent := esgateway.EsGateway(cfg)
@@ -64,6 +64,7 @@ type LinseedSubController struct {
 	scheme          *runtime.Scheme
 	status          status.StatusManager
 	clusterDomain   string
+	auditLog        bool
 	tierWatchReady  *utils.ReadyFlag
 	dpiAPIReady     *utils.ReadyFlag
 	usePSP          bool
@@ -81,6 +82,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		tierWatchReady:  &utils.ReadyFlag{},
 		dpiAPIReady:     &utils.ReadyFlag{},
 		multiTenant:     opts.MultiTenant,
@@ -447,9 +449,9 @@ func (r *LinseedSubController) Reconcile(ctx context.Context, request reconcile.
 	// In standard installs, the LogStorage owns Linseed. For multi-tenant, it's owned by the Tenant instance.
 	var hdler utils.ComponentHandler
 	if r.multiTenant {
-		hdler = utils.NewComponentHandler(reqLogger, r.client, r.scheme, tenant)
+		hdler = utils.NewComponentHandler(reqLogger, r.client, r.scheme, tenant, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, "log-storage-access-controller"))
 	} else {
-		hdler = utils.NewComponentHandler(reqLogger, r.client, r.scheme, logStorage)
+		hdler = utils.NewComponentHandler(reqLogger, r.client, r.scheme, logStorage, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, "log-storage-access-controller"))
 	}
 	if err := hdler.CreateOrUpdateOrDelete(ctx, linseedComponent, r.status); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating / deleting resource", err, reqLogger)
@@ -44,6 +44,7 @@ type LogStorageManagedClusterController struct {
 	scheme        *runtime.Scheme
 	provider      operatorv1.Provider
 	clusterDomain string
+	auditLog      bool
 }
 
 func Add(mgr manager.Manager, opts options.AddOptions) error {
@@ -56,6 +57,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		client:        mgr.GetClient(),
 		scheme:        mgr.GetScheme(),
 		clusterDomain: opts.ClusterDomain,
+		auditLog:      opts.AuditLog,
 		provider:      opts.DetectedProvider,
 	}
 
@@ -139,7 +141,7 @@ func (r *LogStorageManagedClusterController) Reconcile(ctx context.Context, requ
 		Installation:  install,
 	}
 	component := render.NewManagedClusterLogStorage(cfg)
-	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, managementClusterConnection)
+	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, managementClusterConnection, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, "log-storage-managedcluster-controller"))
 	if err := hdler.CreateOrUpdateOrDelete(ctx, component, nil); err != nil {
 		return reconcile.Result{}, err
 	}
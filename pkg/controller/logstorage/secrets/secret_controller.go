@@ -60,6 +60,7 @@ type SecretSubController struct {
 	scheme          *runtime.Scheme
 	status          status.StatusManager
 	clusterDomain   string
+	auditLog        bool
 	multiTenant     bool
 	elasticExternal bool
 }
@@ -74,6 +75,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		multiTenant:     opts.MultiTenant,
 		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageSecrets, opts.KubernetesVersion),
 		elasticExternal: opts.ElasticExternal,
@@ -154,6 +156,15 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	if err := utils.AddServiceWatch(c, render.LinseedServiceName, helper.InstallNamespace()); err != nil {
 		return fmt.Errorf("log-storage-secrets-controller failed to watch Service: %w", err)
 	}
+	if opts.ElasticExternal {
+		// The public certificates of the external Elasticsearch and Kibana instances are included in the trusted bundle.
+		// Watch them so that a rotated CA is added to the bundle, and the components trusting it are rolled, right away.
+		for _, name := range []string{logstorage.ExternalESPublicCertName, logstorage.ExternalKBPublicCertName} {
+			if err = utils.AddSecretsWatchWithHandler(c, name, common.OperatorNamespace(), eventHandler); err != nil {
+				return fmt.Errorf("log-storage-secrets-controller failed to watch Secret: %w", err)
+			}
+		}
+	}
 	if opts.MultiTenant {
 		if err = utils.AddSecretsWatch(c, certificatemanagement.TenantCASecretName, ""); err != nil {
 			return fmt.Errorf("log-storage-secrets-controller failed to watch Secret: %w", err)
@@ -258,7 +269,7 @@ func (r *SecretSubController) Reconcile(ctx context.Context, request reconcile.R
 	operatorSigner.AddToStatusManager(r.status, render.ElasticsearchNamespace)
 
 	// Provision secrets and the trusted bundle into the cluster.
-	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, ls)
+	hdler := utils.NewComponentHandler(reqLogger, r.client, r.scheme, ls, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, "log-storage-secrets-controller"))
 
 	// Determine if Kibana should be enabled for this cluster.
 	kibanaEnabled := !operatorv1.IsFIPSModeEnabled(install.FIPSMode) && !r.multiTenant
@@ -554,6 +554,68 @@ var _ = Describe("LogStorage Secrets controller", func() {
 		Expect(len(secrets.Items)).To(Equal(1))
 	})
 
+	Context("External Elasticsearch", func() {
+		var r *SecretSubController
+
+		BeforeEach(func() {
+			ls := &operatorv1.LogStorage{}
+			ls.Name = "tigera-secure"
+			ls.Status.State = operatorv1.TigeraStatusReady
+			CreateLogStorage(cli, ls)
+
+			var err error
+			r, err = NewSecretControllerWithShims(cli, scheme, mockStatus, operatorv1.ProviderNone, dns.DefaultClusterDomain)
+			Expect(err).ShouldNot(HaveOccurred())
+			r.elasticExternal = true
+		})
+
+		It("should update the trusted bundle when the external Elasticsearch CA is rotated", func() {
+			externalESSecret := rtest.CreateCertSecret(logstorage.ExternalESPublicCertName, common.OperatorNamespace(), "external.es.com")
+			Expect(cli.Create(ctx, externalESSecret)).ShouldNot(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			bundle := &corev1.ConfigMap{}
+			bundleKey := types.NamespacedName{Name: certificatemanagement.TrustedCertConfigMapName, Namespace: render.ElasticsearchNamespace}
+			Expect(cli.Get(ctx, bundleKey, bundle)).ShouldNot(HaveOccurred())
+			rtest.ExpectBundleContents(bundle,
+				types.NamespacedName{Name: certificatemanagement.CASecretName, Namespace: common.OperatorNamespace()},
+				types.NamespacedName{Name: logstorage.ExternalESPublicCertName, Namespace: common.OperatorNamespace()},
+			)
+			oldContents := bundle.Data["tigera-ca-bundle.crt"]
+			oldAnnotations := bundle.Annotations
+
+			// Rotate the external Elasticsearch CA.
+			rotated := rtest.CreateCertSecret(logstorage.ExternalESPublicCertName, common.OperatorNamespace(), "external.es.com")
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(externalESSecret), externalESSecret)).ShouldNot(HaveOccurred())
+			externalESSecret.Data = rotated.Data
+			Expect(cli.Update(ctx, externalESSecret)).ShouldNot(HaveOccurred())
+
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			// The bundle should contain the new CA, and its hash annotations, which es-gateway and Linseed
+			// copy onto their pod templates, should have changed so that they roll.
+			Expect(cli.Get(ctx, bundleKey, bundle)).ShouldNot(HaveOccurred())
+			Expect(bundle.Data["tigera-ca-bundle.crt"]).NotTo(Equal(oldContents))
+			Expect(bundle.Data["tigera-ca-bundle.crt"]).To(ContainSubstring(string(rotated.Data[corev1.TLSCertKey])))
+			Expect(bundle.Annotations).NotTo(Equal(oldAnnotations))
+		})
+
+		It("should return an error when the external Elasticsearch secret has no certificate", func() {
+			Expect(cli.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: logstorage.ExternalESPublicCertName, Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{"foo": []byte("bar")},
+			})).ShouldNot(HaveOccurred())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).Should(HaveOccurred())
+			Expect(err.Error()).Should(ContainSubstring("certificate PEM is missing"))
+			mockStatus.AssertCalled(GinkgoT(), "SetDegraded", operatorv1.ResourceReadError, "Failed to get certificate", mock.Anything, mock.Anything)
+		})
+	})
+
 	Context("Multi-tenant secret rendering", func() {
 		var tenant *operatorv1.Tenant
 		tenantNS := "tenant-a-ns"
@@ -59,6 +59,7 @@ type UserController struct {
 	esClientFn      utils.ElasticsearchClientCreator
 	multiTenant     bool
 	elasticExternal bool
+	auditLog        bool
 }
 
 type UsersCleanupController struct {
@@ -86,6 +87,7 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 		status:          status.New(mgr.GetClient(), initializer.TigeraStatusLogStorageUsers, opts.KubernetesVersion),
 		esClientFn:      utils.NewElasticClient,
 		elasticExternal: opts.ElasticExternal,
+		auditLog:        opts.AuditLog,
 	}
 	r.status.Run(opts.ShutdownContext)
 
@@ -286,9 +288,9 @@ func (r *UserController) Reconcile(ctx context.Context, request reconcile.Reques
 	// In standard installs, the LogStorage owns the secret. For multi-tenant, it's owned by the tenant.
 	var hdler utils.ComponentHandler
 	if r.multiTenant {
-		hdler = utils.NewComponentHandler(reqLogger, r.client, r.scheme, tenant)
+		hdler = utils.NewComponentHandler(reqLogger, r.client, r.scheme, tenant, utils.WithAuditLog(r.auditLog, "log-storage-user-controller"))
 	} else {
-		hdler = utils.NewComponentHandler(reqLogger, r.client, r.scheme, logStorage)
+		hdler = utils.NewComponentHandler(reqLogger, r.client, r.scheme, logStorage, utils.WithAuditLog(r.auditLog, "log-storage-user-controller"))
 	}
 	if err = hdler.CreateOrUpdateOrDelete(ctx, credentialComponent, r.status); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating Linseed user secret", err, reqLogger)
@@ -138,6 +138,9 @@ func Add(mgr manager.Manager, opts options.AddOptions) error {
 	if err = c.WatchObject(&operatorv1.Authentication{}, eventHandler); err != nil {
 		return fmt.Errorf("manager-controller failed to watch resource: %w", err)
 	}
+	if err = utils.AddExternalDexRootCAWatch(c, mgr.GetClient(), eventHandler); err != nil {
+		return fmt.Errorf("manager-controller failed to watch the external Dex CA secret: %w", err)
+	}
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("manager-controller failed to watch manager Tigerastatus: %w", err)
 	}
@@ -198,6 +201,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, licenseAPIReady
 		provider:        opts.DetectedProvider,
 		status:          status.New(mgr.GetClient(), "manager", opts.KubernetesVersion),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		licenseAPIReady: licenseAPIReady,
 		tierWatchReady:  tierWatchReady,
 		usePSP:          opts.UsePSP,
@@ -219,6 +223,7 @@ type ReconcileManager struct {
 	provider        operatorv1.Provider
 	status          status.StatusManager
 	clusterDomain   string
+	auditLog        bool
 	licenseAPIReady *utils.ReadyFlag
 	tierWatchReady  *utils.ReadyFlag
 	usePSP          bool
@@ -627,7 +632,7 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 	}
 
 	// Create a component handler to manage the rendered component.
-	componentHandler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
+	componentHandler := utils.NewComponentHandler(log, r.client, r.scheme, instance, utils.WithInstallation(installation), utils.WithAuditLog(r.auditLog, "manager-controller"))
 
 	// Set replicas to 1 for management or managed clusters.
 	// TODO Remove after MCM tigera-manager HA deployment is supported.
@@ -670,6 +675,7 @@ func (r *ReconcileManager) Reconcile(ctx context.Context, request reconcile.Requ
 	managerCfg := &render.ManagerConfiguration{
 		VoltronRouteConfig:      routeConfig,
 		KeyValidatorConfig:      keyValidatorConfig,
+		Authentication:          authenticationCR,
 		ESSecrets:               esSecrets,
 		TrustedCertBundle:       trustedBundle,
 		ClusterConfig:           clusterConfig,
@@ -109,6 +109,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, prometheusReady
 		prometheusReady: prometheusReady,
 		tierWatchReady:  tierWatchReady,
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		usePSP:          opts.UsePSP,
 		multiTenant:     opts.MultiTenant,
 	}
@@ -122,7 +123,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions, prometheusReady
 	return r
 }
 
-func add(_ manager.Manager, c ctrlruntime.Controller) error {
+func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 	var err error
 
 	// watch for primary resource changes
@@ -169,6 +170,10 @@ func add(_ manager.Manager, c ctrlruntime.Controller) error {
 		return fmt.Errorf("monitor-controller failed to watch resource: %w", err)
 	}
 
+	if err = utils.AddExternalDexRootCAWatch(c, mgr.GetClient(), &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("monitor-controller failed to watch the external Dex CA secret: %w", err)
+	}
+
 	// Watch for changes to TigeraStatus.
 	if err = utils.AddTigeraStatusWatch(c, ResourceName); err != nil {
 		return fmt.Errorf("monitor-controller failed to watch monitor Tigerastatus: %w", err)
@@ -188,6 +193,7 @@ type ReconcileMonitor struct {
 	prometheusReady *utils.ReadyFlag
 	tierWatchReady  *utils.ReadyFlag
 	clusterDomain   string
+	auditLog        bool
 	usePSP          bool
 	multiTenant     bool
 }
@@ -354,7 +360,7 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 	}
 
 	// Create a component handler to manage the rendered component.
-	hdler := utils.NewComponentHandler(log, r.client, r.scheme, instance)
+	hdler := utils.NewComponentHandler(log, r.client, r.scheme, instance, utils.WithInstallation(install), utils.WithAuditLog(r.auditLog, "monitor-controller"))
 
 	alertmanagerConfigSecret, createInOperatorNamespace, err := r.readAlertmanagerConfigSecret(ctx)
 	if err != nil {
@@ -374,6 +380,7 @@ func (r *ReconcileMonitor) Reconcile(ctx context.Context, request reconcile.Requ
 		PullSecrets:              pullSecrets,
 		AlertmanagerConfigSecret: alertmanagerConfigSecret,
 		KeyValidatorConfig:       keyValidatorConfig,
+		Authentication:           authenticationCR,
 		ServerTLSSecret:          serverTLSSecret,
 		ClientTLSSecret:          clientTLSSecret,
 		ClusterDomain:            r.clusterDomain,
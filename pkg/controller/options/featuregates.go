@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FeatureGate is the name of an experimental feature that can be toggled cluster-wide.
+type FeatureGate string
+
+const (
+	// FeatureGateSidecarAcceleration enables Felix's experimental sidecar acceleration
+	// in the default FelixConfiguration, unless it has been explicitly configured.
+	FeatureGateSidecarAcceleration FeatureGate = "SidecarAcceleration"
+)
+
+// knownFeatureGates maps each supported feature gate to its default value.
+var knownFeatureGates = map[FeatureGate]bool{
+	FeatureGateSidecarAcceleration: false,
+}
+
+// FeatureGates holds the feature gates that have been explicitly set.
+type FeatureGates map[FeatureGate]bool
+
+// Enabled returns whether the given feature gate is enabled, falling back to its default value
+// if it has not been explicitly set.
+func (f FeatureGates) Enabled(gate FeatureGate) bool {
+	if enabled, ok := f[gate]; ok {
+		return enabled
+	}
+	return knownFeatureGates[gate]
+}
+
+// ParseFeatureGates parses a comma separated list of <gate>=<bool> pairs, for example
+// "SidecarAcceleration=true". Unknown gates and malformed values result in an error.
+func ParseFeatureGates(value string) (FeatureGates, error) {
+	gates := FeatureGates{}
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		kv := strings.SplitN(s, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("feature gate %q must be of the form <gate>=<bool>", s)
+		}
+		gate := FeatureGate(strings.TrimSpace(kv[0]))
+		if _, ok := knownFeatureGates[gate]; !ok {
+			return nil, fmt.Errorf("unknown feature gate %q", gate)
+		}
+		enabled, err := strconv.ParseBool(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for feature gate %q: %w", gate, err)
+		}
+		gates[gate] = enabled
+	}
+	return gates, nil
+}
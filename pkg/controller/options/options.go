@@ -45,4 +45,20 @@ type AddOptions struct {
 
 	// Whether or not the cluster supports PodSecurityPolicies.
 	UsePSP bool
+
+	// FeatureGates are the cluster-wide toggles for experimental features, as
+	// loaded from the operator's bootstrap configmap.
+	FeatureGates FeatureGates
+
+	// ExpectedNodeCIDRs are the CIDRs that the cluster's pod addresses are expected to be allocated from, for
+	// example the VPC or subnet allocation in a cloud environment. When set, IP pools that fall outside of these
+	// CIDRs are reported as invalid.
+	ExpectedNodeCIDRs []string
+
+	// RequireConsistentNATOutgoing causes IP pools of the same address family with different natOutgoing
+	// settings to be reported as invalid. Otherwise, such pools are only logged as a warning.
+	RequireConsistentNATOutgoing bool
+
+	// AuditLog causes each controller to log an audit entry for every object it creates, changes or deletes.
+	AuditLog bool
 }
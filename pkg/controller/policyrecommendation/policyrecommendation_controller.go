@@ -162,6 +162,7 @@ func newReconciler(
 		provider:                 opts.DetectedProvider,
 		status:                   status.New(mgr.GetClient(), "policy-recommendation", opts.KubernetesVersion),
 		clusterDomain:            opts.ClusterDomain,
+		auditLog:                 opts.AuditLog,
 		licenseAPIReady:          licenseAPIReady,
 		tierWatchReady:           tierWatchReady,
 		policyRecScopeWatchReady: policyRecScopeWatchReady,
@@ -184,6 +185,7 @@ type ReconcilePolicyRecommendation struct {
 	// that reads objects from the cache and writes to the apiserver
 	client                   client.Client
 	clusterDomain            string
+	auditLog                 bool
 	licenseAPIReady          *utils.ReadyFlag
 	scheme                   *runtime.Scheme
 	status                   status.StatusManager
@@ -338,7 +340,7 @@ func (r *ReconcilePolicyRecommendation) Reconcile(ctx context.Context, request r
 	}
 
 	// Create a component handler to manage the rendered component.
-	handler := utils.NewComponentHandler(log, r.client, r.scheme, policyRecommendation)
+	handler := utils.NewComponentHandler(log, r.client, r.scheme, policyRecommendation, utils.WithInstallation(installation), utils.WithAuditLog(r.auditLog, PolicyRecommendationControllerName))
 
 	// Determine the namespaces to which we must bind the cluster role.
 	// For multi-tenant, the cluster role will be bind to the service account in the tenant namespace
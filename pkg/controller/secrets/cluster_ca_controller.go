@@ -43,6 +43,7 @@ type ClusterCAController struct {
 	client        client.Client
 	scheme        *runtime.Scheme
 	clusterDomain string
+	auditLog      bool
 	log           logr.Logger
 }
 
@@ -51,6 +52,7 @@ func AddClusterCAController(mgr manager.Manager, opts options.AddOptions) error
 		client:        mgr.GetClient(),
 		scheme:        mgr.GetScheme(),
 		clusterDomain: opts.ClusterDomain,
+		auditLog:      opts.AuditLog,
 		log:           logf.Log.WithName("controller_cluster_ca"),
 	}
 
@@ -108,7 +110,7 @@ func (r *ClusterCAController) Reconcile(ctx context.Context, request reconcile.R
 		KeyPairOptions: []rcertificatemanagement.KeyPairOption{rcertificatemanagement.NewKeyPairOption(cm.KeyPair(), true, false)},
 	})
 
-	hdler := utils.NewComponentHandler(logc, r.client, r.scheme, instance)
+	hdler := utils.NewComponentHandler(logc, r.client, r.scheme, instance, utils.WithAuditLog(r.auditLog, "cluster-ca-controller"))
 	if err = hdler.CreateOrUpdateOrDelete(ctx, component, nil); err != nil {
 		return reconcile.Result{}, err
 	}
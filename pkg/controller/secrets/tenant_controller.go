@@ -50,6 +50,7 @@ type TenantController struct {
 	scheme          *runtime.Scheme
 	status          status.StatusManager
 	clusterDomain   string
+	auditLog        bool
 	log             logr.Logger
 	elasticExternal bool
 }
@@ -63,6 +64,7 @@ func AddTenantController(mgr manager.Manager, opts options.AddOptions) error {
 		client:          mgr.GetClient(),
 		scheme:          mgr.GetScheme(),
 		clusterDomain:   opts.ClusterDomain,
+		auditLog:        opts.AuditLog,
 		elasticExternal: opts.ElasticExternal,
 		status:          status.New(mgr.GetClient(), "secrets", opts.KubernetesVersion),
 		log:             logf.Log.WithName("controller_tenant_secrets"),
@@ -207,7 +209,7 @@ func (r *TenantController) Reconcile(ctx context.Context, request reconcile.Requ
 		TrustedBundle:  trustedBundleWithSystemCAs,
 	})
 
-	hdler := utils.NewComponentHandler(logc, r.client, r.scheme, tenant)
+	hdler := utils.NewComponentHandler(logc, r.client, r.scheme, tenant, utils.WithInstallation(installation), utils.WithAuditLog(r.auditLog, "tenant-secrets-controller"))
 	if err = hdler.CreateOrUpdateOrDelete(ctx, component, r.status); err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, logc)
 		return reconcile.Result{}, err
@@ -95,6 +95,7 @@ func newReconciler(mgr manager.Manager, opts options.AddOptions) reconcile.Recon
 		provider:    opts.DetectedProvider,
 		status:      status.New(mgr.GetClient(), "tiers", opts.KubernetesVersion),
 		multiTenant: opts.MultiTenant,
+		auditLog:    opts.AuditLog,
 	}
 	r.status.Run(opts.ShutdownContext)
 	return r
@@ -110,6 +111,7 @@ type ReconcileTiers struct {
 	tierWatchReady     *utils.ReadyFlag
 	policyWatchesReady *utils.ReadyFlag
 	multiTenant        bool
+	auditLog           bool
 }
 
 // add adds watches for resources that are available at startup.
@@ -126,6 +128,10 @@ func add(mgr manager.Manager, c ctrlruntime.Controller) error {
 		return fmt.Errorf("tiers-controller failed to watch node-local-dns daemonset: %v", err)
 	}
 
+	if err := c.WatchObject(&operatorv1.Authentication{}, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("tiers-controller failed to watch Authentication resource: %v", err)
+	}
+
 	return nil
 }
 
@@ -156,14 +162,25 @@ func (r *ReconcileTiers) Reconcile(ctx context.Context, request reconcile.Reques
 		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
 	}
 
+	apiServer, _, err := utils.GetAPIServer(ctx, r.client)
+	if err != nil {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying APIServer", err, reqLogger)
+		return reconcile.Result{RequeueAfter: utils.StandardRetry}, nil
+	}
+	if err = validateUserWorkloadTier(apiServer.Spec.UserWorkloadTier); err != nil {
+		r.status.SetDegraded(operatorv1.ResourceValidationError, "Invalid APIServer user workload tier", err, reqLogger)
+		return reconcile.Result{}, nil
+	}
+
 	tiersConfig, reconcileResult := r.prepareTiersConfig(ctx, reqLogger)
 	if reconcileResult != nil {
 		return *reconcileResult, nil
 	}
+	tiersConfig.UserWorkloadTier = apiServer.Spec.UserWorkloadTier
 
 	component := tiers.Tiers(tiersConfig)
 
-	componentHandler := utils.NewComponentHandler(log, r.client, r.scheme, nil)
+	componentHandler := utils.NewComponentHandler(log, r.client, r.scheme, nil, utils.WithAuditLog(r.auditLog, "tiers-controller"))
 	err = componentHandler.CreateOrUpdateOrDelete(ctx, component, nil)
 	if err != nil {
 		r.status.SetDegraded(operatorv1.ResourceUpdateError, "Error creating / updating resource", err, reqLogger)
@@ -181,13 +198,20 @@ func (r *ReconcileTiers) prepareTiersConfig(ctx context.Context, reqLogger logr.
 		DNSEgressCIDRs: tiers.DNSEgressCIDR{},
 	}
 
+	// The Dex namespace is configurable on the Authentication.
+	authentication, err := utils.GetAuthentication(ctx, r.client)
+	if err != nil && !apierrors.IsNotFound(err) {
+		r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying Authentication", err, reqLogger)
+		return nil, &reconcile.Result{RequeueAfter: utils.StandardRetry}
+	}
+
 	// Determine the namespaces that should be allowed to access the DNS service. For single tenant clusters, this is a
 	// well-known list of namespaces that contain product code.
 	namespaces := []string{
 		common.CalicoNamespace,
 		render.GuardianNamespace,
 		render.ComplianceNamespace,
-		render.DexNamespace,
+		render.GetDexNamespace(authentication),
 		render.ElasticsearchNamespace,
 		render.LogCollectorNamespace,
 		render.IntrusionDetectionNamespace,
@@ -213,11 +237,16 @@ func (r *ReconcileTiers) prepareTiersConfig(ctx context.Context, reqLogger logr.
 
 	// node-local-dns is not supported on openshift
 	if r.provider != operatorv1.ProviderOpenShift {
+		_, installation, err := utils.GetInstallation(ctx, r.client)
+		if err != nil {
+			r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying installation", err, reqLogger)
+			return nil, &reconcile.Result{RequeueAfter: utils.StandardRetry}
+		}
 		nodeLocalDNSExists, err := utils.IsNodeLocalDNSAvailable(ctx, r.client)
 		if err != nil {
 			r.status.SetDegraded(operatorv1.ResourceReadError, "Error querying node-local-dns pods", err, reqLogger)
 			return nil, &reconcile.Result{RequeueAfter: utils.StandardRetry}
-		} else if nodeLocalDNSExists {
+		} else if nodeLocalDNSExists || installation.NodeLocalDNSCache != nil {
 			dnsServiceIPs, err := utils.GetDNSServiceIPs(ctx, r.client, r.provider)
 			if err != nil {
 				if apierrors.IsNotFound(err) {
@@ -230,15 +259,7 @@ func (r *ReconcileTiers) prepareTiersConfig(ctx context.Context, reqLogger logr.
 
 			if len(dnsServiceIPs) > 0 {
 				for _, IP := range dnsServiceIPs {
-					var builder strings.Builder
-					builder.WriteString(IP)
-					if net.ParseIP(IP).To4() != nil {
-						builder.WriteString("/32")
-						tiersConfig.DNSEgressCIDRs.IPV4 = append(tiersConfig.DNSEgressCIDRs.IPV4, builder.String())
-					} else {
-						builder.WriteString("/128")
-						tiersConfig.DNSEgressCIDRs.IPV6 = append(tiersConfig.DNSEgressCIDRs.IPV6, builder.String())
-					}
+					addDNSEgressCIDR(&tiersConfig.DNSEgressCIDRs, IP)
 				}
 			} else {
 				r.status.SetDegraded(operatorv1.ResourceReadError,
@@ -247,8 +268,45 @@ func (r *ReconcileTiers) prepareTiersConfig(ctx context.Context, reqLogger logr.
 					reqLogger)
 			}
 
+			// The cache also listens on its own link-local address, which is the only one it listens on when
+			// kube-proxy runs in IPVS mode.
+			if c := installation.NodeLocalDNSCache; c != nil && c.LocalIP != "" {
+				addDNSEgressCIDR(&tiersConfig.DNSEgressCIDRs, c.LocalIP)
+			}
 		}
 	}
 
 	return &tiersConfig, nil
 }
+
+// addDNSEgressCIDR adds the host CIDR of the given IP address to the IPv4 or IPv6 DNS egress CIDRs.
+func addDNSEgressCIDR(cidrs *tiers.DNSEgressCIDR, IP string) {
+	var builder strings.Builder
+	builder.WriteString(IP)
+	if net.ParseIP(IP).To4() != nil {
+		builder.WriteString("/32")
+		cidrs.IPV4 = append(cidrs.IPV4, builder.String())
+	} else {
+		builder.WriteString("/128")
+		cidrs.IPV6 = append(cidrs.IPV6, builder.String())
+	}
+}
+
+// validateUserWorkloadTier checks that the configured user workload tier, if any, does not conflict with the
+// tiers that are managed elsewhere.
+func validateUserWorkloadTier(tier *operatorv1.UserWorkloadTier) error {
+	if tier == nil {
+		return nil
+	}
+	switch tier.Name {
+	case "":
+		return fmt.Errorf("APIServer spec.userWorkloadTier.name must be specified")
+	case networkpolicy.TigeraComponentTierName, "default":
+		return fmt.Errorf("APIServer spec.userWorkloadTier.name must not be %q", tier.Name)
+	}
+	if float64(tier.Order) <= tiers.AllowTigeraTierOrder {
+		return fmt.Errorf("APIServer spec.userWorkloadTier.order must be greater than %v, the order of the %s tier",
+			tiers.AllowTigeraTierOrder, networkpolicy.TigeraComponentTierName)
+	}
+	return nil
+}
@@ -16,12 +16,15 @@ package tiers
 
 import (
 	"context"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -128,6 +131,28 @@ var _ = Describe("tier controller tests", func() {
 		Expect(c.Get(ctx, client.ObjectKey{Name: "allow-tigera"}, &tier)).To(BeNil())
 	})
 
+	It("allows DNS egress to a configured NodeLocal DNSCache local IP", func() {
+		installation := &operatorv1.Installation{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "default"}, installation)).NotTo(HaveOccurred())
+		installation.Spec.NodeLocalDNSCache = &operatorv1.NodeLocalDNSCache{LocalIP: "169.254.20.10"}
+		Expect(c.Update(ctx, installation)).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-dns", Namespace: "kube-system"},
+			Spec:       corev1.ServiceSpec{ClusterIP: "10.96.0.10", ClusterIPs: []string{"10.96.0.10", "fd00::a"}},
+		})).NotTo(HaveOccurred())
+		mockStatus.On("ReadyToMonitor")
+		mockStatus.On("ClearDegraded")
+
+		_, err := r.Reconcile(ctx, reconcile.Request{})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		policy := v3.GlobalNetworkPolicy{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "allow-tigera.node-local-dns"}, &policy)).NotTo(HaveOccurred())
+		Expect(policy.Spec.Egress).To(HaveLen(2))
+		Expect(policy.Spec.Egress[0].Destination.Nets).To(ConsistOf("10.96.0.10/32", "169.254.20.10/32"))
+		Expect(policy.Spec.Egress[1].Destination.Nets).To(ConsistOf("fd00::a/128"))
+	})
+
 	It("waits for API server to be available before reconciling", func() {
 		err := c.Delete(ctx, &operatorv1.APIServer{ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"}})
 		Expect(err).ShouldNot(HaveOccurred())
@@ -193,4 +218,65 @@ var _ = Describe("tier controller tests", func() {
 		Expect(err).ShouldNot(HaveOccurred())
 		mockStatus.AssertExpectations(GinkgoT())
 	})
+	Context("user workload tier", func() {
+		setUserWorkloadTier := func(tier *operatorv1.UserWorkloadTier) {
+			apiServer := &operatorv1.APIServer{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "tigera-secure"}, apiServer)).NotTo(HaveOccurred())
+			apiServer.Spec.UserWorkloadTier = tier
+			Expect(c.Update(ctx, apiServer)).NotTo(HaveOccurred())
+			apiServer.Status.State = operatorv1.TigeraStatusReady
+			Expect(c.Status().Update(ctx, apiServer)).NotTo(HaveOccurred())
+		}
+
+		It("reconciles the user workload tier with the configured order", func() {
+			setUserWorkloadTier(&operatorv1.UserWorkloadTier{Name: "workloads", Order: 200})
+			mockStatus.On("ReadyToMonitor")
+			mockStatus.On("ClearDegraded")
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tier := v3.Tier{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "workloads"}, &tier)).NotTo(HaveOccurred())
+			Expect(*tier.Spec.Order).To(Equal(200.0))
+			Expect(c.Get(ctx, client.ObjectKey{Name: "allow-tigera"}, &tier)).NotTo(HaveOccurred())
+		})
+
+		It("updates the order of the user workload tier", func() {
+			setUserWorkloadTier(&operatorv1.UserWorkloadTier{Name: "workloads", Order: 200})
+			mockStatus.On("ReadyToMonitor")
+			mockStatus.On("ClearDegraded")
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			setUserWorkloadTier(&operatorv1.UserWorkloadTier{Name: "workloads", Order: 300})
+			_, err = r.Reconcile(ctx, reconcile.Request{})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			tier := v3.Tier{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "workloads"}, &tier)).NotTo(HaveOccurred())
+			Expect(*tier.Spec.Order).To(Equal(300.0))
+		})
+
+		DescribeTable("rejects a user workload tier that conflicts with operator managed tiers",
+			func(tier *operatorv1.UserWorkloadTier, expectedErr string) {
+				setUserWorkloadTier(tier)
+				mockStatus.On("SetDegraded", operatorv1.ResourceValidationError, "Invalid APIServer user workload tier",
+					mock.MatchedBy(func(msg string) bool { return strings.Contains(msg, expectedErr) }), mock.Anything).Return()
+
+				_, err := r.Reconcile(ctx, reconcile.Request{})
+				Expect(err).ShouldNot(HaveOccurred())
+				mockStatus.AssertExpectations(GinkgoT())
+
+				tierList := v3.TierList{}
+				Expect(c.List(ctx, &tierList)).NotTo(HaveOccurred())
+				Expect(tierList.Items).To(BeEmpty())
+			},
+			Entry("allow-tigera name", &operatorv1.UserWorkloadTier{Name: "allow-tigera", Order: 200}, `must not be "allow-tigera"`),
+			Entry("default name", &operatorv1.UserWorkloadTier{Name: "default", Order: 200}, `must not be "default"`),
+			Entry("empty name", &operatorv1.UserWorkloadTier{Order: 200}, "name must be specified"),
+			Entry("order equal to allow-tigera", &operatorv1.UserWorkloadTier{Name: "workloads", Order: 100}, "order must be greater than 100"),
+			Entry("order before allow-tigera", &operatorv1.UserWorkloadTier{Name: "workloads", Order: 50}, "order must be greater than 100"),
+		)
+	})
 })
@@ -29,16 +29,38 @@ import (
 	rauth "github.com/tigera/operator/pkg/render/common/authentication"
 	tigerakvc "github.com/tigera/operator/pkg/render/common/authentication/tigera/key_validator_config"
 
+	"github.com/tigera/operator/pkg/ctrlruntime"
+
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
 // GetKeyValidatorConfig uses the operatorv1.Authentication CR given to create the KeyValidatorConfig. This may be
 // either a DexKeyValidatorConfig or a tigerakvc.KeyValidatorConfig.
 func GetKeyValidatorConfig(ctx context.Context, cli client.Client, authenticationCR *operatorv1.Authentication, clusterDomain string) (rauth.KeyValidatorConfig, error) {
 	var keyValidatorConfig rauth.KeyValidatorConfig
-	if authenticationCR != nil {
+	if authenticationCR != nil && authenticationCR.Spec.ExternalDex != nil {
+		rootCA, err := GetExternalDexRootCA(ctx, cli, authenticationCR)
+		if err != nil {
+			return nil, err
+		}
+
+		var kvcOptions []tigerakvc.Option
+		if authenticationCR.Spec.UsernamePrefix != "" {
+			kvcOptions = append(kvcOptions, tigerakvc.WithUsernamePrefix(authenticationCR.Spec.UsernamePrefix))
+		}
+		if authenticationCR.Spec.GroupsPrefix != "" {
+			kvcOptions = append(kvcOptions, tigerakvc.WithGroupsPrefix(authenticationCR.Spec.GroupsPrefix))
+		}
+		if len(rootCA) > 0 {
+			kvcOptions = append(kvcOptions, tigerakvc.WithRootCA(rootCA))
+		}
+		return tigerakvc.New(authenticationCR.Spec.ExternalDex.IssuerURL, render.DexClientId, kvcOptions...)
+	} else if authenticationCR != nil {
 		idpSecret, err := GetIDPSecret(ctx, cli, authenticationCR)
 		if err != nil {
 			return nil, err
@@ -76,6 +98,49 @@ func GetKeyValidatorConfig(ctx context.Context, cli client.Client, authenticatio
 	return keyValidatorConfig, nil
 }
 
+// GetExternalDexRootCA retrieves the CA certificate of the external Dex configured in the given
+// operatorv1.Authentication CR. It returns nil if no CA secret is configured.
+func GetExternalDexRootCA(ctx context.Context, client client.Client, authentication *operatorv1.Authentication) ([]byte, error) {
+	secretName := authentication.Spec.ExternalDex.CASecretName
+	if secretName == "" {
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: common.OperatorNamespace()}, secret); err != nil {
+		return nil, fmt.Errorf("missing secret %s/%s: %w", common.OperatorNamespace(), secretName, err)
+	}
+	rootCA := secret.Data[render.RootCASecretField]
+	if len(rootCA) == 0 {
+		return nil, fmt.Errorf("%s is a required field for secret %s/%s", render.RootCASecretField, secret.Namespace, secret.Name)
+	}
+	if _, err := certificatemanagement.ParseCertificate(rootCA); err != nil {
+		return nil, fmt.Errorf("secret %s/%s should have a valid certificate for field %s", secret.Namespace, secret.Name, render.RootCASecretField)
+	}
+	return rootCA, nil
+}
+
+// AddExternalDexRootCAWatch adds a watch for the secret holding the CA certificate of the external Dex. Its name is
+// configured on the Authentication CR, so secrets in the operator namespace are watched and filtered on that name.
+func AddExternalDexRootCAWatch(c ctrlruntime.Controller, cli client.Client, h handler.EventHandler) error {
+	return c.WatchObject(&corev1.Secret{}, h, predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetNamespace() == common.OperatorNamespace() && isExternalDexRootCASecret(cli, o.GetName())
+	}))
+}
+
+// isExternalDexRootCASecret returns whether the named secret in the operator namespace holds the CA certificate of
+// the external Dex configured on the Authentication CR.
+func isExternalDexRootCASecret(cli client.Client, name string) bool {
+	authentication, err := GetAuthentication(context.Background(), cli)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			log.Error(err, "Failed to query Authentication")
+		}
+		return false
+	}
+	return authentication.Spec.ExternalDex != nil && authentication.Spec.ExternalDex.CASecretName == name
+}
+
 // GetIDPSecret retrieves the Secret containing sensitive information for the configuration IdP specified in the given
 // operatorv1.Authentication CR.
 func GetIDPSecret(ctx context.Context, client client.Client, authentication *operatorv1.Authentication) (*corev1.Secret, error) {
@@ -25,6 +25,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/apis"
@@ -89,3 +93,39 @@ var _ = Describe("LDAP secrets tests", func() {
 		Entry("invalid rootCA", `CN=example,OU=finance",DC=com`, "tige\ra-secure", &invalidCert, true),
 	)
 })
+
+var _ = Describe("external Dex CA secret watch", func() {
+	It("should only match the CA secret configured on the Authentication", func() {
+		scheme := runtime.NewScheme()
+		Expect(operatorv1.SchemeBuilder.AddToScheme(scheme)).ShouldNot(HaveOccurred())
+		cli := ctrlrfake.DefaultFakeClientBuilder(scheme).Build()
+		Expect(cli.Create(context.Background(), &operatorv1.Authentication{
+			ObjectMeta: metav1.ObjectMeta{Name: "tigera-secure"},
+			Spec: operatorv1.AuthenticationSpec{
+				ExternalDex: &operatorv1.AuthenticationExternalDex{IssuerURL: "https://dex.example.com/dex", CASecretName: "dex-ca"},
+			},
+		})).NotTo(HaveOccurred())
+
+		c := &predicateController{}
+		Expect(utils.AddExternalDexRootCAWatch(c, cli, &handler.EnqueueRequestForObject{})).NotTo(HaveOccurred())
+		Expect(c.predicates).To(HaveLen(1))
+
+		matches := func(name, namespace string) bool {
+			return c.predicates[0].Create(event.CreateEvent{Object: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}})
+		}
+		Expect(matches("dex-ca", common.OperatorNamespace())).To(BeTrue())
+		Expect(matches("dex-ca", "other")).To(BeFalse())
+		Expect(matches("other-ca", common.OperatorNamespace())).To(BeFalse())
+	})
+})
+
+// predicateController records the predicates of the watches added to it.
+type predicateController struct {
+	controller.Controller
+	predicates []predicate.Predicate
+}
+
+func (c *predicateController) WatchObject(_ client.Object, _ handler.EventHandler, predicates ...predicate.Predicate) error {
+	c.predicates = append(c.predicates, predicates...)
+	return nil
+}
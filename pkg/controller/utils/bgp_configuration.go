@@ -0,0 +1,58 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func PatchBGPConfiguration(ctx context.Context, c client.Client, patchFn func(bc *crdv1.BGPConfiguration) (bool, error)) (*crdv1.BGPConfiguration, error) {
+	// Fetch any existing default BGPConfiguration object.
+	bc := &crdv1.BGPConfiguration{}
+	err := c.Get(ctx, types.NamespacedName{Name: "default"}, bc)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to read BGPConfiguration: %w", err)
+	}
+
+	// Create a base state for the upcoming patch operation.
+	patchFrom := client.MergeFrom(bc.DeepCopy())
+
+	// Apply desired changes to the BGPConfiguration.
+	updated, err := patchFn(bc)
+	if err != nil {
+		return nil, err
+	}
+	if updated {
+		// Apply the patch.
+		if bc.ResourceVersion == "" {
+			bc.ObjectMeta.Name = "default"
+			if err := c.Create(ctx, bc); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := c.Patch(ctx, bc, patchFrom); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return bc, nil
+}
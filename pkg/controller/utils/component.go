@@ -37,25 +37,58 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/controller/status"
 	"github.com/tigera/operator/pkg/render"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 )
 
+const (
+	auditOperationCreate = "create"
+	auditOperationUpdate = "update"
+	auditOperationDelete = "delete"
+)
+
 type ComponentHandler interface {
 	CreateOrUpdateOrDelete(context.Context, render.Component, status.StatusManager) error
 }
 
+// ComponentHandlerOption customizes a ComponentHandler.
+type ComponentHandlerOption func(*componentHandler)
+
+// WithAuditLog makes the handler emit an audit log entry, attributed to the given controller, for each object
+// it creates, changes or deletes. It has no effect if enabled is false.
+func WithAuditLog(enabled bool, controller string) ComponentHandlerOption {
+	return func(c *componentHandler) {
+		c.auditLog = enabled
+		c.controller = controller
+	}
+}
+
+// WithInstallation makes the handler apply the Installation's defaults for components, such as its default pod
+// securityContext, to the objects it writes. install may be nil.
+func WithInstallation(install *operatorv1.InstallationSpec) ComponentHandlerOption {
+	return func(c *componentHandler) {
+		if install != nil {
+			c.podSecurityContext = install.DefaultPodSecurityContext
+		}
+	}
+}
+
 // cr is allowed to be nil in the case we don't want to put ownership on a resource,
 // this is useful for CRD management so that they are not removed automatically.
-func NewComponentHandler(log logr.Logger, client client.Client, scheme *runtime.Scheme, cr metav1.Object) ComponentHandler {
-	return &componentHandler{
+func NewComponentHandler(log logr.Logger, client client.Client, scheme *runtime.Scheme, cr metav1.Object, opts ...ComponentHandlerOption) ComponentHandler {
+	c := &componentHandler{
 		client: client,
 		scheme: scheme,
 		cr:     cr,
 		log:    log,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 type componentHandler struct {
@@ -63,6 +96,13 @@ type componentHandler struct {
 	scheme *runtime.Scheme
 	cr     metav1.Object
 	log    logr.Logger
+
+	// auditLog enables audit log entries for the objects the handler writes, attributed to controller.
+	auditLog   bool
+	controller string
+
+	// podSecurityContext is the default pod securityContext from the Installation, if any.
+	podSecurityContext *v1.PodSecurityContext
 }
 
 func (c componentHandler) createOrUpdateObject(ctx context.Context, obj client.Object, osType rmeta.OSType) error {
@@ -103,6 +143,11 @@ func (c componentHandler) createOrUpdateObject(ctx context.Context, obj client.O
 	// Modify Liveness and Readiness probe default values if they are not set for this object.
 	setProbeTimeouts(obj)
 
+	// Apply the Installation's default pod securityContext to any fields the component doesn't set.
+	if c.podSecurityContext != nil {
+		modifyPodSpec(obj, func(podSpec *v1.PodSpec) { setDefaultPodSecurityContext(podSpec, c.podSecurityContext) })
+	}
+
 	// Make sure we have our standard selector and pod labels
 	setStandardSelectorAndLabels(obj)
 
@@ -131,6 +176,7 @@ func (c componentHandler) createOrUpdateObject(ctx context.Context, obj client.O
 			logCtx.WithValues("key", key).Error(err, "Failed to create object.")
 			return err
 		}
+		c.audit(auditOperationCreate, obj)
 		return nil
 	}
 
@@ -157,6 +203,7 @@ func (c componentHandler) createOrUpdateObject(ctx context.Context, obj client.O
 				logCtx.WithValues("key", key).Error(err, "Failed to create Job.")
 				return err
 			}
+			c.audit(auditOperationUpdate, mobj)
 			return nil
 		case *v1.Secret:
 			objSecret := obj.(*v1.Secret)
@@ -176,6 +223,7 @@ func (c componentHandler) createOrUpdateObject(ctx context.Context, obj client.O
 					logCtx.WithValues("key", key).Error(err, "Failed to create Secret.")
 					return err
 				}
+				c.audit(auditOperationUpdate, mobj)
 				return nil
 			}
 		case *v1.Service:
@@ -196,6 +244,7 @@ func (c componentHandler) createOrUpdateObject(ctx context.Context, obj client.O
 					logCtx.WithValues("key", key).Error(err, "Failed to recreate service.", "obj", obj)
 					return err
 				}
+				c.audit(auditOperationUpdate, mobj)
 				return nil
 			}
 		case *rbacv1.RoleBinding:
@@ -214,6 +263,7 @@ func (c componentHandler) createOrUpdateObject(ctx context.Context, obj client.O
 					logCtx.WithValues("key", key).Error(err, "Failed to recreate RoleBinding")
 					return err
 				}
+				c.audit(auditOperationUpdate, mobj)
 				return nil
 			}
 		case *rbacv1.ClusterRoleBinding:
@@ -232,6 +282,7 @@ func (c componentHandler) createOrUpdateObject(ctx context.Context, obj client.O
 					logCtx.WithValues("key", key).Error(err, "Failed to recreate ClusterRoleBinding")
 					return err
 				}
+				c.audit(auditOperationUpdate, mobj)
 				return nil
 			}
 		}
@@ -239,10 +290,33 @@ func (c componentHandler) createOrUpdateObject(ctx context.Context, obj client.O
 			logCtx.WithValues("key", key).Info("Failed to update object.")
 			return err
 		}
+		// The API server leaves the resource version alone for updates that don't change anything, so only
+		// audit the ones that did.
+		if mobj.GetResourceVersion() != cur.GetResourceVersion() {
+			c.audit(auditOperationUpdate, mobj)
+		}
 	}
 	return nil
 }
 
+// audit emits a structured audit log entry for the given operation on obj, if audit logging is enabled.
+func (c componentHandler) audit(operation string, obj client.Object) {
+	if !c.auditLog {
+		return
+	}
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		kind = reflect.TypeOf(obj).Elem().Name()
+	}
+	c.log.WithName("audit").Info("Applied object",
+		"controller", c.controller,
+		"operation", operation,
+		"kind", kind,
+		"namespace", obj.GetNamespace(),
+		"name", obj.GetName(),
+	)
+}
+
 func resetMetadataForCreate(obj client.Object) {
 	obj.SetResourceVersion("")
 	obj.SetUID("")
@@ -325,6 +399,8 @@ func (c componentHandler) CreateOrUpdateOrDelete(ctx context.Context, component
 			logCtx := ContextLoggerForResource(c.log, obj)
 			logCtx.Error(err, fmt.Sprintf("Error deleting object %v", obj))
 			return err
+		} else if err == nil {
+			c.audit(auditOperationDelete, obj)
 		}
 
 		key := client.ObjectKeyFromObject(obj)
@@ -421,6 +497,19 @@ func mergeState(desired client.Object, current runtime.Object) client.Object {
 			// We want this service to keep its cluster IP.
 			ds.Spec.ClusterIP = cs.Spec.ClusterIP
 		}
+		// Keep any node ports that were allocated by Kubernetes rather than specified by us.
+		if ds.Spec.Type == v1.ServiceTypeNodePort && cs.Spec.Type == v1.ServiceTypeNodePort {
+			for i := range ds.Spec.Ports {
+				if ds.Spec.Ports[i].NodePort != 0 {
+					continue
+				}
+				for _, cp := range cs.Spec.Ports {
+					if cp.Name == ds.Spec.Ports[i].Name {
+						ds.Spec.Ports[i].NodePort = cp.NodePort
+					}
+				}
+			}
+		}
 		return ds
 	case *batchv1.Job:
 		cj := current.(*batchv1.Job)
@@ -580,6 +669,56 @@ func modifyPodSpec(obj client.Object, f func(*v1.PodSpec)) {
 	}
 }
 
+// setDefaultPodSecurityContext sets any fields of the pod securityContext that are not already set from the
+// given defaults. The user and group identity fields are only set on pods whose containers all declare that they
+// run as a non-root user, since the operator can't tell which user an image runs as otherwise.
+func setDefaultPodSecurityContext(podSpec *v1.PodSpec, defaults *v1.PodSecurityContext) {
+	sc := &v1.PodSecurityContext{}
+	if podSpec.SecurityContext != nil {
+		sc = podSpec.SecurityContext
+	}
+	if podRunsAsNonRoot(podSpec) {
+		if sc.RunAsNonRoot == nil && defaults.RunAsNonRoot != nil {
+			sc.RunAsNonRoot = defaults.RunAsNonRoot
+		}
+		if sc.RunAsUser == nil && defaults.RunAsUser != nil {
+			sc.RunAsUser = defaults.RunAsUser
+		}
+		if sc.RunAsGroup == nil && defaults.RunAsGroup != nil {
+			sc.RunAsGroup = defaults.RunAsGroup
+		}
+	}
+	if sc.FSGroup == nil && defaults.FSGroup != nil {
+		sc.FSGroup = defaults.FSGroup
+	}
+	if sc.SeccompProfile == nil && defaults.SeccompProfile != nil {
+		sc.SeccompProfile = defaults.SeccompProfile
+	}
+	if podSpec.SecurityContext == nil && !reflect.DeepEqual(*sc, v1.PodSecurityContext{}) {
+		podSpec.SecurityContext = sc
+	}
+}
+
+// podRunsAsNonRoot returns true if every container in the pod has a securityContext that runs it as a non-root user
+// and is not privileged.
+func podRunsAsNonRoot(podSpec *v1.PodSpec) bool {
+	for _, cs := range [][]v1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for _, c := range cs {
+			sc := c.SecurityContext
+			if sc == nil || (sc.Privileged != nil && *sc.Privileged) {
+				return false
+			}
+			if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+				return false
+			}
+			if !(sc.RunAsNonRoot != nil && *sc.RunAsNonRoot) && sc.RunAsUser == nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // setImagePullPolicy ensures that an image pull policy is set if not set already.
 func setImagePullPolicy(podSpec *v1.PodSpec) {
 	for i := range podSpec.Containers {
@@ -17,6 +17,7 @@ package utils
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	rbacv1 "k8s.io/api/rbac/v1"
 
@@ -26,6 +27,8 @@ import (
 
 	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
 	kbv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/kibana/v1"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
 	ocsv1 "github.com/openshift/api/security/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	apps "k8s.io/api/apps/v1"
@@ -37,6 +40,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
@@ -1027,6 +1031,142 @@ var _ = Describe("Component handler tests", func() {
 		},
 	)
 
+	Context("with a default pod securityContext on the Installation", func() {
+		var (
+			runAsNonRoot = true
+			runAsUser    = int64(10001)
+			fsGroup      = int64(2000)
+		)
+
+		BeforeEach(func() {
+			handler = NewComponentHandler(logf.Log.WithName("test_utils_logger"), c, scheme, instance, WithInstallation(&operatorv1.InstallationSpec{
+				DefaultPodSecurityContext: &corev1.PodSecurityContext{
+					RunAsNonRoot:   &runAsNonRoot,
+					RunAsUser:      &runAsUser,
+					FSGroup:        &fsGroup,
+					SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				},
+			}))
+		})
+
+		createDeployment := func(podSC *corev1.PodSecurityContext, containerSC *corev1.SecurityContext) *apps.Deployment {
+			fc := &fakeComponent{
+				supportedOSType: rmeta.OSTypeLinux,
+				objs: []client.Object{&apps.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"},
+					Spec: apps.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								SecurityContext: podSC,
+								Containers:      []corev1.Container{{Name: "test", SecurityContext: containerSC}},
+							},
+						},
+					},
+				}},
+			}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			d := &apps.Deployment{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "test-deployment", Namespace: "default"}, d)).NotTo(HaveOccurred())
+			return d
+		}
+
+		It("applies the defaults to pods whose containers run as non-root", func() {
+			d := createDeployment(nil, &corev1.SecurityContext{RunAsNonRoot: &runAsNonRoot})
+			Expect(d.Spec.Template.Spec.SecurityContext).To(Equal(&corev1.PodSecurityContext{
+				RunAsNonRoot:   &runAsNonRoot,
+				RunAsUser:      &runAsUser,
+				FSGroup:        &fsGroup,
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			}))
+		})
+
+		It("keeps fields the component sets itself", func() {
+			componentUser := int64(999)
+			d := createDeployment(&corev1.PodSecurityContext{
+				RunAsUser:      &componentUser,
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeUnconfined},
+			}, &corev1.SecurityContext{RunAsNonRoot: &runAsNonRoot})
+			sc := d.Spec.Template.Spec.SecurityContext
+			Expect(*sc.RunAsUser).To(BeEquivalentTo(999))
+			Expect(sc.SeccompProfile.Type).To(Equal(corev1.SeccompProfileTypeUnconfined))
+			Expect(*sc.RunAsNonRoot).To(BeTrue())
+			Expect(*sc.FSGroup).To(BeEquivalentTo(2000))
+		})
+
+		It("does not apply user defaults to pods that run as root", func() {
+			privileged := true
+			d := createDeployment(nil, &corev1.SecurityContext{Privileged: &privileged})
+			sc := d.Spec.Template.Spec.SecurityContext
+			Expect(sc.RunAsNonRoot).To(BeNil())
+			Expect(sc.RunAsUser).To(BeNil())
+			Expect(*sc.FSGroup).To(BeEquivalentTo(2000))
+			Expect(sc.SeccompProfile.Type).To(Equal(corev1.SeccompProfileTypeRuntimeDefault))
+		})
+
+		It("does not apply user defaults to pods whose containers don't declare a non-root user", func() {
+			d := createDeployment(nil, nil)
+			Expect(d.Spec.Template.Spec.SecurityContext).To(Equal(&corev1.PodSecurityContext{
+				FSGroup:        &fsGroup,
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			}))
+		})
+	})
+
+	It("does not set a pod securityContext without an Installation default", func() {
+		fc := &fakeComponent{
+			supportedOSType: rmeta.OSTypeLinux,
+			objs: []client.Object{&apps.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"},
+				Spec: apps.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "test"}}},
+					},
+				},
+			}},
+		}
+		Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+		d := &apps.Deployment{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-deployment", Namespace: "default"}, d)).NotTo(HaveOccurred())
+		Expect(d.Spec.Template.Spec.SecurityContext).To(BeNil())
+	})
+
+	It("preserves node ports allocated by Kubernetes on a NodePort service", func() {
+		Expect(c.Create(ctx, &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-service"},
+			Spec: corev1.ServiceSpec{
+				Type: corev1.ServiceTypeNodePort,
+				Ports: []corev1.ServicePort{
+					{Name: "a", Port: 443, NodePort: 30443},
+					{Name: "b", Port: 9200, NodePort: 31234},
+				},
+			},
+		})).NotTo(HaveOccurred())
+
+		fc := &fakeComponent{
+			supportedOSType: rmeta.OSTypeLinux,
+			objs: []client.Object{
+				&corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "my-service"},
+					Spec: corev1.ServiceSpec{
+						Type: corev1.ServiceTypeNodePort,
+						Ports: []corev1.ServicePort{
+							{Name: "a", Port: 443, NodePort: 30000},
+							{Name: "b", Port: 9200},
+						},
+					},
+				},
+			},
+		}
+		Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+		svc := &corev1.Service{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "my-service"}, svc)).NotTo(HaveOccurred())
+		Expect(svc.Spec.Ports[0].NodePort).To(BeEquivalentTo(30000))
+		Expect(svc.Spec.Ports[1].NodePort).To(BeEquivalentTo(31234))
+	})
+
 	It("recreates a service if its ClusterIP is removed", func() {
 		// Simulate creation of a service by earlier version of operator that includes a ClusterIP.
 		svcWithIP := &corev1.Service{
@@ -1669,6 +1809,85 @@ var _ = Describe("Component handler tests", func() {
 			Expect(sa.ImagePullSecrets).To(HaveLen(1))
 		})
 	})
+
+	Context("audit logging", func() {
+		var entries []string
+		var auditLog logr.Logger
+
+		BeforeEach(func() {
+			entries = nil
+			auditLog = funcr.New(func(prefix, args string) {
+				entries = append(entries, prefix+" "+args)
+			}, funcr.Options{}).WithName("controller_test")
+		})
+
+		auditEntries := func() []string {
+			var audit []string
+			for _, e := range entries {
+				if strings.HasPrefix(e, "controller_test/audit ") {
+					audit = append(audit, e)
+				}
+			}
+			return audit
+		}
+
+		newConfigMap := func(data string) *corev1.ConfigMap {
+			return &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "audit-cm", Namespace: "default"},
+				Data:       map[string]string{"key": data},
+			}
+		}
+
+		It("does not emit audit entries when disabled", func() {
+			handler = NewComponentHandler(auditLog, c, scheme, instance, WithAuditLog(false, "test-controller"))
+			fc := &fakeComponent{
+				supportedOSType: rmeta.OSTypeLinux,
+				objs:            []client.Object{newConfigMap("a")},
+			}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			Expect(auditEntries()).To(BeEmpty())
+		})
+
+		It("emits audit entries for created, updated and deleted objects", func() {
+			handler = NewComponentHandler(auditLog, c, scheme, instance, WithAuditLog(true, "test-controller"))
+
+			fc := &fakeComponent{
+				supportedOSType: rmeta.OSTypeLinux,
+				objs:            []client.Object{newConfigMap("a")},
+			}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			fc.objs = []client.Object{newConfigMap("b")}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			fc.objs = nil
+			fc.objsToDelete = []client.Object{newConfigMap("b")}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+
+			audit := auditEntries()
+			Expect(audit).To(HaveLen(3))
+			Expect(audit[0]).To(ContainSubstring(`"controller"="test-controller" "operation"="create" "kind"="ConfigMap" "namespace"="default" "name"="audit-cm"`))
+			Expect(audit[1]).To(ContainSubstring(`"controller"="test-controller" "operation"="update" "kind"="ConfigMap" "namespace"="default" "name"="audit-cm"`))
+			Expect(audit[2]).To(ContainSubstring(`"controller"="test-controller" "operation"="delete" "kind"="ConfigMap" "namespace"="default" "name"="audit-cm"`))
+		})
+
+		It("does not emit audit entries for updates that leave the object unchanged", func() {
+			// Like the API server, don't bump the resource version when an update changes nothing.
+			cli := ctrlrfake.DefaultFakeClientBuilder(scheme).WithObjects(newConfigMap("a")).WithInterceptorFuncs(interceptor.Funcs{
+				Update: func(ctx context.Context, cli client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					return nil
+				},
+			}).Build()
+			handler = NewComponentHandler(auditLog, cli, scheme, instance, WithAuditLog(true, "test-controller"))
+
+			fc := &fakeComponent{
+				supportedOSType: rmeta.OSTypeLinux,
+				objs:            []client.Object{newConfigMap("a")},
+			}
+			Expect(handler.CreateOrUpdateOrDelete(ctx, fc, sm)).NotTo(HaveOccurred())
+			Expect(auditEntries()).To(BeEmpty())
+		})
+	})
 })
 
 var _ = Describe("Mocked client Component handler tests", func() {
@@ -1896,6 +2115,7 @@ var _ = Describe("Mocked client Component handler tests", func() {
 // A fake component that only returns ready and always creates the "test-namespace" Namespace.
 type fakeComponent struct {
 	objs            []client.Object
+	objsToDelete    []client.Object
 	supportedOSType rmeta.OSType
 }
 
@@ -1908,7 +2128,7 @@ func (c *fakeComponent) ResolveImages(is *operatorv1.ImageSet) error {
 }
 
 func (c *fakeComponent) Objects() ([]client.Object, []client.Object) {
-	return c.objs, nil
+	return c.objs, c.objsToDelete
 }
 
 func (c *fakeComponent) SupportedOSType() rmeta.OSType {
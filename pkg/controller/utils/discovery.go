@@ -17,6 +17,8 @@ package utils
 import (
 	"context"
 	"fmt"
+	"net"
+	"sort"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -27,10 +29,21 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/controller/options"
 )
 
 var log = logf.Log.WithName("discovery")
 
+// Keys recognized in the operator bootstrap configmap.
+const (
+	bootstrapKeyElasticExternal   = "ELASTIC_EXTERNAL"
+	bootstrapKeyAuditLogEnabled   = "AUDIT_LOG_ENABLED"
+	bootstrapKeyExpectedNodeCIDRs = "EXPECTED_NODE_CIDRS"
+	bootstrapKeyFeatureGates      = "FEATURE_GATES"
+
+	bootstrapKeyRequireConsistentNATOutgoing = "REQUIRE_CONSISTENT_NAT_OUTGOING"
+)
+
 // RequiresTigeraSecure determines if the configuration requires we start the tigera secure
 // controllers.
 func RequiresTigeraSecure(cfg *rest.Config) (bool, error) {
@@ -85,6 +98,41 @@ func MultiTenant(ctx context.Context, c kubernetes.Interface) (bool, error) {
 	return false, nil
 }
 
+// ExpectedProviderEnvVar can be set to the provider the operator must detect, as an alternative to the
+// --expected-provider flag.
+const ExpectedProviderEnvVar = "OPERATOR_EXPECTED_PROVIDER"
+
+// ExpectedProviderNone is the expected provider value that asserts no provider is detected.
+const ExpectedProviderNone = "None"
+
+// ValidateExpectedProvider returns an error if an expected provider is given and it differs from the detected one.
+// This catches manifests that were applied to the wrong kind of cluster. An empty expected value disables the check.
+func ValidateExpectedProvider(expected string, detected operatorv1.Provider) error {
+	if expected == "" {
+		return nil
+	}
+
+	want := operatorv1.Provider(expected)
+	if expected == ExpectedProviderNone {
+		want = operatorv1.ProviderNone
+	}
+	switch want {
+	case operatorv1.ProviderNone, operatorv1.ProviderEKS, operatorv1.ProviderGKE, operatorv1.ProviderAKS, operatorv1.ProviderRKE2,
+		operatorv1.ProviderOpenShift, operatorv1.ProviderDockerEE, operatorv1.ProviderTKG:
+	default:
+		return fmt.Errorf("expected provider %q is not valid, should be one of %s, EKS, GKE, AKS, RKE2, OpenShift, DockerEnterprise, TKG", expected, ExpectedProviderNone)
+	}
+
+	if want != detected {
+		got := string(detected)
+		if detected == operatorv1.ProviderNone {
+			got = ExpectedProviderNone
+		}
+		return fmt.Errorf("detected provider %q does not match the expected provider %q", got, expected)
+	}
+	return nil
+}
+
 func AutoDiscoverProvider(ctx context.Context, clientset kubernetes.Interface) (operatorv1.Provider, error) {
 	// First, try to determine the platform based on the present API groups.
 	if platform, err := autodetectFromGroup(clientset); err != nil {
@@ -256,10 +304,100 @@ func UseExternalElastic(config *corev1.ConfigMap) bool {
 	}
 
 	// Load the operator bootstrap configuration from its configmap.
-	if val, ok := config.Data["ELASTIC_EXTERNAL"]; ok && val != "" {
+	if val, ok := config.Data[bootstrapKeyElasticExternal]; ok && val != "" {
+		if strings.ToLower(val) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// UseAuditLog returns true if the operator is configured to emit an audit log entry for each object
+// it creates, updates or deletes, and false otherwise.
+func UseAuditLog(config *corev1.ConfigMap) bool {
+	if config == nil {
+		return false
+	}
+
+	if val, ok := config.Data[bootstrapKeyAuditLogEnabled]; ok && val != "" {
+		if strings.ToLower(val) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireConsistentNATOutgoing returns true if the operator is configured to reject IP pools of the same address
+// family that have different natOutgoing settings, and false if such pools should only be warned about.
+func RequireConsistentNATOutgoing(config *corev1.ConfigMap) bool {
+	if config == nil {
+		return false
+	}
+
+	if val, ok := config.Data[bootstrapKeyRequireConsistentNATOutgoing]; ok && val != "" {
 		if strings.ToLower(val) == "true" {
 			return true
 		}
 	}
 	return false
 }
+
+// LoadExpectedNodeCIDRs returns the CIDRs that IP pools are expected to fall within, as configured in the
+// operator bootstrap configuration. The value is a comma-separated list of CIDRs.
+func LoadExpectedNodeCIDRs(config *corev1.ConfigMap) ([]string, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	var cidrs []string
+	for _, c := range strings.Split(config.Data[bootstrapKeyExpectedNodeCIDRs], ",") {
+		c = strings.TrimSpace(c)
+		if c == "" {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(c); err != nil {
+			return nil, fmt.Errorf("invalid expected node CIDR %q: %w", c, err)
+		}
+		cidrs = append(cidrs, c)
+	}
+	return cidrs, nil
+}
+
+// LoadFeatureGates returns the feature gates configured in the operator bootstrap configuration.
+func LoadFeatureGates(config *corev1.ConfigMap) (options.FeatureGates, error) {
+	if config == nil {
+		return options.FeatureGates{}, nil
+	}
+	return options.ParseFeatureGates(config.Data[bootstrapKeyFeatureGates])
+}
+
+// ValidateBootstrapConfig checks the keys of the operator bootstrap configuration. It returns an error if a
+// recognized key has a malformed value, and otherwise returns any keys it does not recognize so that the
+// caller can warn about them.
+func ValidateBootstrapConfig(config *corev1.ConfigMap) ([]string, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	var unknown []string
+	for key, val := range config.Data {
+		switch key {
+		case bootstrapKeyElasticExternal, bootstrapKeyAuditLogEnabled, bootstrapKeyRequireConsistentNATOutgoing:
+			if val != "" && strings.ToLower(val) != "true" && strings.ToLower(val) != "false" {
+				return nil, fmt.Errorf("bootstrap configmap key %s must be true or false, got %q", key, val)
+			}
+		case bootstrapKeyExpectedNodeCIDRs:
+			if _, err := LoadExpectedNodeCIDRs(config); err != nil {
+				return nil, fmt.Errorf("bootstrap configmap key %s is invalid: %w", key, err)
+			}
+		case bootstrapKeyFeatureGates:
+			if _, err := LoadFeatureGates(config); err != nil {
+				return nil, fmt.Errorf("bootstrap configmap key %s is invalid: %w", key, err)
+			}
+		default:
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown, nil
+}
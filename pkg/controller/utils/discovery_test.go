@@ -18,6 +18,7 @@ import (
 	"context"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
 	corev1 "k8s.io/api/core/v1"
@@ -25,6 +26,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
+	"github.com/tigera/operator/pkg/controller/options"
 )
 
 var _ = Describe("provider discovery", func() {
@@ -144,3 +146,149 @@ var _ = Describe("provider discovery", func() {
 		Expect(p).To(Equal(operatorv1.ProviderRKE2))
 	})
 })
+
+var _ = Describe("feature gates", func() {
+	It("should default gates to off when not configured", func() {
+		gates, err := LoadFeatureGates(&corev1.ConfigMap{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gates.Enabled(options.FeatureGateSidecarAcceleration)).To(BeFalse())
+
+		gates, err = LoadFeatureGates(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gates.Enabled(options.FeatureGateSidecarAcceleration)).To(BeFalse())
+	})
+
+	It("should enable a gate when set in the bootstrap configmap", func() {
+		gates, err := LoadFeatureGates(&corev1.ConfigMap{Data: map[string]string{"FEATURE_GATES": "SidecarAcceleration=true"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gates.Enabled(options.FeatureGateSidecarAcceleration)).To(BeTrue())
+	})
+
+	It("should reject unknown gates", func() {
+		_, err := LoadFeatureGates(&corev1.ConfigMap{Data: map[string]string{"FEATURE_GATES": "NotAGate=true"}})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject malformed gates", func() {
+		_, err := LoadFeatureGates(&corev1.ConfigMap{Data: map[string]string{"FEATURE_GATES": "SidecarAcceleration"}})
+		Expect(err).To(HaveOccurred())
+		_, err = LoadFeatureGates(&corev1.ConfigMap{Data: map[string]string{"FEATURE_GATES": "SidecarAcceleration=maybe"}})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("expected node CIDRs", func() {
+	It("should return no CIDRs if none are configured", func() {
+		cidrs, err := LoadExpectedNodeCIDRs(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cidrs).To(BeEmpty())
+
+		cidrs, err = LoadExpectedNodeCIDRs(&corev1.ConfigMap{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cidrs).To(BeEmpty())
+	})
+
+	It("should load CIDRs from the bootstrap configmap", func() {
+		cidrs, err := LoadExpectedNodeCIDRs(&corev1.ConfigMap{Data: map[string]string{"EXPECTED_NODE_CIDRS": "10.0.0.0/8, fd00::/8"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cidrs).To(Equal([]string{"10.0.0.0/8", "fd00::/8"}))
+	})
+
+	It("should reject invalid CIDRs", func() {
+		_, err := LoadExpectedNodeCIDRs(&corev1.ConfigMap{Data: map[string]string{"EXPECTED_NODE_CIDRS": "10.0.0.0/33"}})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("consistent NAT outgoing configuration", func() {
+	It("should not require consistent natOutgoing by default", func() {
+		Expect(RequireConsistentNATOutgoing(nil)).To(BeFalse())
+		Expect(RequireConsistentNATOutgoing(&corev1.ConfigMap{})).To(BeFalse())
+	})
+
+	It("should require consistent natOutgoing when enabled in the bootstrap configmap", func() {
+		Expect(RequireConsistentNATOutgoing(&corev1.ConfigMap{Data: map[string]string{"REQUIRE_CONSISTENT_NAT_OUTGOING": "True"}})).To(BeTrue())
+	})
+})
+
+var _ = Describe("bootstrap configmap validation", func() {
+	It("should accept a missing or empty configmap", func() {
+		unknown, err := ValidateBootstrapConfig(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unknown).To(BeEmpty())
+
+		unknown, err = ValidateBootstrapConfig(&corev1.ConfigMap{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unknown).To(BeEmpty())
+	})
+
+	It("should accept valid values for all recognized keys", func() {
+		unknown, err := ValidateBootstrapConfig(&corev1.ConfigMap{Data: map[string]string{
+			"ELASTIC_EXTERNAL":                "True",
+			"AUDIT_LOG_ENABLED":               "false",
+			"EXPECTED_NODE_CIDRS":             "10.0.0.0/8",
+			"FEATURE_GATES":                   "",
+			"REQUIRE_CONSISTENT_NAT_OUTGOING": "true",
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unknown).To(BeEmpty())
+	})
+
+	It("should return unrecognized keys", func() {
+		unknown, err := ValidateBootstrapConfig(&corev1.ConfigMap{Data: map[string]string{
+			"AUDIT_LOG_ENABLE": "true",
+			"ELASTIC_EXTERNAL": "true",
+			"AAA":              "x",
+		}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unknown).To(Equal([]string{"AAA", "AUDIT_LOG_ENABLE"}))
+	})
+
+	DescribeTable("should reject malformed values for recognized keys", func(key, val string) {
+		_, err := ValidateBootstrapConfig(&corev1.ConfigMap{Data: map[string]string{key: val}})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(key))
+	},
+		Entry("non-boolean ELASTIC_EXTERNAL", "ELASTIC_EXTERNAL", "yes"),
+		Entry("non-boolean AUDIT_LOG_ENABLED", "AUDIT_LOG_ENABLED", "1"),
+		Entry("non-boolean REQUIRE_CONSISTENT_NAT_OUTGOING", "REQUIRE_CONSISTENT_NAT_OUTGOING", "always"),
+		Entry("invalid EXPECTED_NODE_CIDRS", "EXPECTED_NODE_CIDRS", "10.0.0.0"),
+		Entry("unknown FEATURE_GATES", "FEATURE_GATES", "NoSuchGate=true"),
+	)
+})
+
+var _ = Describe("expected provider validation", func() {
+	It("should not check the provider when no provider is expected", func() {
+		Expect(ValidateExpectedProvider("", operatorv1.ProviderEKS)).To(Succeed())
+	})
+
+	It("should accept a matching provider", func() {
+		Expect(ValidateExpectedProvider("EKS", operatorv1.ProviderEKS)).To(Succeed())
+		Expect(ValidateExpectedProvider("OpenShift", operatorv1.ProviderOpenShift)).To(Succeed())
+	})
+
+	It("should accept None when no provider is detected", func() {
+		Expect(ValidateExpectedProvider("None", operatorv1.ProviderNone)).To(Succeed())
+	})
+
+	It("should reject a mismatched provider", func() {
+		err := ValidateExpectedProvider("EKS", operatorv1.ProviderGKE)
+		Expect(err).To(MatchError(`detected provider "GKE" does not match the expected provider "EKS"`))
+	})
+
+	It("should reject an expected provider when none is detected", func() {
+		err := ValidateExpectedProvider("AKS", operatorv1.ProviderNone)
+		Expect(err).To(MatchError(`detected provider "None" does not match the expected provider "AKS"`))
+	})
+
+	It("should reject None when a provider is detected", func() {
+		err := ValidateExpectedProvider("None", operatorv1.ProviderTKG)
+		Expect(err).To(MatchError(`detected provider "TKG" does not match the expected provider "None"`))
+	})
+
+	It("should reject an unknown expected provider", func() {
+		err := ValidateExpectedProvider("eks", operatorv1.ProviderEKS)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is not valid"))
+	})
+})
@@ -107,6 +107,7 @@ type ElasticsearchClientCreator func(client client.Client, ctx context.Context,
 
 type ElasticClient interface {
 	SetILMPolicies(context.Context, *operatorv1.LogStorage) error
+	SetSnapshotRepository(context.Context, *operatorv1.LogStorage) error
 	CreateUser(context.Context, *User) error
 	DeleteUser(context.Context, *User) error
 	GetUsers(ctx context.Context) ([]User, error)
@@ -388,6 +389,44 @@ func (es *esClient) SetILMPolicies(ctx context.Context, ls *operatorv1.LogStorag
 	return es.createOrUpdatePolicies(ctx, policyList)
 }
 
+// SetSnapshotRepository registers the snapshot repository configured in LogStorage with Elasticsearch. Registering
+// a repository is idempotent, so the repository settings are simply applied on every call.
+func (es *esClient) SetSnapshotRepository(ctx context.Context, ls *operatorv1.LogStorage) error {
+	repo := ls.Spec.SnapshotRepository
+	if repo == nil {
+		return nil
+	}
+	_, err := es.client.SnapshotCreateRepository(repo.Name).
+		Type(snapshotRepositoryType(repo.Type)).
+		Settings(snapshotRepositorySettings(repo)).
+		Do(ctx)
+	if err != nil {
+		log.Error(err, "Error applying snapshot repository", "repository", repo.Name)
+		return err
+	}
+	return nil
+}
+
+// snapshotRepositoryType returns the Elasticsearch repository type for the given SnapshotRepositoryType.
+func snapshotRepositoryType(t operatorv1.SnapshotRepositoryType) string {
+	switch t {
+	case operatorv1.SnapshotRepositoryTypeGCS:
+		return "gcs"
+	default:
+		return "s3"
+	}
+}
+
+func snapshotRepositorySettings(repo *operatorv1.SnapshotRepository) map[string]interface{} {
+	settings := map[string]interface{}{
+		"bucket": repo.Bucket,
+	}
+	if repo.BasePath != "" {
+		settings["base_path"] = repo.BasePath
+	}
+	return settings
+}
+
 // listILMPolicies generates ILM policies based on disk space and retention in LogStorage
 // Allocate 70% of ES disk space to flows, dns and bgp logs [majorPctOfTotalDisk]
 // Allocate 90% of the 70% ES disk space to flow logs, 5% of the 70% ES disk space to each dns and bgp logs.
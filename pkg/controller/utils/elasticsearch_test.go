@@ -27,6 +27,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	elastic "github.com/olivere/elastic/v7"
+	operatorv1 "github.com/tigera/operator/api/v1"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 )
@@ -34,6 +35,8 @@ import (
 const (
 	baseURI   = "http://127.0.0.1:9200"
 	indexName = "tigera_secure_ee_test_index"
+
+	snapshotRepositoryName = "tigera-backup"
 )
 
 var newPolicies bool
@@ -92,6 +95,51 @@ var _ = Describe("Elasticsearch tests", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+
+	Context("Snapshot repository", func() {
+		var (
+			eClient *esClient
+			ctx     context.Context
+		)
+		BeforeEach(func() {
+			client := &http.Client{
+				Transport: http.RoundTripper(&testRoundTripper{}),
+			}
+			eClient = mockElasticClient(client, baseURI)
+			ctx = context.Background()
+		})
+
+		It("does nothing when no repository is configured", func() {
+			Expect(eClient.SetSnapshotRepository(ctx, &operatorv1.LogStorage{})).To(Succeed())
+		})
+
+		It("registers the repository", func() {
+			ls := &operatorv1.LogStorage{
+				Spec: operatorv1.LogStorageSpec{
+					SnapshotRepository: &operatorv1.SnapshotRepository{
+						Name:     snapshotRepositoryName,
+						Type:     operatorv1.SnapshotRepositoryTypeS3,
+						Bucket:   "es-backups",
+						BasePath: "cluster-a",
+					},
+				},
+			}
+			Expect(eClient.SetSnapshotRepository(ctx, ls)).To(Succeed())
+		})
+
+		It("returns an error when Elasticsearch rejects the repository", func() {
+			ls := &operatorv1.LogStorage{
+				Spec: operatorv1.LogStorageSpec{
+					SnapshotRepository: &operatorv1.SnapshotRepository{
+						Name:   "unknown",
+						Type:   operatorv1.SnapshotRepositoryTypeGCS,
+						Bucket: "es-backups",
+					},
+				},
+			}
+			Expect(eClient.SetSnapshotRepository(ctx, ls)).NotTo(Succeed())
+		})
+	})
 })
 
 type testRoundTripper struct {
@@ -130,6 +178,21 @@ func (t *testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error)
 	case "POST":
 	case "PUT":
 		switch req.URL.String() {
+		case baseURI + "/_snapshot/" + snapshotRepositoryName:
+			actualBody, err := io.ReadAll(req.Body)
+			Expect(err).To(BeNil())
+
+			jsonFile, err := os.Open("test_files/03_put_snapshot_repository.json")
+			Expect(err).To(BeNil())
+			defer jsonFile.Close()
+			expectedBody, _ := io.ReadAll(jsonFile)
+			Expect(actualBody).To(MatchJSON(expectedBody))
+
+			return &http.Response{
+				StatusCode: 200,
+				Request:    req,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"acknowledged": true}`)),
+			}, nil
 		case baseURI + "/_ilm/policy/" + indexName + "_policy":
 			if newPolicies {
 				actualBody, err := io.ReadAll(req.Body)
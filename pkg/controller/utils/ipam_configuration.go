@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PatchIPAMConfig fetches the default IPAMConfig and applies the given patch function to it, creating the
+// IPAMConfig if it does not exist and the patch function reports a change.
+func PatchIPAMConfig(ctx context.Context, c client.Client, patchFn func(ic *crdv1.IPAMConfig) (bool, error)) (*crdv1.IPAMConfig, error) {
+	// Fetch any existing default IPAMConfig object.
+	ic := &crdv1.IPAMConfig{}
+	err := c.Get(ctx, types.NamespacedName{Name: "default"}, ic)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to read IPAMConfig: %w", err)
+	}
+	if errors.IsNotFound(err) {
+		// Calico allocates blocks automatically when there is no IPAMConfig, so keep doing so when creating one.
+		ic.Spec.AutoAllocateBlocks = true
+	}
+
+	// Create a base state for the upcoming patch operation.
+	patchFrom := client.MergeFrom(ic.DeepCopy())
+
+	// Apply desired changes to the IPAMConfig.
+	updated, err := patchFn(ic)
+	if err != nil {
+		return nil, err
+	}
+	if updated {
+		// Apply the patch.
+		if ic.ResourceVersion == "" {
+			ic.ObjectMeta.Name = "default"
+			if err := c.Create(ctx, ic); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := c.Patch(ctx, ic, patchFrom); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return ic, nil
+}
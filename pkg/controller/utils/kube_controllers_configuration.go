@@ -0,0 +1,89 @@
+// Copyright (c) 2024 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	crdv1 "github.com/tigera/operator/pkg/apis/crd.projectcalico.org/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PatchKubeControllersConfiguration fetches the default KubeControllersConfiguration and applies the given patch
+// function to it, creating the KubeControllersConfiguration if it does not exist and the patch function reports a change.
+func PatchKubeControllersConfiguration(ctx context.Context, c client.Client, patchFn func(kc *crdv1.KubeControllersConfiguration) (bool, error)) (*crdv1.KubeControllersConfiguration, error) {
+	// Fetch any existing default KubeControllersConfiguration object.
+	kc := &crdv1.KubeControllersConfiguration{}
+	err := c.Get(ctx, types.NamespacedName{Name: "default"}, kc)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to read KubeControllersConfiguration: %w", err)
+	}
+	if errors.IsNotFound(err) {
+		// calico-kube-controllers only fills in its defaults when it creates the KubeControllersConfiguration itself,
+		// so start from the same defaults when creating it here.
+		setDefaultsOnKubeControllersConfiguration(kc)
+	}
+
+	// Create a base state for the upcoming patch operation.
+	patchFrom := client.MergeFrom(kc.DeepCopy())
+
+	// Apply desired changes to the KubeControllersConfiguration.
+	updated, err := patchFn(kc)
+	if err != nil {
+		return nil, err
+	}
+	if updated {
+		// Apply the patch.
+		if kc.ResourceVersion == "" {
+			kc.ObjectMeta.Name = "default"
+			if err := c.Create(ctx, kc); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := c.Patch(ctx, kc, patchFrom); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return kc, nil
+}
+
+func setDefaultsOnKubeControllersConfiguration(kc *crdv1.KubeControllersConfiguration) {
+	metricsPort := 9094
+	kc.Spec = crdv1.KubeControllersConfigurationSpec{
+		LogSeverityScreen:      "Info",
+		HealthChecks:           "Enabled",
+		EtcdV3CompactionPeriod: &metav1.Duration{Duration: 10 * time.Minute},
+		PrometheusMetricsPort:  &metricsPort,
+		Controllers: crdv1.ControllersConfig{
+			Node: &crdv1.NodeControllerConfig{
+				ReconcilerPeriod: &metav1.Duration{Duration: 5 * time.Minute},
+				SyncLabels:       "Enabled",
+				HostEndpoint:     &crdv1.AutoHostEndpointConfig{AutoCreate: "Disabled"},
+				LeakGracePeriod:  &metav1.Duration{Duration: 15 * time.Minute},
+			},
+			Policy:           &crdv1.PolicyControllerConfig{ReconcilerPeriod: &metav1.Duration{Duration: 5 * time.Minute}},
+			WorkloadEndpoint: &crdv1.WorkloadEndpointControllerConfig{ReconcilerPeriod: &metav1.Duration{Duration: 5 * time.Minute}},
+			ServiceAccount:   &crdv1.ServiceAccountControllerConfig{ReconcilerPeriod: &metav1.Duration{Duration: 5 * time.Minute}},
+			Namespace:        &crdv1.NamespaceControllerConfig{ReconcilerPeriod: &metav1.Duration{Duration: 5 * time.Minute}},
+		},
+	}
+}
@@ -108,6 +108,16 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		inst.TyphaMetricsPort = override.TyphaMetricsPort
 	}
 
+	switch compareFields(inst.DefaultPodSecurityContext, override.DefaultPodSecurityContext) {
+	case BOnlySet, Different:
+		inst.DefaultPodSecurityContext = override.DefaultPodSecurityContext
+	}
+
+	switch compareFields(inst.FailsafePorts, override.FailsafePorts) {
+	case BOnlySet, Different:
+		inst.FailsafePorts = override.FailsafePorts
+	}
+
 	switch compareFields(inst.FlexVolumePath, override.FlexVolumePath) {
 	case BOnlySet, Different:
 		inst.FlexVolumePath = override.FlexVolumePath
@@ -123,6 +133,11 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		override.NodeUpdateStrategy.DeepCopyInto(&inst.NodeUpdateStrategy)
 	}
 
+	switch compareFields(inst.MaxConcurrentNodeRestarts, override.MaxConcurrentNodeRestarts) {
+	case BOnlySet, Different:
+		inst.MaxConcurrentNodeRestarts = override.MaxConcurrentNodeRestarts
+	}
+
 	switch compareFields(inst.ComponentResources, override.ComponentResources) {
 	case BOnlySet, Different:
 		inst.ComponentResources = make([]operatorv1.ComponentResource, len(override.ComponentResources))
@@ -173,6 +188,16 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		inst.CalicoKubeControllersDeployment = mergeCalicoKubeControllersDeployment(inst.CalicoKubeControllersDeployment, override.CalicoKubeControllersDeployment)
 	}
 
+	switch compareFields(inst.KubeControllersConfig, override.KubeControllersConfig) {
+	case BOnlySet, Different:
+		inst.KubeControllersConfig = override.KubeControllersConfig.DeepCopy()
+	}
+
+	switch compareFields(inst.NodeLocalDNSCache, override.NodeLocalDNSCache) {
+	case BOnlySet, Different:
+		inst.NodeLocalDNSCache = override.NodeLocalDNSCache.DeepCopy()
+	}
+
 	switch compareFields(inst.TyphaDeployment, override.TyphaDeployment) {
 	case BOnlySet:
 		inst.TyphaDeployment = override.TyphaDeployment.DeepCopy()
@@ -209,6 +234,29 @@ func OverrideInstallationSpec(cfg, override operatorv1.InstallationSpec) operato
 		inst.ServiceCIDRs = override.ServiceCIDRs
 	}
 
+	switch compareFields(inst.NamespaceResourceQuotas, override.NamespaceResourceQuotas) {
+	case BOnlySet, Different:
+		inst.NamespaceResourceQuotas = make([]operatorv1.NamespaceResourceQuota, len(override.NamespaceResourceQuotas))
+		for i := range override.NamespaceResourceQuotas {
+			override.NamespaceResourceQuotas[i].DeepCopyInto(&inst.NamespaceResourceQuotas[i])
+		}
+	}
+
+	switch compareFields(inst.PodSecurityAdmissionModes, override.PodSecurityAdmissionModes) {
+	case BOnlySet, Different:
+		inst.PodSecurityAdmissionModes = override.PodSecurityAdmissionModes
+	}
+
+	switch compareFields(inst.CalicoNodeGenerationLabel, override.CalicoNodeGenerationLabel) {
+	case BOnlySet, Different:
+		inst.CalicoNodeGenerationLabel = override.CalicoNodeGenerationLabel
+	}
+
+	switch compareFields(inst.ServiceAccountAnnotations, override.ServiceAccountAnnotations) {
+	case BOnlySet, Different:
+		inst.ServiceAccountAnnotations = override.ServiceAccountAnnotations
+	}
+
 	return inst
 }
 
@@ -255,6 +303,11 @@ func mergeCalicoNetwork(cfg, override *operatorv1.CalicoNetworkSpec) *operatorv1
 		out.BGP = override.BGP
 	}
 
+	switch compareFields(out.MaxRecommendedIPPools, override.MaxRecommendedIPPools) {
+	case BOnlySet, Different:
+		out.MaxRecommendedIPPools = override.MaxRecommendedIPPools
+	}
+
 	switch compareFields(out.IPPools, override.IPPools) {
 	case BOnlySet, Different:
 		out.IPPools = make([]operatorv1.IPPool, len(override.IPPools))
@@ -273,6 +326,124 @@ func mergeCalicoNetwork(cfg, override *operatorv1.CalicoNetworkSpec) *operatorv1
 		out.LinuxPolicySetupTimeoutSeconds = override.LinuxPolicySetupTimeoutSeconds
 	}
 
+	switch compareFields(out.BGPReadinessGate, override.BGPReadinessGate) {
+	case BOnlySet, Different:
+		out.BGPReadinessGate = override.BGPReadinessGate
+	}
+
+	switch compareFields(out.AdvertisedServiceClusterIPs, override.AdvertisedServiceClusterIPs) {
+	case BOnlySet, Different:
+		out.AdvertisedServiceClusterIPs = override.AdvertisedServiceClusterIPs
+	}
+
+	switch compareFields(out.BGPGracefulRestartTime, override.BGPGracefulRestartTime) {
+	case BOnlySet, Different:
+		out.BGPGracefulRestartTime = override.BGPGracefulRestartTime
+	}
+
+	switch compareFields(out.BGPListenPort, override.BGPListenPort) {
+	case BOnlySet, Different:
+		out.BGPListenPort = override.BGPListenPort
+	}
+
+	switch compareFields(out.PolicySync, override.PolicySync) {
+	case BOnlySet, Different:
+		out.PolicySync = override.PolicySync
+	}
+
+	switch compareFields(out.EndpointStatusReporting, override.EndpointStatusReporting) {
+	case BOnlySet, Different:
+		out.EndpointStatusReporting = override.EndpointStatusReporting
+	}
+
+	switch compareFields(out.BGPFilters, override.BGPFilters) {
+	case BOnlySet, Different:
+		out.BGPFilters = make([]operatorv1.BGPFilter, len(override.BGPFilters))
+		for i := range override.BGPFilters {
+			override.BGPFilters[i].DeepCopyInto(&out.BGPFilters[i])
+		}
+	}
+
+	switch compareFields(out.RouteReflectors, override.RouteReflectors) {
+	case BOnlySet, Different:
+		out.RouteReflectors = override.RouteReflectors.DeepCopy()
+	}
+
+	switch compareFields(out.XDPAcceleration, override.XDPAcceleration) {
+	case BOnlySet, Different:
+		out.XDPAcceleration = override.XDPAcceleration
+	}
+
+	switch compareFields(out.BPFExternalServiceMode, override.BPFExternalServiceMode) {
+	case BOnlySet, Different:
+		out.BPFExternalServiceMode = override.BPFExternalServiceMode
+	}
+
+	switch compareFields(out.BPFConnectTimeLoadBalancing, override.BPFConnectTimeLoadBalancing) {
+	case BOnlySet, Different:
+		out.BPFConnectTimeLoadBalancing = override.BPFConnectTimeLoadBalancing
+	}
+
+	switch compareFields(out.DefaultEndpointToHostAction, override.DefaultEndpointToHostAction) {
+	case BOnlySet, Different:
+		out.DefaultEndpointToHostAction = override.DefaultEndpointToHostAction
+	}
+
+	switch compareFields(out.AWSSrcDstCheck, override.AWSSrcDstCheck) {
+	case BOnlySet, Different:
+		out.AWSSrcDstCheck = override.AWSSrcDstCheck
+	}
+
+	switch compareFields(out.FloatingIPs, override.FloatingIPs) {
+	case BOnlySet, Different:
+		out.FloatingIPs = override.FloatingIPs
+	}
+
+	switch compareFields(out.WireguardHostEncryption, override.WireguardHostEncryption) {
+	case BOnlySet, Different:
+		out.WireguardHostEncryption = override.WireguardHostEncryption
+	}
+
+	switch compareFields(out.NATPortRange, override.NATPortRange) {
+	case BOnlySet, Different:
+		out.NATPortRange = override.NATPortRange
+	}
+
+	switch compareFields(out.RemoveExternalRoutes, override.RemoveExternalRoutes) {
+	case BOnlySet, Different:
+		out.RemoveExternalRoutes = override.RemoveExternalRoutes
+	}
+
+	switch compareFields(out.VXLANPort, override.VXLANPort) {
+	case BOnlySet, Different:
+		out.VXLANPort = override.VXLANPort
+	}
+
+	switch compareFields(out.DNSExtraTTL, override.DNSExtraTTL) {
+	case BOnlySet, Different:
+		out.DNSExtraTTL = override.DNSExtraTTL
+	}
+
+	switch compareFields(out.ConntrackCleanupInterval, override.ConntrackCleanupInterval) {
+	case BOnlySet, Different:
+		out.ConntrackCleanupInterval = override.ConntrackCleanupInterval
+	}
+
+	switch compareFields(out.BPFConntrackCleanupInterval, override.BPFConntrackCleanupInterval) {
+	case BOnlySet, Different:
+		out.BPFConntrackCleanupInterval = override.BPFConntrackCleanupInterval
+	}
+
+	switch compareFields(out.IptablesRefreshInterval, override.IptablesRefreshInterval) {
+	case BOnlySet, Different:
+		out.IptablesRefreshInterval = override.IptablesRefreshInterval
+	}
+
+	switch compareFields(out.BPFMapSizes, override.BPFMapSizes) {
+	case BOnlySet, Different:
+		out.BPFMapSizes = override.BPFMapSizes.DeepCopy()
+	}
+
 	switch compareFields(out.LinuxDataplane, override.LinuxDataplane) {
 	case BOnlySet, Different:
 		out.LinuxDataplane = override.LinuxDataplane
@@ -361,6 +532,11 @@ func mergeCalicoNodeDaemonSet(cfg, override *operatorv1.CalicoNodeDaemonSet) *op
 			copy(out.Containers, override.Containers)
 		}
 
+		switch compareFields(out.ExtraContainers, override.ExtraContainers) {
+		case BOnlySet, Different:
+			out.ExtraContainers = override.ExtraContainers
+		}
+
 		switch compareFields(out.Affinity, override.Affinity) {
 		case BOnlySet, Different:
 			out.Affinity = override.Affinity
@@ -858,6 +858,9 @@ func IsDexDisabled(authentication *operatorv1.Authentication) bool {
 	if authentication.Spec.OIDC != nil && authentication.Spec.OIDC.Type == operatorv1.OIDCTypeTigera {
 		disableDex = true
 	}
+	if authentication.Spec.ExternalDex != nil {
+		disableDex = true
+	}
 	return disableDex
 }
 
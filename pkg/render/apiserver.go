@@ -222,7 +222,7 @@ func (c *apiServerComponent) Objects() ([]client.Object, []client.Object) {
 
 	// Global enterprise-only objects.
 	globalEnterpriseObjects := []client.Object{
-		CreateNamespace(rmeta.APIServerNamespace(operatorv1.TigeraSecureEnterprise), c.cfg.Installation.KubernetesProvider, PSSPrivileged),
+		CreateNamespace(rmeta.APIServerNamespace(operatorv1.TigeraSecureEnterprise), c.cfg.Installation, PSSPrivileged),
 		c.tigeraCustomResourcesClusterRole(),
 		c.tigeraCustomResourcesClusterRoleBinding(),
 		c.tierGetterClusterRole(),
@@ -268,7 +268,7 @@ func (c *apiServerComponent) Objects() ([]client.Object, []client.Object) {
 
 	// Global OSS-only objects.
 	globalCalicoObjects := []client.Object{
-		CreateNamespace(rmeta.APIServerNamespace(operatorv1.Calico), c.cfg.Installation.KubernetesProvider, PSSPrivileged),
+		CreateNamespace(rmeta.APIServerNamespace(operatorv1.Calico), c.cfg.Installation, PSSPrivileged),
 	}
 
 	// Compile the final arrays based on the variant.
@@ -1078,6 +1078,27 @@ func (c *apiServerComponent) startUpArgs() []string {
 		fmt.Sprintf("--tls-cert-file=%s", c.cfg.TLSKeyPair.VolumeMountCertificateFilePath()),
 	}
 
+	if c.cfg.APIServer != nil && c.cfg.APIServer.TLS != nil {
+		if v := c.cfg.APIServer.TLS.MinVersion; v != nil {
+			args = append(args, fmt.Sprintf("--tls-min-version=%s", *v))
+		}
+		if len(c.cfg.APIServer.TLS.CipherSuites) > 0 {
+			args = append(args, fmt.Sprintf("--tls-cipher-suites=%s", strings.Join(c.cfg.APIServer.TLS.CipherSuites, ",")))
+		}
+	}
+
+	if c.cfg.APIServer != nil {
+		if t := c.cfg.APIServer.RequestTimeout; t != nil {
+			args = append(args, fmt.Sprintf("--request-timeout=%s", t.Duration))
+		}
+		if n := c.cfg.APIServer.MaxRequestsInflight; n != nil {
+			args = append(args, fmt.Sprintf("--max-requests-inflight=%d", *n))
+		}
+		if n := c.cfg.APIServer.MaxMutatingRequestsInflight; n != nil {
+			args = append(args, fmt.Sprintf("--max-mutating-requests-inflight=%d", *n))
+		}
+	}
+
 	if c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise {
 		args = append(args,
 			"--audit-policy-file=/etc/tigera/audit/policy.conf",
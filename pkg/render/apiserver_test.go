@@ -36,6 +36,7 @@ import (
 	"github.com/tigera/operator/pkg/controller/k8sapi"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
 	"github.com/tigera/operator/pkg/dns"
+	"github.com/tigera/operator/pkg/ptr"
 	"github.com/tigera/operator/pkg/render"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 	"github.com/tigera/operator/pkg/render/common/podaffinity"
@@ -765,6 +766,68 @@ var _ = Describe("API server rendering tests (Calico Enterprise)", func() {
 		Expect((dep.(*appsv1.Deployment)).Spec.Template.Spec.Containers[0].Args).To(ConsistOf(expectedArgs))
 	})
 
+	It("should render the TLS minimum version and cipher suites when configured", func() {
+		minVersion := operatorv1.TLSVersion12
+		cfg.APIServer.TLS = &operatorv1.APIServerTLS{
+			MinVersion:   &minVersion,
+			CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"},
+		}
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "tigera-apiserver", "tigera-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+			"--tls-min-version=VersionTLS12",
+			"--tls-cipher-suites=TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+		))
+	})
+
+	It("should not render TLS version or cipher suite args by default", func() {
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "tigera-apiserver", "tigera-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		for _, arg := range d.Spec.Template.Spec.Containers[0].Args {
+			Expect(arg).NotTo(HavePrefix("--tls-min-version"))
+			Expect(arg).NotTo(HavePrefix("--tls-cipher-suites"))
+		}
+	})
+
+	It("should render the request timeout and in-flight limits when configured", func() {
+		cfg.APIServer.RequestTimeout = &metav1.Duration{Duration: 2 * time.Minute}
+		cfg.APIServer.MaxRequestsInflight = ptr.Int32ToPtr(800)
+		cfg.APIServer.MaxMutatingRequestsInflight = ptr.Int32ToPtr(400)
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "tigera-apiserver", "tigera-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		Expect(d.Spec.Template.Spec.Containers[0].Args).To(ContainElements(
+			"--request-timeout=2m0s",
+			"--max-requests-inflight=800",
+			"--max-mutating-requests-inflight=400",
+		))
+	})
+
+	It("should not render request timeout or in-flight limit args by default", func() {
+		component, err := render.APIServer(cfg)
+		Expect(err).To(BeNil(), "Expected APIServer to create successfully %s", err)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		d := rtest.GetResource(resources, "tigera-apiserver", "tigera-system", "apps", "v1", "Deployment").(*appsv1.Deployment)
+		for _, arg := range d.Spec.Template.Spec.Containers[0].Args {
+			Expect(arg).NotTo(HavePrefix("--request-timeout"))
+			Expect(arg).NotTo(HavePrefix("--max-requests-inflight"))
+			Expect(arg).NotTo(HavePrefix("--max-mutating-requests-inflight"))
+		}
+	})
+
 	It("should render an API server with custom configuration with MCM enabled at restart", func() {
 		cfg.ManagementCluster = managementCluster
 		component, err := render.APIServer(cfg)
@@ -22,6 +22,7 @@ import (
 	operator "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/common"
 	"github.com/tigera/operator/pkg/components"
+	"github.com/tigera/operator/pkg/ptr"
 	batchv1 "k8s.io/api/batch/v1"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -150,6 +151,12 @@ func ApplyDeploymentOverrides(d *appsv1.Deployment, overrides components.Replica
 	d.Spec.MinReadySeconds = *r.minReadySeconds
 	d.Spec.Template = *r.podTemplateSpec
 	d.Spec.Strategy = *r.deploymentStrategy
+
+	if o, ok := overrides.(components.RevisionHistoryLimitOverrides); ok {
+		if limit := o.GetRevisionHistoryLimit(); limit != nil {
+			d.Spec.RevisionHistoryLimit = ptr.Int32ToPtr(*limit)
+		}
+	}
 }
 
 // ApplyJobOverrides applies the overrides to the given Job.
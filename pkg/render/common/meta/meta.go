@@ -122,3 +122,21 @@ func GetResourceRequirements(i *operatorv1.InstallationSpec, name operatorv1.Com
 	}
 	return corev1.ResourceRequirements{}
 }
+
+// GetServiceAccountAnnotations retrieves the annotations the installation configures for the service account of the
+// given component. If there are none, it returns nil.
+func GetServiceAccountAnnotations(i *operatorv1.InstallationSpec, name operatorv1.ComponentName) map[string]string {
+	if i == nil {
+		return nil
+	}
+	for _, sa := range i.ServiceAccountAnnotations {
+		if sa.ComponentName == name && len(sa.Annotations) > 0 {
+			annotations := make(map[string]string, len(sa.Annotations))
+			for k, v := range sa.Annotations {
+				annotations[k] = v
+			}
+			return annotations
+		}
+	}
+	return nil
+}
@@ -23,8 +23,29 @@ import (
 const (
 	CalicoCriticalResourceQuotaName = "calico-critical-pods"
 	TigeraCriticalResourceQuotaName = "tigera-critical-pods"
+
+	// NamespaceResourceQuotaName is the name of the user configured ResourceQuota in operator managed namespaces.
+	NamespaceResourceQuotaName = "tigera-namespace-quota"
+
+	// NamespaceResourceQuotaLabel marks the user configured ResourceQuotas that were created by the operator.
+	NamespaceResourceQuotaLabel = "operator.tigera.io/namespace-quota"
 )
 
+// ResourceQuota creates a ResourceQuota with the given spec in a specified namespace.
+func ResourceQuota(name, namespace string, spec corev1.ResourceQuotaSpec) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ResourceQuota",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: spec,
+	}
+}
+
 // ResourceQuotaForPriorityClassScope creates a ResourceQuota in a specified namespace and
 // selects the priority classes provides. This allows pods with the specified pods to be scheduled
 // This doesn't guarantee that a pod will be scheduled as Kubernetes will also check to ensure
@@ -98,6 +98,7 @@ type ComplianceConfiguration struct {
 	ManagementCluster           *operatorv1.ManagementCluster
 	ManagementClusterConnection *operatorv1.ManagementClusterConnection
 	KeyValidatorConfig          authentication.KeyValidatorConfig
+	Authentication              *operatorv1.Authentication
 	ClusterDomain               string
 	HasNoLicense                bool
 
@@ -1843,7 +1844,7 @@ func (c *complianceComponent) complianceServerAllowTigeraNetworkPolicy() *v3.Net
 		{
 			Action:      v3.Allow,
 			Protocol:    &networkpolicy.TCPProtocol,
-			Destination: DexEntityRule,
+			Destination: DexEntityRule(c.cfg.Authentication),
 		},
 		// compliance-server does RBAC checks for managed cluster compliance reports via guardian.
 		{
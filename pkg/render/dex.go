@@ -52,9 +52,23 @@ const (
 	DexTLSSecretName         = "tigera-dex-tls"
 	DexClientId              = "tigera-manager"
 	DexPolicyName            = networkpolicy.TigeraComponentPolicyPrefix + "allow-tigera-dex"
+
+	// DexTerminationGracePeriodSeconds is the default time Dex has to finish in-flight requests when stopped.
+	DexTerminationGracePeriodSeconds int64 = 30
 )
 
-var DexEntityRule = networkpolicy.CreateEntityRule(DexNamespace, DexObjectName, DexPort)
+// GetDexNamespace returns the namespace that Dex is deployed into for the given Authentication.
+func GetDexNamespace(authentication *operatorv1.Authentication) string {
+	if authentication != nil && authentication.Spec.DexNamespace != "" {
+		return authentication.Spec.DexNamespace
+	}
+	return DexNamespace
+}
+
+// DexEntityRule returns an entity rule that selects Dex in the namespace it is deployed into for the given Authentication.
+func DexEntityRule(authentication *operatorv1.Authentication) v3.EntityRule {
+	return networkpolicy.CreateEntityRule(GetDexNamespace(authentication), DexObjectName, DexPort)
+}
 
 func Dex(cfg *DexComponentConfiguration) Component {
 	return &dexComponent{
@@ -112,6 +126,10 @@ func (c *dexComponent) ResolveImages(is *operatorv1.ImageSet) error {
 	return nil
 }
 
+func (c *dexComponent) namespace() string {
+	return GetDexNamespace(c.cfg.Authentication)
+}
+
 func (*dexComponent) SupportedOSType() rmeta.OSType {
 	return rmeta.OSTypeLinux
 }
@@ -119,7 +137,7 @@ func (*dexComponent) SupportedOSType() rmeta.OSType {
 func (c *dexComponent) Objects() ([]client.Object, []client.Object) {
 	objs := []client.Object{
 		c.allowTigeraNetworkPolicy(),
-		networkpolicy.AllowTigeraDefaultDeny(DexNamespace),
+		networkpolicy.AllowTigeraDefaultDeny(c.namespace()),
 		c.serviceAccount(),
 		c.deployment(),
 		c.service(),
@@ -135,11 +153,11 @@ func (c *dexComponent) Objects() ([]client.Object, []client.Object) {
 		objs = append(objs, secret.ToRuntimeObjects(c.cfg.DexConfig.RequiredSecrets(common.OperatorNamespace())...)...)
 	}
 
-	objs = append(objs, secret.ToRuntimeObjects(c.cfg.DexConfig.RequiredSecrets(DexNamespace)...)...)
-	objs = append(objs, secret.ToRuntimeObjects(secret.CopyToNamespace(DexNamespace, c.cfg.PullSecrets...)...)...)
+	objs = append(objs, secret.ToRuntimeObjects(c.cfg.DexConfig.RequiredSecrets(c.namespace())...)...)
+	objs = append(objs, secret.ToRuntimeObjects(secret.CopyToNamespace(c.namespace(), c.cfg.PullSecrets...)...)...)
 
 	if c.cfg.Installation.CertificateManagement != nil {
-		objs = append(objs, certificatemanagement.CSRClusterRoleBinding(DexObjectName, DexNamespace))
+		objs = append(objs, certificatemanagement.CSRClusterRoleBinding(DexObjectName, c.namespace()))
 	}
 
 	if c.cfg.UsePSP {
@@ -160,7 +178,7 @@ func (c *dexComponent) Ready() bool {
 func (c *dexComponent) serviceAccount() *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
 		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
-		ObjectMeta: metav1.ObjectMeta{Name: DexObjectName, Namespace: DexNamespace},
+		ObjectMeta: metav1.ObjectMeta{Name: DexObjectName, Namespace: c.namespace()},
 	}
 }
 
@@ -211,7 +229,7 @@ func (c *dexComponent) clusterRoleBinding() client.Object {
 			{
 				Kind:      "ServiceAccount",
 				Name:      DexObjectName,
-				Namespace: DexNamespace,
+				Namespace: c.namespace(),
 			},
 		},
 	}
@@ -224,7 +242,7 @@ func (c *dexComponent) podSecurityPolicy() *policyv1beta1.PodSecurityPolicy {
 func (c *dexComponent) deployment() client.Object {
 	var initContainers []corev1.Container
 	if c.cfg.TLSKeyPair.UseCertificateManagement() {
-		initContainers = append(initContainers, c.cfg.TLSKeyPair.InitContainer(DexNamespace))
+		initContainers = append(initContainers, c.cfg.TLSKeyPair.InitContainer(c.namespace()))
 	}
 
 	annotations := c.cfg.DexConfig.RequiredAnnotations()
@@ -237,29 +255,32 @@ func (c *dexComponent) deployment() client.Object {
 	mounts = append(mounts, c.cfg.TLSKeyPair.VolumeMount(c.SupportedOSType()))
 	mounts = append(mounts, c.cfg.TrustedBundle.VolumeMounts(c.SupportedOSType())...)
 
+	terminationGracePeriod := DexTerminationGracePeriodSeconds
+
 	d := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      DexObjectName,
-			Namespace: DexNamespace,
+			Namespace: c.namespace(),
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: c.cfg.Installation.ControlPlaneReplicas,
 			Strategy: appsv1.DeploymentStrategy{
-				Type: appsv1.RecreateDeploymentStrategyType,
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        DexObjectName,
-					Namespace:   DexNamespace,
+					Namespace:   c.namespace(),
 					Annotations: annotations,
 				},
 				Spec: corev1.PodSpec{
-					NodeSelector:       c.cfg.Installation.ControlPlaneNodeSelector,
-					ServiceAccountName: DexObjectName,
-					Tolerations:        append(c.cfg.Installation.ControlPlaneTolerations, rmeta.TolerateControlPlane...),
-					ImagePullSecrets:   secret.GetReferenceList(c.cfg.PullSecrets),
-					InitContainers:     initContainers,
+					NodeSelector:                  c.cfg.Installation.ControlPlaneNodeSelector,
+					ServiceAccountName:            DexObjectName,
+					TerminationGracePeriodSeconds: &terminationGracePeriod,
+					Tolerations:                   append(c.cfg.Installation.ControlPlaneTolerations, rmeta.TolerateControlPlane...),
+					ImagePullSecrets:              secret.GetReferenceList(c.cfg.PullSecrets),
+					InitContainers:                initContainers,
 					Containers: []corev1.Container{
 						{
 							Name:            DexObjectName,
@@ -291,7 +312,7 @@ func (c *dexComponent) deployment() client.Object {
 	}
 
 	if c.cfg.Installation.ControlPlaneReplicas != nil && *c.cfg.Installation.ControlPlaneReplicas > 1 {
-		d.Spec.Template.Spec.Affinity = podaffinity.NewPodAntiAffinity(DexObjectName, DexNamespace)
+		d.Spec.Template.Spec.Affinity = podaffinity.NewPodAntiAffinity(DexObjectName, c.namespace())
 	}
 
 	if c.cfg.Authentication != nil {
@@ -308,7 +329,7 @@ func (c *dexComponent) service() client.Object {
 		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      DexObjectName,
-			Namespace: DexNamespace,
+			Namespace: c.namespace(),
 		},
 		Spec: corev1.ServiceSpec{
 			Selector: map[string]string{
@@ -344,6 +365,13 @@ func (c *dexComponent) probe() *corev1.Probe {
 }
 
 func (c *dexComponent) configMap() *corev1.ConfigMap {
+	expiry := map[string]string{
+		// Default duration is 24h. This is too high for most organizations. Setting it to 15m.
+		"idTokens": "15m",
+	}
+	if c.cfg.Authentication != nil && c.cfg.Authentication.Spec.SigningKeyRotationPeriod != nil {
+		expiry["signingKeys"] = c.cfg.Authentication.Spec.SigningKeyRotationPeriod.Duration.String()
+	}
 	bytes, err := yaml.Marshal(map[string]interface{}{
 		"issuer": c.cfg.DexConfig.Issuer(),
 		"storage": map[string]interface{}{
@@ -372,10 +400,7 @@ func (c *dexComponent) configMap() *corev1.ConfigMap {
 				"secretEnv":    dexSecretEnv,
 			},
 		},
-		"expiry": map[string]string{
-			// Default duration is 24h. This is too high for most organizations. Setting it to 15m.
-			"idTokens": "15m",
-		},
+		"expiry": expiry,
 	})
 	if err != nil {
 		// Panic since this would be a developer error, as the marshaled struct is one created by our code.
@@ -385,7 +410,7 @@ func (c *dexComponent) configMap() *corev1.ConfigMap {
 		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      DexObjectName,
-			Namespace: DexNamespace,
+			Namespace: c.namespace(),
 		},
 		Data: map[string]string{
 			"config.yaml": string(bytes),
@@ -432,7 +457,7 @@ func (c *dexComponent) allowTigeraNetworkPolicy() *v3.NetworkPolicy {
 		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      DexPolicyName,
-			Namespace: DexNamespace,
+			Namespace: c.namespace(),
 		},
 		Spec: v3.NetworkPolicySpec{
 			Order:    &networkpolicy.HighPrecedenceOrder,
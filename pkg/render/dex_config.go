@@ -58,7 +58,7 @@ const (
 	BindPWSecretField            = "bindPW"
 
 	// OIDC well-known-config related constants.
-	jwksURI = "https://tigera-dex.tigera-dex.svc.%s:5556/dex/keys"
+	jwksURI = "https://tigera-dex.%s.svc.%s:5556/dex/keys"
 
 	// Env related constants.
 	googleAdminEmailEnv = "ADMIN_EMAIL"
@@ -265,10 +265,10 @@ func (d *DexKeyValidatorConfig) RequiredAnnotations() map[string]string {
 func (d *DexKeyValidatorConfig) RequiredEnv(prefix string) []corev1.EnvVar {
 	return []corev1.EnvVar{
 		{Name: fmt.Sprintf("%sDEX_ENABLED", prefix), Value: strconv.FormatBool(true)},
-		{Name: fmt.Sprintf("%sDEX_URL", prefix), Value: fmt.Sprintf("https://tigera-dex.tigera-dex.svc.%s:5556/", d.clusterDomain)},
+		{Name: fmt.Sprintf("%sDEX_URL", prefix), Value: fmt.Sprintf("https://tigera-dex.%s.svc.%s:5556/", GetDexNamespace(d.authentication), d.clusterDomain)},
 		{Name: fmt.Sprintf("%sOIDC_AUTH_ENABLED", prefix), Value: strconv.FormatBool(true)},
 		{Name: fmt.Sprintf("%sOIDC_AUTH_ISSUER", prefix), Value: fmt.Sprintf("%s/dex", d.BaseURL())},
-		{Name: fmt.Sprintf("%sOIDC_AUTH_JWKSURL", prefix), Value: fmt.Sprintf(jwksURI, d.clusterDomain)},
+		{Name: fmt.Sprintf("%sOIDC_AUTH_JWKSURL", prefix), Value: fmt.Sprintf(jwksURI, GetDexNamespace(d.authentication), d.clusterDomain)},
 		{Name: fmt.Sprintf("%sOIDC_AUTH_CLIENT_ID", prefix), Value: DexClientId},
 		{Name: fmt.Sprintf("%sOIDC_AUTH_USERNAME_CLAIM", prefix), Value: d.UsernameClaim()},
 		{Name: fmt.Sprintf("%sOIDC_AUTH_GROUPS_CLAIM", prefix), Value: DefaultGroupsClaim},
@@ -337,14 +337,14 @@ func (d *dexConfig) RequiredVolumeMounts() []corev1.VolumeMount {
 			ReadOnly:  true,
 		},
 	}
-	if d.idpSecret.Data[serviceAccountSecretField] != nil {
+	if d.idpSecret != nil && d.idpSecret.Data[serviceAccountSecretField] != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      "secrets",
 			MountPath: "/etc/dex/secrets",
 			ReadOnly:  true,
 		})
 	}
-	if d.idpSecret.Data[RootCASecretField] != nil {
+	if d.idpSecret != nil && d.idpSecret.Data[RootCASecretField] != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      "secrets",
 			MountPath: "/etc/ssl/certs/",
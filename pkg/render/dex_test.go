@@ -16,6 +16,7 @@ package render_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tigera/operator/test"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -26,9 +27,11 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
@@ -273,6 +276,55 @@ var _ = Describe("dex rendering tests", func() {
 			Entry("custom cluster domain", "custom.internal"),
 		)
 
+		It("should render the signing key rotation period into the dex config", func() {
+			authentication.Spec.SigningKeyRotationPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+			cfg.Authentication = authentication
+
+			component := render.Dex(cfg)
+			resources, _ := component.Objects()
+
+			cm, ok := rtest.GetResource(resources, "tigera-dex", "tigera-dex", "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			Expect(ok).To(BeTrue())
+			Expect(cm.Data["config.yaml"]).To(ContainSubstring("signingKeys: 24h0m0s"))
+			Expect(cm.Data["config.yaml"]).To(ContainSubstring("idTokens: 15m"))
+		})
+
+		It("should leave the signing key rotation to dex when no period is set", func() {
+			cfg.Authentication = authentication
+
+			component := render.Dex(cfg)
+			resources, _ := component.Objects()
+
+			cm, ok := rtest.GetResource(resources, "tigera-dex", "tigera-dex", "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			Expect(ok).To(BeTrue())
+			Expect(cm.Data["config.yaml"]).NotTo(ContainSubstring("signingKeys"))
+		})
+
+		It("should render all namespaced resources into a custom dex namespace", func() {
+			authentication.Spec.DexNamespace = "tenant-a-dex"
+			cfg.DexConfig = render.NewDexConfig(nil, authentication, dexSecret, idpSecret, clusterName)
+			cfg.Authentication = authentication
+
+			component := render.Dex(cfg)
+			resources, _ := component.Objects()
+
+			for _, r := range resources {
+				if r.GetNamespace() == "" || r.GetNamespace() == common.OperatorNamespace() {
+					continue
+				}
+				Expect(r.GetNamespace()).To(Equal("tenant-a-dex"), "%s %s", r.GetObjectKind().GroupVersionKind().Kind, r.GetName())
+			}
+			Expect(rtest.GetResource(resources, render.DexObjectName, "tenant-a-dex", "apps", "v1", "Deployment")).NotTo(BeNil())
+			Expect(rtest.GetResource(resources, render.DexPolicyName, "tenant-a-dex", "projectcalico.org", "v3", "NetworkPolicy")).NotTo(BeNil())
+
+			crb := rtest.GetResource(resources, render.DexObjectName, "", rbac, "v1", "ClusterRoleBinding").(*rbacv1.ClusterRoleBinding)
+			Expect(crb.Subjects[0].Namespace).To(Equal("tenant-a-dex"))
+
+			validatorEnv := render.NewDexKeyValidatorConfig(authentication, idpSecret, clusterName).RequiredEnv("")
+			Expect(validatorEnv[1].Value).To(Equal(fmt.Sprintf("https://tigera-dex.tenant-a-dex.svc.%s:5556/", clusterName)))
+			Expect(validatorEnv[4].Value).To(Equal(fmt.Sprintf("https://tigera-dex.tenant-a-dex.svc.%s:5556/dex/keys", clusterName)))
+		})
+
 		It("should apply tolerations", func() {
 			t := corev1.Toleration{
 				Key:      "foo",
@@ -434,6 +486,46 @@ var _ = Describe("dex rendering tests", func() {
 			Expect(initContainer.Resources).To(Equal(dexInitContainerResources))
 
 		})
+		It("should render a rolling update strategy and grace period by default", func() {
+			component := render.Dex(cfg)
+			resources, _ := component.Objects()
+			deploy, ok := rtest.GetResource(resources, render.DexObjectName, render.DexNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(deploy.Spec.Strategy).To(Equal(appsv1.DeploymentStrategy{Type: appsv1.RollingUpdateDeploymentStrategyType}))
+			Expect(*deploy.Spec.Template.Spec.TerminationGracePeriodSeconds).To(Equal(render.DexTerminationGracePeriodSeconds))
+		})
+
+		It("should render the strategy and grace period overrides", func() {
+			maxUnavailable := intstr.FromInt(0)
+			maxSurge := intstr.FromInt(1)
+			gracePeriod := int64(60)
+			cfg.Authentication = &operatorv1.Authentication{
+				Spec: operatorv1.AuthenticationSpec{
+					DexDeployment: &operatorv1.DexDeployment{
+						Spec: &operatorv1.DexDeploymentSpec{
+							Strategy: &operatorv1.DexDeploymentStrategy{
+								RollingUpdate: &appsv1.RollingUpdateDeployment{MaxUnavailable: &maxUnavailable, MaxSurge: &maxSurge},
+							},
+							Template: &operatorv1.DexDeploymentPodTemplateSpec{
+								Spec: &operatorv1.DexDeploymentPodSpec{
+									TerminationGracePeriodSeconds: &gracePeriod,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			component := render.Dex(cfg)
+			resources, _ := component.Objects()
+			deploy, ok := rtest.GetResource(resources, render.DexObjectName, render.DexNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(deploy.Spec.Strategy.Type).To(Equal(appsv1.RollingUpdateDeploymentStrategyType))
+			Expect(*deploy.Spec.Strategy.RollingUpdate.MaxUnavailable).To(Equal(maxUnavailable))
+			Expect(*deploy.Spec.Strategy.RollingUpdate.MaxSurge).To(Equal(maxSurge))
+			Expect(*deploy.Spec.Template.Spec.TerminationGracePeriodSeconds).To(Equal(gracePeriod))
+		})
+
 		It("should render configuration with default Init container resource requests and limits", func() {
 			ca, _ := tls.MakeCA(rmeta.DefaultOperatorCASignerName())
 			cert, _, _ := ca.Config.GetPEMBytes() // create a valid pem block
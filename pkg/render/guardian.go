@@ -17,7 +17,14 @@
 package render
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
 	"net"
+	"path"
+	"strconv"
+	"strings"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -53,7 +60,23 @@ const (
 	GuardianVolumeName             = "tigera-guardian-certs"
 	GuardianSecretName             = "tigera-managed-cluster-connection"
 	GuardianTargetPort             = 8080
+	GuardianHealthPort             = 9080
+	GuardianDefaultTunnelPort      = 9443
+	GuardianDebugPort              = 9081
+	GuardianTokenVolumeName        = "guardian-token"
+	GuardianTokenMountPath         = "/var/run/secrets/tigera/guardian"
 	GuardianPolicyName             = networkpolicy.TigeraComponentPolicyPrefix + "guardian-access"
+
+	// GuardianTierReadyConditionType is the pod condition the operator sets on guardian pods once the allow-tigera
+	// tier exists. It is used as a readiness gate when spec.tierReadinessGate is enabled.
+	GuardianTierReadyConditionType corev1.PodConditionType = "projectcalico.org/TierReady"
+
+	// GuardianBackendCABundleKey is the key of the CA bundle in the secrets referenced by the backend CA bundles.
+	GuardianBackendCABundleKey = "ca.crt"
+	// GuardianBackendCABundleLabel is set on the copies of the backend CA bundle secrets in the guardian namespace,
+	// so that copies which are no longer referenced can be found and removed.
+	GuardianBackendCABundleLabel = "operator.tigera.io/guardian-backend-ca-bundle"
+	guardianBackendCABundleDir   = "/etc/pki/guardian"
 )
 
 var (
@@ -90,9 +113,48 @@ type GuardianConfiguration struct {
 	TrustedCertBundle certificatemanagement.TrustedBundle
 	TunnelCAType      operatorv1.CAType
 
+	// The secrets holding the CA bundles of individual backends, if any. Backends without one use TrustedCertBundle.
+	PacketCaptureCABundle *corev1.Secret
+	PrometheusCABundle    *corev1.Secret
+	QueryServerCABundle   *corev1.Secret
+
+	// The names of the backend CA bundle copies currently in the guardian namespace. Those that are no longer
+	// referenced are deleted.
+	BackendCABundleCopies []string
+
 	// Whether the cluster supports pod security policies.
 	UsePSP                      bool
 	ManagementClusterConnection *operatorv1.ManagementClusterConnection
+
+	// Whether guardian proxies Elasticsearch and Kibana requests to the management cluster. The corresponding
+	// ports are only added to the guardian service when set.
+	ElasticsearchProxyEnabled bool
+	KibanaProxyEnabled        bool
+}
+
+// debugConfigEndpointEnabled returns whether guardian serves its effective configuration on the debug port.
+func (cfg *GuardianConfiguration) debugConfigEndpointEnabled() bool {
+	if cfg.ManagementClusterConnection == nil {
+		return false
+	}
+	e := cfg.ManagementClusterConnection.Spec.DebugConfigEndpoint
+	return e != nil && *e == operatorv1.DebugConfigEndpointEnabled
+}
+
+// tierReadinessGateEnabled returns whether guardian's readiness is gated on the allow-tigera tier.
+func (cfg *GuardianConfiguration) tierReadinessGateEnabled() bool {
+	if cfg.ManagementClusterConnection == nil || cfg.Installation.Variant != operatorv1.TigeraSecureEnterprise {
+		return false
+	}
+	g := cfg.ManagementClusterConnection.Spec.TierReadinessGate
+	return g != nil && *g == operatorv1.TierReadinessGateEnabled
+}
+
+func (cfg *GuardianConfiguration) ipFamilyPreference() operatorv1.IPFamily {
+	if cfg.ManagementClusterConnection != nil && cfg.ManagementClusterConnection.Spec.IPFamilyPreference != nil {
+		return *cfg.ManagementClusterConnection.Spec.IPFamilyPreference
+	}
+	return ""
 }
 
 type GuardianComponent struct {
@@ -115,7 +177,7 @@ func (c *GuardianComponent) SupportedOSType() rmeta.OSType {
 
 func (c *GuardianComponent) Objects() ([]client.Object, []client.Object) {
 	objs := []client.Object{
-		CreateNamespace(GuardianNamespace, c.cfg.Installation.KubernetesProvider, PSSRestricted),
+		CreateNamespace(GuardianNamespace, c.cfg.Installation, PSSRestricted),
 	}
 
 	objs = append(objs, secret.ToRuntimeObjects(secret.CopyToNamespace(GuardianNamespace, c.cfg.PullSecrets...)...)...)
@@ -130,7 +192,7 @@ func (c *GuardianComponent) Objects() ([]client.Object, []client.Object) {
 
 		// Add tigera-manager service account for impersonation. In managed clusters, the tigera-manager
 		// service account is always within the tigera-manager namespace - regardless of (multi)tenancy mode.
-		CreateNamespace(ManagerNamespace, c.cfg.Installation.KubernetesProvider, PSSRestricted),
+		CreateNamespace(ManagerNamespace, c.cfg.Installation, PSSRestricted),
 		managerServiceAccount(ManagerNamespace),
 		managerClusterRole(false, true, c.cfg.UsePSP, c.cfg.Installation.KubernetesProvider),
 		managerClusterRoleBinding([]string{ManagerNamespace}),
@@ -141,11 +203,12 @@ func (c *GuardianComponent) Objects() ([]client.Object, []client.Object) {
 		managerClusterWideTigeraLayer(),
 		managerClusterWideDefaultView(),
 	)
+	objs = append(objs, secret.ToRuntimeObjects(c.backendCABundleCopies()...)...)
 
 	if c.cfg.UsePSP {
 		objs = append(objs, c.podSecurityPolicy())
 	}
-	return objs, nil
+	return objs, c.staleBackendCABundleCopies()
 }
 
 func (c *GuardianComponent) Ready() bool {
@@ -153,7 +216,7 @@ func (c *GuardianComponent) Ready() bool {
 }
 
 func (c *GuardianComponent) service() *corev1.Service {
-	return &corev1.Service{
+	svc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      GuardianServiceName,
 			Namespace: GuardianNamespace,
@@ -172,33 +235,52 @@ func (c *GuardianComponent) service() *corev1.Service {
 					},
 					Protocol: corev1.ProtocolTCP,
 				},
-				{
-					Name: "elasticsearch",
-					Port: 9200,
-					TargetPort: intstr.IntOrString{
-						Type:   intstr.Int,
-						IntVal: 8080,
-					},
-					Protocol: corev1.ProtocolTCP,
-				},
-				{
-					Name: "kibana",
-					Port: 5601,
-					TargetPort: intstr.IntOrString{
-						Type:   intstr.Int,
-						IntVal: 8080,
-					},
-					Protocol: corev1.ProtocolTCP,
-				},
 			},
 		},
 	}
+
+	if c.cfg.ElasticsearchProxyEnabled {
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Name: "elasticsearch",
+			Port: 9200,
+			TargetPort: intstr.IntOrString{
+				Type:   intstr.Int,
+				IntVal: 8080,
+			},
+			Protocol: corev1.ProtocolTCP,
+		})
+	}
+	if c.cfg.KibanaProxyEnabled {
+		svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+			Name: "kibana",
+			Port: 5601,
+			TargetPort: intstr.IntOrString{
+				Type:   intstr.Int,
+				IntVal: 8080,
+			},
+			Protocol: corev1.ProtocolTCP,
+		})
+	}
+
+	if c.cfg.ManagementClusterConnection != nil {
+		if s := c.cfg.ManagementClusterConnection.Spec.GuardianService; s != nil && s.Type != nil && *s.Type == corev1.ServiceTypeNodePort {
+			svc.Spec.Type = corev1.ServiceTypeNodePort
+			if s.NodePort != nil {
+				svc.Spec.Ports[0].NodePort = *s.NodePort
+			}
+		}
+	}
+	return svc
 }
 
 func (c *GuardianComponent) serviceAccount() *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
-		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
-		ObjectMeta: metav1.ObjectMeta{Name: GuardianServiceAccountName, Namespace: GuardianNamespace},
+		TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        GuardianServiceAccountName,
+			Namespace:   GuardianNamespace,
+			Annotations: rmeta.GetServiceAccountAnnotations(c.cfg.Installation, operatorv1.ComponentNameGuardian),
+		},
 	}
 }
 
@@ -287,16 +369,30 @@ func (c *GuardianComponent) deployment() *appsv1.Deployment {
 		},
 	}
 
+	if c.cfg.tierReadinessGateEnabled() {
+		d.Spec.Template.Spec.ReadinessGates = []corev1.PodReadinessGate{{ConditionType: GuardianTierReadyConditionType}}
+	}
+
+	if delay := c.shutdownDrainDelay(); delay > 0 {
+		// Guardian keeps running for the delay once it is told to stop, and then drains in-flight requests for up to
+		// the same delay. Leave time for both on top of the default grace period.
+		gracePeriod := int64(corev1.DefaultTerminationGracePeriodSeconds) + 2*int64(math.Ceil(delay.Seconds()))
+		d.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+	}
+
 	if c.cfg.ManagementClusterConnection != nil {
 		if overrides := c.cfg.ManagementClusterConnection.Spec.GuardianDeployment; overrides != nil {
 			rcomponents.ApplyDeploymentOverrides(d, overrides)
+
+			// Add any user-provided init containers after those managed by the operator.
+			d.Spec.Template.Spec.InitContainers = append(d.Spec.Template.Spec.InitContainers, overrides.GetExtraInitContainers()...)
 		}
 	}
 	return d
 }
 
 func (c *GuardianComponent) volumes() []corev1.Volume {
-	return []corev1.Volume{
+	volumes := []corev1.Volume{
 		c.cfg.TrustedCertBundle.Volume(),
 		{
 			Name: GuardianVolumeName,
@@ -307,58 +403,307 @@ func (c *GuardianComponent) volumes() []corev1.Volume {
 			},
 		},
 	}
-}
-
-func (c *GuardianComponent) container() []corev1.Container {
-	return []corev1.Container{
-		{
-			Name:            GuardianDeploymentName,
-			Image:           c.image,
-			ImagePullPolicy: ImagePullPolicy(),
-			Env: []corev1.EnvVar{
-				{Name: "GUARDIAN_PORT", Value: "9443"},
-				{Name: "GUARDIAN_LOGLEVEL", Value: "INFO"},
-				{Name: "GUARDIAN_VOLTRON_URL", Value: c.cfg.URL},
-				{Name: "GUARDIAN_VOLTRON_CA_TYPE", Value: string(c.cfg.TunnelCAType)},
-				{Name: "GUARDIAN_PACKET_CAPTURE_CA_BUNDLE_PATH", Value: c.cfg.TrustedCertBundle.MountPath()},
-				{Name: "GUARDIAN_PROMETHEUS_CA_BUNDLE_PATH", Value: c.cfg.TrustedCertBundle.MountPath()},
-				{Name: "GUARDIAN_QUERYSERVER_CA_BUNDLE_PATH", Value: c.cfg.TrustedCertBundle.MountPath()},
-				{Name: "GUARDIAN_FIPS_MODE_ENABLED", Value: operatorv1.IsFIPSModeEnabledString(c.cfg.Installation.FIPSMode)},
-			},
-			VolumeMounts: c.volumeMounts(),
-			LivenessProbe: &corev1.Probe{
-				ProbeHandler: corev1.ProbeHandler{
-					HTTPGet: &corev1.HTTPGetAction{
-						Path: "/health",
-						Port: intstr.FromInt(9080),
+	if t := c.serviceAccountToken(); t != nil {
+		expirationSeconds := int64(3600)
+		if t.ExpirationSeconds != nil {
+			expirationSeconds = *t.ExpirationSeconds
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name: GuardianTokenVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						{
+							ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+								Audience:          t.Audience,
+								ExpirationSeconds: &expirationSeconds,
+								Path:              "token",
+							},
+						},
 					},
 				},
-				InitialDelaySeconds: 90,
 			},
-			ReadinessProbe: &corev1.Probe{
-				ProbeHandler: corev1.ProbeHandler{
-					HTTPGet: &corev1.HTTPGetAction{
-						Path: "/health",
-						Port: intstr.FromInt(9080),
-					},
+		})
+	}
+	for _, b := range c.backendCABundles() {
+		if b.secret == nil {
+			continue
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name: b.volumeName(),
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: b.secret.Name,
+					Items:      []corev1.KeyToPath{{Key: GuardianBackendCABundleKey, Path: GuardianBackendCABundleKey}},
+				},
+			},
+		})
+	}
+	return volumes
+}
+
+// serviceAccountToken returns the configuration of guardian's projected service account token, if any.
+func (c *GuardianComponent) serviceAccountToken() *operatorv1.GuardianServiceAccountToken {
+	if c.cfg.ManagementClusterConnection == nil {
+		return nil
+	}
+	return c.cfg.ManagementClusterConnection.Spec.ServiceAccountToken
+}
+
+func (c *GuardianComponent) container() []corev1.Container {
+	env := []corev1.EnvVar{
+		{Name: "GUARDIAN_PORT", Value: strconv.Itoa(int(c.tunnelPort()))},
+		{Name: "GUARDIAN_LOGLEVEL", Value: "INFO"},
+		{Name: "GUARDIAN_VOLTRON_URL", Value: c.cfg.URL},
+		{Name: "GUARDIAN_VOLTRON_CA_TYPE", Value: string(c.cfg.TunnelCAType)},
+	}
+	for _, b := range c.backendCABundles() {
+		env = append(env, corev1.EnvVar{Name: b.envVar, Value: c.backendCABundlePath(b)})
+	}
+	env = append(env, corev1.EnvVar{Name: "GUARDIAN_FIPS_MODE_ENABLED", Value: operatorv1.IsFIPSModeEnabledString(c.cfg.Installation.FIPSMode)})
+
+	container := corev1.Container{
+		Name:            GuardianDeploymentName,
+		Image:           c.image,
+		ImagePullPolicy: ImagePullPolicy(),
+		Env:             append(env, c.managementClusterConnectionEnv()...),
+		VolumeMounts:    c.volumeMounts(),
+		LivenessProbe:   c.livenessProbe(),
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: "/health",
+					Port: intstr.FromInt(GuardianHealthPort),
 				},
-				InitialDelaySeconds: 10,
 			},
-			SecurityContext: securitycontext.NewNonRootContext(),
+			InitialDelaySeconds: 10,
+		},
+		SecurityContext: securitycontext.NewNonRootContext(),
+	}
+	return []corev1.Container{container}
+}
+
+// shutdownDrainDelay returns how long guardian drains connections for when it is terminated, or 0 if it does not.
+func (c *GuardianComponent) shutdownDrainDelay() time.Duration {
+	if c.cfg.ManagementClusterConnection == nil || c.cfg.ManagementClusterConnection.Spec.ShutdownDrainDelay == nil {
+		return 0
+	}
+	return c.cfg.ManagementClusterConnection.Spec.ShutdownDrainDelay.Duration
+}
+
+// guardianBackendCABundle is the CA bundle guardian uses to verify one of its backend services.
+type guardianBackendCABundle struct {
+	// backend names the backend service. It is used to name the volume and mount path of the bundle.
+	backend string
+	envVar  string
+
+	// secret holds the bundle. If nil, the shared trusted bundle is used instead.
+	secret *corev1.Secret
+}
+
+func (c *GuardianComponent) backendCABundles() []guardianBackendCABundle {
+	return []guardianBackendCABundle{
+		{backend: "packet-capture", envVar: "GUARDIAN_PACKET_CAPTURE_CA_BUNDLE_PATH", secret: c.cfg.PacketCaptureCABundle},
+		{backend: "prometheus", envVar: "GUARDIAN_PROMETHEUS_CA_BUNDLE_PATH", secret: c.cfg.PrometheusCABundle},
+		{backend: "queryserver", envVar: "GUARDIAN_QUERYSERVER_CA_BUNDLE_PATH", secret: c.cfg.QueryServerCABundle},
+	}
+}
+
+// backendCABundleSecrets returns the distinct secrets holding backend CA bundles, which are copied to the guardian
+// namespace.
+func (c *GuardianComponent) backendCABundleSecrets() []*corev1.Secret {
+	var secrets []*corev1.Secret
+	seen := map[string]bool{}
+	for _, b := range c.backendCABundles() {
+		if b.secret != nil && !seen[b.secret.Name] {
+			seen[b.secret.Name] = true
+			secrets = append(secrets, b.secret)
+		}
+	}
+	return secrets
+}
+
+// backendCABundleCopies returns the copies of the backend CA bundle secrets in the guardian namespace.
+func (c *GuardianComponent) backendCABundleCopies() []*corev1.Secret {
+	copies := secret.CopyToNamespace(GuardianNamespace, c.backendCABundleSecrets()...)
+	for _, s := range copies {
+		s.Labels = map[string]string{GuardianBackendCABundleLabel: ""}
+	}
+	return copies
+}
+
+// staleBackendCABundleCopies returns the backend CA bundle copies in the guardian namespace that are no longer
+// referenced.
+func (c *GuardianComponent) staleBackendCABundleCopies() []client.Object {
+	referenced := map[string]bool{}
+	for _, s := range c.backendCABundleSecrets() {
+		referenced[s.Name] = true
+	}
+	var stale []client.Object
+	for _, name := range c.cfg.BackendCABundleCopies {
+		if !referenced[name] {
+			stale = append(stale, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: GuardianNamespace}})
+		}
+	}
+	return stale
+}
+
+func (c *GuardianComponent) backendCABundlePath(b guardianBackendCABundle) string {
+	if b.secret == nil {
+		return c.cfg.TrustedCertBundle.MountPath()
+	}
+	return path.Join(guardianBackendCABundleDir, b.backend, GuardianBackendCABundleKey)
+}
+
+func (b guardianBackendCABundle) volumeName() string {
+	return fmt.Sprintf("guardian-%s-ca-bundle", b.backend)
+}
+
+// managementClusterConnectionEnv returns the environment variables for optional ManagementClusterConnection settings.
+func (c *GuardianComponent) managementClusterConnectionEnv() []corev1.EnvVar {
+	if c.cfg.ManagementClusterConnection == nil {
+		return nil
+	}
+	var env []corev1.EnvVar
+	spec := c.cfg.ManagementClusterConnection.Spec
+	if spec.IPFamilyPreference != nil {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_IP_FAMILY_PREFERENCE", Value: string(*spec.IPFamilyPreference)})
+	}
+	if spec.MaxRequestHeaderBytes != nil {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_MAX_REQUEST_HEADER_BYTES", Value: strconv.Itoa(int(*spec.MaxRequestHeaderBytes))})
+	}
+	if spec.TunnelMaxFrameBytes != nil {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_TUNNEL_MAX_FRAME_BYTES", Value: strconv.Itoa(int(*spec.TunnelMaxFrameBytes))})
+	}
+	if p := spec.BackendRetryPolicy; p != nil {
+		if p.MaxRetries != nil {
+			env = append(env, corev1.EnvVar{Name: "GUARDIAN_BACKEND_RETRY_COUNT", Value: strconv.Itoa(int(*p.MaxRetries))})
+		}
+		if p.Backoff != nil {
+			env = append(env, corev1.EnvVar{Name: "GUARDIAN_BACKEND_RETRY_BACKOFF", Value: p.Backoff.Duration.String()})
+		}
+	}
+	if spec.DNSCacheTTL != nil {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_DNS_CACHE_TTL", Value: spec.DNSCacheTTL.Duration.String()})
+	}
+	if spec.TunnelCompression != nil {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_TUNNEL_COMPRESSION", Value: strconv.FormatBool(*spec.TunnelCompression == operatorv1.TunnelCompressionEnabled)})
+	}
+	if spec.RequestCoalescing != nil {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_REQUEST_COALESCING", Value: strconv.FormatBool(*spec.RequestCoalescing == operatorv1.RequestCoalescingEnabled)})
+	}
+	if spec.InsecureSkipTLSVerify {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_INSECURE_SKIP_TLS_VERIFY", Value: "true"})
+	}
+	if l := spec.AccessLogging; l != nil && l.State != nil && *l.State == operatorv1.AccessLoggingEnabled {
+		format := operatorv1.AccessLogFormatText
+		if l.Format != nil {
+			format = *l.Format
+		}
+		env = append(env,
+			corev1.EnvVar{Name: "GUARDIAN_ACCESS_LOG_ENABLED", Value: "true"},
+			corev1.EnvVar{Name: "GUARDIAN_ACCESS_LOG_FORMAT", Value: strings.ToLower(string(format))},
+		)
+	}
+	if len(spec.TunnelLatencyBuckets) > 0 {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_TUNNEL_LATENCY_BUCKETS", Value: strings.Join(spec.TunnelLatencyBuckets, ",")})
+	}
+	if spec.Workers != nil {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_WORKERS", Value: strconv.Itoa(int(*spec.Workers))})
+	}
+	if l := spec.TunnelBandwidthLimit; l != nil {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_TUNNEL_BANDWIDTH_LIMIT", Value: strconv.FormatInt(l.Value(), 10)})
+	}
+	if spec.ServiceAccountToken != nil {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_SERVICE_ACCOUNT_TOKEN_PATH", Value: GuardianTokenMountPath + "/token"})
+	}
+	if len(spec.TunnelHeaders) > 0 {
+		// Header values may contain commas, so pass the headers as a JSON object. Keys are sorted by the encoder,
+		// which keeps the rendered value stable across reconciles.
+		headers, _ := json.Marshal(spec.TunnelHeaders)
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_TUNNEL_HEADERS", Value: string(headers)})
+	}
+	if len(spec.ClusterLabels) > 0 {
+		labels, _ := json.Marshal(spec.ClusterLabels)
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_CLUSTER_LABELS", Value: string(labels)})
+	}
+	if delay := c.shutdownDrainDelay(); delay > 0 {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_SHUTDOWN_DRAIN_DELAY", Value: delay.String()})
+	}
+	if spec.HeartbeatInterval != nil {
+		env = append(env, corev1.EnvVar{Name: "GUARDIAN_HEARTBEAT_INTERVAL", Value: spec.HeartbeatInterval.Duration.String()})
+	}
+	if c.cfg.debugConfigEndpointEnabled() {
+		env = append(env,
+			corev1.EnvVar{Name: "GUARDIAN_DEBUG_CONFIG_ENABLED", Value: "true"},
+			corev1.EnvVar{Name: "GUARDIAN_DEBUG_PORT", Value: strconv.Itoa(GuardianDebugPort)},
+		)
+	}
+	return env
+}
+
+// tunnelPort returns the port guardian listens on for the tunnel.
+func (c *GuardianComponent) tunnelPort() int32 {
+	if c.cfg.ManagementClusterConnection != nil && c.cfg.ManagementClusterConnection.Spec.TunnelPort != nil {
+		return *c.cfg.ManagementClusterConnection.Spec.TunnelPort
+	}
+	return GuardianDefaultTunnelPort
+}
+
+func (c *GuardianComponent) livenessProbe() *corev1.Probe {
+	handler := corev1.ProbeHandler{
+		HTTPGet: &corev1.HTTPGetAction{
+			Path: "/health",
+			Port: intstr.FromInt(GuardianHealthPort),
 		},
 	}
+	if c.cfg.ManagementClusterConnection != nil {
+		if d := c.cfg.ManagementClusterConnection.Spec.GuardianDeployment; d != nil {
+			if t := d.GetLivenessProbeType(GuardianDeploymentName); t != nil && *t == operatorv1.GuardianProbeTypeTCP {
+				handler = corev1.ProbeHandler{
+					TCPSocket: &corev1.TCPSocketAction{
+						Port: intstr.FromInt(GuardianHealthPort),
+					},
+				}
+			}
+		}
+	}
+	return &corev1.Probe{
+		ProbeHandler:        handler,
+		InitialDelaySeconds: 90,
+	}
 }
 
 func (c *GuardianComponent) volumeMounts() []corev1.VolumeMount {
-	return append(
+	mounts := append(
 		c.cfg.TrustedCertBundle.VolumeMounts(c.SupportedOSType()),
 		corev1.VolumeMount{Name: GuardianVolumeName, MountPath: "/certs/", ReadOnly: true},
 	)
+	if c.serviceAccountToken() != nil {
+		mounts = append(mounts, corev1.VolumeMount{Name: GuardianTokenVolumeName, MountPath: GuardianTokenMountPath, ReadOnly: true})
+	}
+	for _, b := range c.backendCABundles() {
+		if b.secret != nil {
+			mounts = append(mounts, corev1.VolumeMount{Name: b.volumeName(), MountPath: path.Join(guardianBackendCABundleDir, b.backend), ReadOnly: true})
+		}
+	}
+	return mounts
 }
 
 func (c *GuardianComponent) annotations() map[string]string {
 	annotations := c.cfg.TrustedCertBundle.HashAnnotations()
 	annotations["hash.operator.tigera.io/tigera-managed-cluster-connection"] = rmeta.AnnotationHash(c.cfg.TunnelSecret.Data)
+	for _, s := range c.backendCABundleSecrets() {
+		annotations[fmt.Sprintf("hash.operator.tigera.io/%s", s.Name)] = rmeta.AnnotationHash(s.Data)
+	}
+	if c.cfg.ManagementClusterConnection != nil {
+		if egw := c.cfg.ManagementClusterConnection.Spec.EgressGateway; egw != nil {
+			annotations["egress.projectcalico.org/selector"] = egw.Selector
+			if egw.NamespaceSelector != "" {
+				annotations["egress.projectcalico.org/namespaceSelector"] = egw.NamespaceSelector
+			}
+		}
+	}
 	return annotations
 }
 
@@ -410,18 +755,20 @@ func guardianAllowTigeraPolicy(cfg *GuardianConfiguration) (*v3.NetworkPolicy, e
 			},
 		})
 	} else {
-		var netSuffix string
-		if parsedIp.To4() != nil {
-			netSuffix = "/32"
-		} else {
-			netSuffix = "/128"
+		cidr := parsedIp.String() + "/128"
+		if ipv4 := parsedIp.To4(); ipv4 != nil {
+			cidr = ipv4.String() + "/32"
+			// An IPv4-mapped IPv6 address is allowed as an IPv6 network if IPv6 is preferred.
+			if strings.Contains(host, ":") && cfg.ipFamilyPreference() == operatorv1.IPFamilyIPv6 {
+				cidr = "::ffff:" + ipv4.String() + "/128"
+			}
 		}
 
 		egressRules = append(egressRules, v3.Rule{
 			Action:   v3.Allow,
 			Protocol: &networkpolicy.TCPProtocol,
 			Destination: v3.EntityRule{
-				Nets:  []string{parsedIp.String() + netSuffix},
+				Nets:  []string{cidr},
 				Ports: []numorstring.Port{parsedPort},
 			},
 		})
@@ -480,6 +827,16 @@ func guardianAllowTigeraPolicy(cfg *GuardianConfiguration) (*v3.NetworkPolicy, e
 			Destination: guardianIngressDestinationEntityRule,
 		},
 	}
+	if cfg.debugConfigEndpointEnabled() {
+		// The debug endpoint exposes guardian's configuration, so only allow it to be reached from within the guardian
+		// namespace.
+		ingressRules = append(ingressRules, v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      v3.EntityRule{NamespaceSelector: fmt.Sprintf("projectcalico.org/name == '%s'", GuardianNamespace)},
+			Destination: v3.EntityRule{Ports: networkpolicy.Ports(GuardianDebugPort)},
+		})
+	}
 
 	policy := &v3.NetworkPolicy{
 		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
@@ -15,6 +15,8 @@
 package render_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -28,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
@@ -36,6 +39,7 @@ import (
 	"github.com/tigera/operator/pkg/components"
 	"github.com/tigera/operator/pkg/controller/certificatemanager"
 	ctrlrfake "github.com/tigera/operator/pkg/ctrlruntime/client/fake"
+	"github.com/tigera/operator/pkg/ptr"
 	"github.com/tigera/operator/pkg/render"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
 	"github.com/tigera/operator/pkg/render/common/networkpolicy"
@@ -237,6 +241,54 @@ var _ = Describe("Rendering tests", func() {
 				Expect(managementClusterEgressRule.Destination.Domains).To(Equal([]string{"mydomain.io"}))
 				Expect(managementClusterEgressRule.Destination.Ports).To(Equal(networkpolicy.Ports(8080)))
 			})
+
+			DescribeTable("should allow the management cluster address as the preferred IP family",
+				func(addr string, preference *operatorv1.IPFamily, expectedNet string) {
+					cfg := createGuardianConfig(operatorv1.InstallationSpec{Registry: "my-reg/"}, addr, false)
+					cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+						Spec: operatorv1.ManagementClusterConnectionSpec{IPFamilyPreference: preference},
+					}
+					g, err := render.GuardianPolicy(cfg)
+					Expect(err).NotTo(HaveOccurred())
+					resources, _ = g.Objects()
+					policy := testutils.GetAllowTigeraPolicyFromResources(policyName, resources)
+					managementClusterEgressRule := policy.Spec.Egress[5]
+					Expect(managementClusterEgressRule.Destination.Nets).To(Equal([]string{expectedNet}))
+				},
+				Entry("IPv4 address, no preference", "10.0.0.1:9449", nil, "10.0.0.1/32"),
+				Entry("IPv6 address, no preference", "[2001:db8::1]:9449", nil, "2001:db8::1/128"),
+				Entry("IPv4-mapped address, no preference", "[::ffff:10.0.0.1]:9449", nil, "10.0.0.1/32"),
+				Entry("IPv4-mapped address, IPv4 preferred", "[::ffff:10.0.0.1]:9449", ptrIPFamily(operatorv1.IPFamilyIPv4), "10.0.0.1/32"),
+				Entry("IPv4-mapped address, IPv6 preferred", "[::ffff:10.0.0.1]:9449", ptrIPFamily(operatorv1.IPFamilyIPv6), "::ffff:10.0.0.1/128"),
+				Entry("IPv4 address, IPv6 preferred", "10.0.0.1:9449", ptrIPFamily(operatorv1.IPFamilyIPv6), "10.0.0.1/32"),
+			)
+
+			It("should allow the debug config endpoint only from the guardian namespace when it is enabled", func() {
+				cfg := createGuardianConfig(operatorv1.InstallationSpec{Registry: "my-reg/"}, "127.0.0.1:1234", false)
+				enabled := operatorv1.DebugConfigEndpointEnabled
+				cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+					Spec: operatorv1.ManagementClusterConnectionSpec{DebugConfigEndpoint: &enabled},
+				}
+				g, err := render.GuardianPolicy(cfg)
+				Expect(err).NotTo(HaveOccurred())
+				resources, _ = g.Objects()
+				policy := testutils.GetAllowTigeraPolicyFromResources(policyName, resources)
+				Expect(policy.Spec.Ingress).To(ContainElement(v3.Rule{
+					Action:      v3.Allow,
+					Protocol:    &networkpolicy.TCPProtocol,
+					Source:      v3.EntityRule{NamespaceSelector: "projectcalico.org/name == 'tigera-guardian'"},
+					Destination: v3.EntityRule{Ports: networkpolicy.Ports(9081)},
+				}))
+			})
+
+			It("should not allow the debug config endpoint by default", func() {
+				renderGuardianPolicy("127.0.0.1:1234", false)
+				policy := testutils.GetAllowTigeraPolicyFromResources(policyName, resources)
+				for _, rule := range policy.Spec.Ingress {
+					Expect(rule.Destination.Ports).NotTo(ContainElement(networkpolicy.Ports(9081)[0]))
+				}
+			})
+
 		})
 	})
 })
@@ -335,5 +387,841 @@ var _ = Describe("guardian", func() {
 			Expect(container).NotTo(BeNil())
 			Expect(container.Resources).To(Equal(guardianResources))
 		})
+
+		It("should render an HTTP liveness probe by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			Expect(container.LivenessProbe.HTTPGet).NotTo(BeNil())
+			Expect(container.LivenessProbe.TCPSocket).To(BeNil())
+		})
+
+		It("should render a TCP liveness probe when configured", func() {
+			tcp := operatorv1.GuardianProbeTypeTCP
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{
+					GuardianDeployment: &operatorv1.GuardianDeployment{
+						Spec: &operatorv1.GuardianDeploymentSpec{
+							Template: &operatorv1.GuardianDeploymentPodTemplateSpec{
+								Spec: &operatorv1.GuardianDeploymentPodSpec{
+									Containers: []operatorv1.GuardianDeploymentContainer{{
+										Name:              render.GuardianDeploymentName,
+										LivenessProbeType: &tcp,
+									}},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			Expect(container.LivenessProbe.HTTPGet).To(BeNil())
+			Expect(container.LivenessProbe.TCPSocket).NotTo(BeNil())
+			Expect(container.LivenessProbe.TCPSocket.Port).To(Equal(intstr.FromInt(9080)))
+			Expect(container.LivenessProbe.InitialDelaySeconds).To(BeEquivalentTo(90))
+
+			// The readiness probe is unchanged.
+			Expect(container.ReadinessProbe.HTTPGet).NotTo(BeNil())
+		})
+
+		DescribeTable("should render the IP family preference when configured",
+			func(preference operatorv1.IPFamily) {
+				cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+					Spec: operatorv1.ManagementClusterConnectionSpec{IPFamilyPreference: &preference},
+				}
+				g := render.Guardian(cfg)
+				resources, _ := g.Objects()
+				deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+				container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+				rtest.ExpectEnv(container.Env, "GUARDIAN_IP_FAMILY_PREFERENCE", string(preference))
+			},
+			Entry("IPv4", operatorv1.IPFamilyIPv4),
+			Entry("IPv6", operatorv1.IPFamilyIPv6),
+		)
+
+		It("should render the max request header size when configured", func() {
+			maxHeaderBytes := int32(65536)
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{MaxRequestHeaderBytes: &maxHeaderBytes},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_MAX_REQUEST_HEADER_BYTES", "65536")
+		})
+
+		It("should render the tunnel max frame size when configured", func() {
+			maxFrameBytes := int32(4 * 1024 * 1024)
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{TunnelMaxFrameBytes: &maxFrameBytes},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_TUNNEL_MAX_FRAME_BYTES", "4194304")
+		})
+
+		DescribeTable("should render the tunnel compression setting when configured", func(compression operatorv1.TunnelCompressionType, expected string) {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{TunnelCompression: &compression},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_TUNNEL_COMPRESSION", expected)
+		},
+			Entry("Enabled", operatorv1.TunnelCompressionEnabled, "true"),
+			Entry("Disabled", operatorv1.TunnelCompressionDisabled, "false"),
+		)
+
+		DescribeTable("should render the request coalescing setting when configured", func(coalescing operatorv1.RequestCoalescingType, expected string) {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{RequestCoalescing: &coalescing},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_REQUEST_COALESCING", expected)
+		},
+			Entry("Enabled", operatorv1.RequestCoalescingEnabled, "true"),
+			Entry("Disabled", operatorv1.RequestCoalescingDisabled, "false"),
+		)
+
+		It("should not render the request coalescing setting by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_REQUEST_COALESCING"))
+			}
+		})
+
+		It("should render the tunnel latency histogram buckets when configured", func() {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{TunnelLatencyBuckets: []string{"0.05", "0.1", "0.5", "1"}},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_TUNNEL_LATENCY_BUCKETS", "0.05,0.1,0.5,1")
+		})
+
+		It("should render the default tunnel port", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_PORT", "9443")
+		})
+
+		It("should render the tunnel port when configured", func() {
+			port := int32(10443)
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{TunnelPort: &port},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_PORT", "10443")
+		})
+
+		It("should render the number of workers when configured", func() {
+			workers := int32(16)
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{Workers: &workers},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_WORKERS", "16")
+		})
+
+		It("should not render the number of workers when not configured", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_WORKERS"))
+			}
+		})
+
+		It("should render the tunnel bandwidth limit when configured", func() {
+			limit := resource.MustParse("10Mi")
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{TunnelBandwidthLimit: &limit},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_TUNNEL_BANDWIDTH_LIMIT", "10485760")
+		})
+
+		It("should not render the tunnel bandwidth limit when not configured", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_TUNNEL_BANDWIDTH_LIMIT"))
+			}
+		})
+
+		It("should mount a projected service account token with the configured audience", func() {
+			expirationSeconds := int64(7200)
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{ServiceAccountToken: &operatorv1.GuardianServiceAccountToken{
+					Audience:          "sts.amazonaws.com",
+					ExpirationSeconds: &expirationSeconds,
+				}},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+
+			var projected *corev1.ProjectedVolumeSource
+			for _, v := range deployment.Spec.Template.Spec.Volumes {
+				if v.Name == render.GuardianTokenVolumeName {
+					projected = v.Projected
+				}
+			}
+			Expect(projected).NotTo(BeNil())
+			Expect(projected.Sources).To(ConsistOf(corev1.VolumeProjection{
+				ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+					Audience:          "sts.amazonaws.com",
+					ExpirationSeconds: &expirationSeconds,
+					Path:              "token",
+				},
+			}))
+
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      render.GuardianTokenVolumeName,
+				MountPath: render.GuardianTokenMountPath,
+				ReadOnly:  true,
+			}))
+			rtest.ExpectEnv(container.Env, "GUARDIAN_SERVICE_ACCOUNT_TOKEN_PATH", "/var/run/secrets/tigera/guardian/token")
+		})
+
+		It("should not mount a projected service account token when not configured", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			for _, v := range deployment.Spec.Template.Spec.Volumes {
+				Expect(v.Name).NotTo(Equal(render.GuardianTokenVolumeName))
+			}
+		})
+
+		It("should render the tunnel headers when configured", func() {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{TunnelHeaders: map[string]string{
+					"X-Tenant":   "tenant-a",
+					"X-Accepted": "a, b",
+				}},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_TUNNEL_HEADERS", `{"X-Accepted":"a, b","X-Tenant":"tenant-a"}`)
+		})
+
+		It("should not render the tunnel headers when not configured", func() {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_TUNNEL_HEADERS"))
+			}
+		})
+
+		It("should render the cluster labels when configured", func() {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{
+					ClusterLabels: map[string]string{"region": "us-east-1", "environment": "prod"},
+				},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_CLUSTER_LABELS", `{"environment":"prod","region":"us-east-1"}`)
+		})
+
+		It("should use the shared trusted bundle for all backends by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_PACKET_CAPTURE_CA_BUNDLE_PATH", cfg.TrustedCertBundle.MountPath())
+			rtest.ExpectEnv(container.Env, "GUARDIAN_PROMETHEUS_CA_BUNDLE_PATH", cfg.TrustedCertBundle.MountPath())
+			rtest.ExpectEnv(container.Env, "GUARDIAN_QUERYSERVER_CA_BUNDLE_PATH", cfg.TrustedCertBundle.MountPath())
+		})
+
+		It("should mount per-backend CA bundles when configured", func() {
+			prometheusCA := &corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "prometheus-ca", Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{"ca.crt": []byte("prometheus")},
+			}
+			queryServerCA := &corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "queryserver-ca", Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{"ca.crt": []byte("queryserver")},
+			}
+			cfg.PrometheusCABundle = prometheusCA
+			cfg.QueryServerCABundle = queryServerCA
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+
+			// The secrets are copied to the guardian namespace.
+			rtest.ExpectResourceInList(resources, "prometheus-ca", render.GuardianNamespace, "", "v1", "Secret")
+			rtest.ExpectResourceInList(resources, "queryserver-ca", render.GuardianNamespace, "", "v1", "Secret")
+
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElements(
+				corev1.Volume{
+					Name: "guardian-prometheus-ca-bundle",
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+						SecretName: "prometheus-ca",
+						Items:      []corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}},
+					}},
+				},
+				corev1.Volume{
+					Name: "guardian-queryserver-ca-bundle",
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+						SecretName: "queryserver-ca",
+						Items:      []corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}},
+					}},
+				},
+			))
+			for _, v := range deployment.Spec.Template.Spec.Volumes {
+				Expect(v.Name).NotTo(Equal("guardian-packet-capture-ca-bundle"))
+			}
+			Expect(deployment.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/prometheus-ca"))
+			Expect(deployment.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/queryserver-ca"))
+
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			Expect(container.VolumeMounts).To(ContainElements(
+				corev1.VolumeMount{Name: "guardian-prometheus-ca-bundle", MountPath: "/etc/pki/guardian/prometheus", ReadOnly: true},
+				corev1.VolumeMount{Name: "guardian-queryserver-ca-bundle", MountPath: "/etc/pki/guardian/queryserver", ReadOnly: true},
+			))
+			rtest.ExpectEnv(container.Env, "GUARDIAN_PACKET_CAPTURE_CA_BUNDLE_PATH", cfg.TrustedCertBundle.MountPath())
+			rtest.ExpectEnv(container.Env, "GUARDIAN_PROMETHEUS_CA_BUNDLE_PATH", "/etc/pki/guardian/prometheus/ca.crt")
+			rtest.ExpectEnv(container.Env, "GUARDIAN_QUERYSERVER_CA_BUNDLE_PATH", "/etc/pki/guardian/queryserver/ca.crt")
+		})
+
+		It("should copy a CA bundle secret shared by several backends once", func() {
+			ca := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-ca", Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{"ca.crt": []byte("backend")},
+			}
+			cfg.PacketCaptureCABundle = ca
+			cfg.PrometheusCABundle = ca
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+
+			copies := 0
+			for _, obj := range resources {
+				if s, ok := obj.(*corev1.Secret); ok && s.Name == "backend-ca" {
+					copies++
+				}
+			}
+			Expect(copies).To(Equal(1))
+
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_PACKET_CAPTURE_CA_BUNDLE_PATH", "/etc/pki/guardian/packet-capture/ca.crt")
+			rtest.ExpectEnv(container.Env, "GUARDIAN_PROMETHEUS_CA_BUNDLE_PATH", "/etc/pki/guardian/prometheus/ca.crt")
+		})
+
+		It("should delete the CA bundle copies that are no longer referenced", func() {
+			cfg.PrometheusCABundle = &corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "prometheus-ca", Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{"ca.crt": []byte("prometheus")},
+			}
+			cfg.BackendCABundleCopies = []string{"prometheus-ca", "old-prometheus-ca"}
+			g := render.Guardian(cfg)
+			resources, toDelete := g.Objects()
+
+			copied := rtest.GetResource(resources, "prometheus-ca", render.GuardianNamespace, "", "v1", "Secret").(*corev1.Secret)
+			Expect(copied.Labels).To(HaveKey(render.GuardianBackendCABundleLabel))
+			Expect(toDelete).To(HaveLen(1))
+			Expect(toDelete[0].GetName()).To(Equal("old-prometheus-ca"))
+			Expect(toDelete[0].GetNamespace()).To(Equal(render.GuardianNamespace))
+		})
+
+		It("should not render the cluster labels when not configured", func() {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_CLUSTER_LABELS"))
+			}
+		})
+
+		It("should not render the tunnel latency histogram buckets when not configured", func() {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_TUNNEL_LATENCY_BUCKETS"))
+			}
+		})
+
+		It("should render the backend retry policy when configured", func() {
+			maxRetries := int32(3)
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{
+					BackendRetryPolicy: &operatorv1.GuardianRetryPolicy{
+						MaxRetries: &maxRetries,
+						Backoff:    &metav1.Duration{Duration: 500 * time.Millisecond},
+					},
+				},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_BACKEND_RETRY_COUNT", "3")
+			rtest.ExpectEnv(container.Env, "GUARDIAN_BACKEND_RETRY_BACKOFF", "500ms")
+		})
+
+		DescribeTable("should render the DNS cache TTL when configured", func(ttl time.Duration, expected string) {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{DNSCacheTTL: &metav1.Duration{Duration: ttl}},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_DNS_CACHE_TTL", expected)
+		},
+			Entry("positive", 30*time.Second, "30s"),
+			Entry("zero disables caching", time.Duration(0), "0s"),
+		)
+
+		DescribeTable("should render the shutdown drain delay and extend the termination grace period", func(delay time.Duration, expectedEnv string, expectedGracePeriod int64) {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{ShutdownDrainDelay: &metav1.Duration{Duration: delay}},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_SHUTDOWN_DRAIN_DELAY", expectedEnv)
+			// Guardian waits for the delay itself, so no preStop hook is needed in its image.
+			Expect(container.Lifecycle).To(BeNil())
+			// The default grace period, plus the delay before guardian stops and the time it has to drain.
+			Expect(deployment.Spec.Template.Spec.TerminationGracePeriodSeconds).To(Equal(ptr.Int64ToPtr(expectedGracePeriod)))
+		},
+			Entry("whole seconds", 15*time.Second, "15s", int64(60)),
+			Entry("fractional seconds are rounded up", 1500*time.Millisecond, "1.5s", int64(34)),
+		)
+
+		It("should render the heartbeat interval env var", func() {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{HeartbeatInterval: &metav1.Duration{Duration: 30 * time.Second}},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_HEARTBEAT_INTERVAL", "30s")
+		})
+
+		DescribeTable("should add the tier readiness gate only when enabled for Enterprise",
+			func(variant operatorv1.ProductVariant, gate *operatorv1.TierReadinessGateType, expectGate bool) {
+				cfg.Installation.Variant = variant
+				cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+					Spec: operatorv1.ManagementClusterConnectionSpec{TierReadinessGate: gate},
+				}
+				g := render.Guardian(cfg)
+				resources, _ := g.Objects()
+				deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+				if expectGate {
+					Expect(deployment.Spec.Template.Spec.ReadinessGates).To(ConsistOf(corev1.PodReadinessGate{ConditionType: render.GuardianTierReadyConditionType}))
+				} else {
+					Expect(deployment.Spec.Template.Spec.ReadinessGates).To(BeEmpty())
+				}
+			},
+			Entry("Enterprise, enabled", operatorv1.TigeraSecureEnterprise, ptr.ToPtr(operatorv1.TierReadinessGateEnabled), true),
+			Entry("Enterprise, disabled", operatorv1.TigeraSecureEnterprise, ptr.ToPtr(operatorv1.TierReadinessGateDisabled), false),
+			Entry("Enterprise, not set", operatorv1.TigeraSecureEnterprise, nil, false),
+			Entry("Calico, enabled", operatorv1.Calico, ptr.ToPtr(operatorv1.TierReadinessGateEnabled), false),
+		)
+
+		It("should render the debug config endpoint env vars when enabled", func() {
+			enabled := operatorv1.DebugConfigEndpointEnabled
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{DebugConfigEndpoint: &enabled},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_DEBUG_CONFIG_ENABLED", "true")
+			rtest.ExpectEnv(container.Env, "GUARDIAN_DEBUG_PORT", "9081")
+		})
+
+		It("should not render the debug config endpoint env vars by default", func() {
+			disabled := operatorv1.DebugConfigEndpointDisabled
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{DebugConfigEndpoint: &disabled},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(HavePrefix("GUARDIAN_DEBUG"))
+			}
+		})
+
+		It("should not render the heartbeat interval by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_HEARTBEAT_INTERVAL"))
+			}
+		})
+
+		It("should render the configured annotations on the guardian service account", func() {
+			cfg.Installation.ServiceAccountAnnotations = []operatorv1.ServiceAccountAnnotations{
+				{ComponentName: operatorv1.ComponentNameGuardian, Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/guardian"}},
+				{ComponentName: operatorv1.ComponentNameESGateway, Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/es-gateway"}},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			sa := rtest.GetResource(resources, render.GuardianServiceAccountName, render.GuardianNamespace, "", "v1", "ServiceAccount").(*corev1.ServiceAccount)
+			Expect(sa.Annotations).To(Equal(map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/guardian"}))
+		})
+
+		It("should not annotate the guardian service account by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			sa := rtest.GetResource(resources, render.GuardianServiceAccountName, render.GuardianNamespace, "", "v1", "ServiceAccount").(*corev1.ServiceAccount)
+			Expect(sa.Annotations).To(BeEmpty())
+		})
+
+		It("should render the egress gateway selection annotations on the guardian pod", func() {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{
+					EgressGateway: &operatorv1.GuardianEgressGateway{
+						Selector:          "egress-code == 'red'",
+						NamespaceSelector: "projectcalico.org/name == 'egress'",
+					},
+				},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			annotations := deployment.Spec.Template.Annotations
+			Expect(annotations).To(HaveKeyWithValue("egress.projectcalico.org/selector", "egress-code == 'red'"))
+			Expect(annotations).To(HaveKeyWithValue("egress.projectcalico.org/namespaceSelector", "projectcalico.org/name == 'egress'"))
+		})
+
+		It("should not render egress gateway annotations by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(deployment.Spec.Template.Annotations).NotTo(HaveKey("egress.projectcalico.org/selector"))
+			Expect(deployment.Spec.Template.Annotations).NotTo(HaveKey("egress.projectcalico.org/namespaceSelector"))
+		})
+
+		It("should not render a shutdown drain delay by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_SHUTDOWN_DRAIN_DELAY"))
+			}
+			Expect(container.Lifecycle).To(BeNil())
+			Expect(deployment.Spec.Template.Spec.TerminationGracePeriodSeconds).To(BeNil())
+		})
+
+		It("should not render the DNS cache TTL by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_DNS_CACHE_TTL"))
+			}
+		})
+
+		It("should not render the backend retry policy by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(HavePrefix("GUARDIAN_BACKEND_RETRY_"))
+			}
+		})
+
+		It("should render a ClusterIP service by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			svc := rtest.GetResource(resources, render.GuardianServiceName, render.GuardianNamespace, "", "", "").(*corev1.Service)
+			Expect(svc.Spec.Type).To(BeEmpty())
+			for _, p := range svc.Spec.Ports {
+				Expect(p.NodePort).To(BeZero())
+			}
+		})
+
+		DescribeTable("should only expose the ports of the proxied backends",
+			func(elasticsearch, kibana bool, expectedPorts []string) {
+				cfg.ElasticsearchProxyEnabled = elasticsearch
+				cfg.KibanaProxyEnabled = kibana
+				g := render.Guardian(cfg)
+				resources, _ := g.Objects()
+				svc := rtest.GetResource(resources, render.GuardianServiceName, render.GuardianNamespace, "", "", "").(*corev1.Service)
+				var ports []string
+				for _, p := range svc.Spec.Ports {
+					ports = append(ports, p.Name)
+				}
+				Expect(ports).To(Equal(expectedPorts))
+			},
+			Entry("no backends", false, false, []string{"linseed"}),
+			Entry("Elasticsearch only", true, false, []string{"linseed", "elasticsearch"}),
+			Entry("Kibana only", false, true, []string{"linseed", "kibana"}),
+			Entry("Elasticsearch and Kibana", true, true, []string{"linseed", "elasticsearch", "kibana"}),
+		)
+
+		It("should render a NodePort service when configured", func() {
+			svcType := corev1.ServiceTypeNodePort
+			nodePort := int32(30443)
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{
+					GuardianService: &operatorv1.GuardianService{Type: &svcType, NodePort: &nodePort},
+				},
+			}
+			cfg.ElasticsearchProxyEnabled = true
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			svc := rtest.GetResource(resources, render.GuardianServiceName, render.GuardianNamespace, "", "", "").(*corev1.Service)
+			Expect(svc.Spec.Type).To(Equal(corev1.ServiceTypeNodePort))
+			Expect(svc.Spec.Ports[0].Name).To(Equal("linseed"))
+			Expect(svc.Spec.Ports[0].NodePort).To(BeEquivalentTo(30443))
+			Expect(svc.Spec.Ports[1].NodePort).To(BeZero())
+		})
+
+		It("should render insecure TLS verification when configured", func() {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{InsecureSkipTLSVerify: true},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_INSECURE_SKIP_TLS_VERIFY", "true")
+		})
+
+		It("should not render insecure TLS verification by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_INSECURE_SKIP_TLS_VERIFY"))
+			}
+		})
+
+		DescribeTable("should render access logging when enabled", func(format *operatorv1.AccessLogFormat, expected string) {
+			state := operatorv1.AccessLoggingEnabled
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{
+					AccessLogging: &operatorv1.GuardianAccessLogging{State: &state, Format: format},
+				},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			rtest.ExpectEnv(container.Env, "GUARDIAN_ACCESS_LOG_ENABLED", "true")
+			rtest.ExpectEnv(container.Env, "GUARDIAN_ACCESS_LOG_FORMAT", expected)
+		},
+			Entry("default format", nil, "text"),
+			Entry("Text", ptrAccessLogFormat(operatorv1.AccessLogFormatText), "text"),
+			Entry("JSON", ptrAccessLogFormat(operatorv1.AccessLogFormatJSON), "json"),
+		)
+
+		DescribeTable("should not render access logging unless enabled", func(accessLogging *operatorv1.GuardianAccessLogging) {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{AccessLogging: accessLogging},
+			}
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(HavePrefix("GUARDIAN_ACCESS_LOG_"))
+			}
+		},
+			Entry("not configured", nil),
+			Entry("no state", &operatorv1.GuardianAccessLogging{Format: ptrAccessLogFormat(operatorv1.AccessLogFormatJSON)}),
+			Entry("Disabled", &operatorv1.GuardianAccessLogging{State: ptrAccessLoggingState(operatorv1.AccessLoggingDisabled)}),
+		)
+
+		It("should not render the tunnel compression setting by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_TUNNEL_COMPRESSION"))
+			}
+		})
+
+		It("should not render the tunnel max frame size by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_TUNNEL_MAX_FRAME_BYTES"))
+			}
+		})
+
+		It("should not render the max request header size by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_MAX_REQUEST_HEADER_BYTES"))
+			}
+		})
+
+		It("should not render the IP family preference by default", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			container := rtest.GetContainer(deployment.Spec.Template.Spec.Containers, render.GuardianDeploymentName)
+			for _, env := range container.Env {
+				Expect(env.Name).NotTo(Equal("GUARDIAN_IP_FAMILY_PREFERENCE"))
+			}
+		})
+
+		It("should render user-provided init containers when configured", func() {
+			initContainer := corev1.Container{
+				Name:    "fetch-token",
+				Image:   "example.com/fetch-token:v1",
+				Command: []string{"/fetch-token"},
+			}
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{
+					GuardianDeployment: &operatorv1.GuardianDeployment{
+						Spec: &operatorv1.GuardianDeploymentSpec{
+							Template: &operatorv1.GuardianDeploymentPodTemplateSpec{
+								Spec: &operatorv1.GuardianDeploymentPodSpec{
+									ExtraInitContainers: []corev1.Container{initContainer},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment, ok := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(deployment.Spec.Template.Spec.InitContainers).To(Equal([]corev1.Container{initContainer}))
+			Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(1))
+		})
+
+		It("should pass through user-provided pod annotations", func() {
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{
+					GuardianDeployment: &operatorv1.GuardianDeployment{
+						Spec: &operatorv1.GuardianDeploymentSpec{
+							Template: &operatorv1.GuardianDeploymentPodTemplateSpec{
+								Metadata: &operatorv1.Metadata{
+									Annotations: map[string]string{
+										"checkpoint.example.com/enabled":                            "true",
+										"checkpoint.example.com/restart-policy":                     "restore",
+										"hash.operator.tigera.io/tigera-managed-cluster-connection": "user-value",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment, ok := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			annotations := deployment.Spec.Template.Annotations
+			Expect(annotations).To(HaveKeyWithValue("checkpoint.example.com/enabled", "true"))
+			Expect(annotations).To(HaveKeyWithValue("checkpoint.example.com/restart-policy", "restore"))
+
+			// Annotations managed by the operator are not overridden.
+			Expect(annotations["hash.operator.tigera.io/tigera-managed-cluster-connection"]).NotTo(Equal("user-value"))
+		})
+
+		It("should render the revisionHistoryLimit when configured", func() {
+			g := render.Guardian(cfg)
+			resources, _ := g.Objects()
+			deployment, ok := rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(deployment.Spec.RevisionHistoryLimit).To(BeNil())
+
+			var limit int32 = 2
+			cfg.ManagementClusterConnection = &operatorv1.ManagementClusterConnection{
+				Spec: operatorv1.ManagementClusterConnectionSpec{
+					GuardianDeployment: &operatorv1.GuardianDeployment{
+						Spec: &operatorv1.GuardianDeploymentSpec{
+							RevisionHistoryLimit: &limit,
+						},
+					},
+				},
+			}
+
+			g = render.Guardian(cfg)
+			resources, _ = g.Objects()
+			deployment, ok = rtest.GetResource(resources, render.GuardianDeploymentName, render.GuardianNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(deployment.Spec.RevisionHistoryLimit).To(Equal(&limit))
+		})
 	})
 })
+
+func ptrIPFamily(f operatorv1.IPFamily) *operatorv1.IPFamily {
+	return &f
+}
+
+func ptrAccessLoggingState(s operatorv1.AccessLoggingState) *operatorv1.AccessLoggingState {
+	return &s
+}
+
+func ptrAccessLogFormat(f operatorv1.AccessLogFormat) *operatorv1.AccessLogFormat {
+	return &f
+}
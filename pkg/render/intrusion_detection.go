@@ -158,7 +158,7 @@ func (c *intrusionDetectionComponent) Objects() ([]client.Object, []client.Objec
 	objs := []client.Object{}
 	if !c.cfg.Tenant.MultiTenant() {
 		// In multi-tenant environments, the namespace is pre-created. So, only create it if we're not in a multi-tenant environment.
-		objs = append(objs, CreateNamespace(c.cfg.Namespace, c.cfg.Installation.KubernetesProvider, PodSecurityStandard(pss)))
+		objs = append(objs, CreateNamespace(c.cfg.Namespace, c.cfg.Installation, PodSecurityStandard(pss)))
 
 		// GlobalAlertTemplates are not used in multi-tenant management clusters.
 		objs = append(objs, c.globalAlertTemplates()...)
@@ -492,9 +492,10 @@ func (c *kubeControllersComponent) controllersServiceAccount() *corev1.ServiceAc
 	return &corev1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      c.kubeControllerServiceAccountName,
-			Namespace: c.cfg.Namespace,
-			Labels:    map[string]string{},
+			Name:        c.kubeControllerServiceAccountName,
+			Namespace:   c.cfg.Namespace,
+			Labels:      map[string]string{},
+			Annotations: rmeta.GetServiceAccountAnnotations(c.cfg.Installation, operatorv1.ComponentNameKubeControllers),
 		},
 	}
 }
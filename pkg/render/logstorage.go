@@ -216,6 +216,11 @@ type ElasticsearchConfiguration struct {
 	ApplyTrial              bool
 	KeyStoreSecret          *corev1.Secret
 	KibanaEnabled           bool
+	Authentication          *operatorv1.Authentication
+
+	// SnapshotRepositoryCredentials is the user provided secret, from the operator namespace, whose keys are added
+	// to the Elasticsearch keystore so that Elasticsearch can access the snapshot repository.
+	SnapshotRepositoryCredentials *corev1.Secret
 
 	// Whether the cluster supports pod security policies.
 	UsePSP bool
@@ -296,7 +301,7 @@ func (es *elasticsearchComponent) Objects() ([]client.Object, []client.Object) {
 
 	// ECK operator
 	toCreate = append(toCreate,
-		CreateNamespace(ECKOperatorNamespace, es.cfg.Installation.KubernetesProvider, PSSRestricted),
+		CreateNamespace(ECKOperatorNamespace, es.cfg.Installation, PSSRestricted),
 		es.eckOperatorAllowTigeraPolicy(),
 	)
 
@@ -335,7 +340,7 @@ func (es *elasticsearchComponent) Objects() ([]client.Object, []client.Object) {
 	toCreate = append(toCreate, es.eckOperatorStatefulSet())
 
 	// Elasticsearch CRs
-	toCreate = append(toCreate, CreateNamespace(ElasticsearchNamespace, es.cfg.Installation.KubernetesProvider, PSSPrivileged))
+	toCreate = append(toCreate, CreateNamespace(ElasticsearchNamespace, es.cfg.Installation, PSSPrivileged))
 	toCreate = append(toCreate, es.elasticsearchAllowTigeraPolicy())
 	toCreate = append(toCreate, es.elasticsearchInternalAllowTigeraPolicy())
 	toCreate = append(toCreate, networkpolicy.AllowTigeraDefaultDeny(ElasticsearchNamespace))
@@ -348,6 +353,10 @@ func (es *elasticsearchComponent) Objects() ([]client.Object, []client.Object) {
 		toCreate = append(toCreate, es.cfg.ElasticsearchUserSecret)
 	}
 
+	if es.cfg.SnapshotRepositoryCredentials != nil {
+		toCreate = append(toCreate, secret.ToRuntimeObjects(secret.CopyToNamespace(ElasticsearchNamespace, es.cfg.SnapshotRepositoryCredentials)...)...)
+	}
+
 	toCreate = append(toCreate, es.elasticsearchServiceAccount())
 	toCreate = append(toCreate, es.cfg.ClusterConfig.ConfigMap())
 
@@ -360,7 +369,7 @@ func (es *elasticsearchComponent) Objects() ([]client.Object, []client.Object) {
 		// - securityContext.capabilities.drop=["ALL"]
 		// - securityContext.runAsNonRoot=true
 		// - securityContext.seccompProfile.type to "RuntimeDefault" or "Localhost"
-		toCreate = append(toCreate, CreateNamespace(KibanaNamespace, es.cfg.Installation.KubernetesProvider, PSSBaseline))
+		toCreate = append(toCreate, CreateNamespace(KibanaNamespace, es.cfg.Installation, PSSBaseline))
 		toCreate = append(toCreate, es.kibanaAllowTigeraPolicy())
 		toCreate = append(toCreate, networkpolicy.AllowTigeraDefaultDeny(KibanaNamespace))
 		toCreate = append(toCreate, es.kibanaServiceAccount())
@@ -466,6 +475,12 @@ func (es elasticsearchComponent) pvcTemplate() corev1.PersistentVolumeClaim {
 }
 
 func (es elasticsearchComponent) resourceRequirements() corev1.ResourceRequirements {
+	return ElasticsearchResourceRequirements(es.cfg.LogStorage)
+}
+
+// ElasticsearchResourceRequirements returns the resource requirements of the Elasticsearch container, which are the
+// defaults with any requirements from the LogStorage applied on top.
+func ElasticsearchResourceRequirements(ls *operatorv1.LogStorage) corev1.ResourceRequirements {
 	resources := corev1.ResourceRequirements{
 		Limits: corev1.ResourceList{
 			"cpu":    resource.MustParse("1"),
@@ -476,8 +491,8 @@ func (es elasticsearchComponent) resourceRequirements() corev1.ResourceRequireme
 			"memory": resource.MustParse("4Gi"),
 		},
 	}
-	if es.cfg.LogStorage.Spec.Nodes != nil && es.cfg.LogStorage.Spec.Nodes.ResourceRequirements != nil {
-		userOverrides := *es.cfg.LogStorage.Spec.Nodes.ResourceRequirements
+	if ls.Spec.Nodes != nil && ls.Spec.Nodes.ResourceRequirements != nil {
+		userOverrides := *ls.Spec.Nodes.ResourceRequirements
 		resources = overrideResourceRequirements(resources, userOverrides)
 	}
 	return resources
@@ -486,7 +501,10 @@ func (es elasticsearchComponent) resourceRequirements() corev1.ResourceRequireme
 func (es elasticsearchComponent) javaOpts() string {
 	var javaOpts string
 	resources := es.resourceRequirements()
-	if es.cfg.LogStorage.Spec.Nodes != nil && es.cfg.LogStorage.Spec.Nodes.ResourceRequirements != nil {
+	if es.cfg.LogStorage.Spec.Nodes != nil && es.cfg.LogStorage.Spec.Nodes.HeapSize != nil {
+		heapSize := quantityToJVMHeapSize(es.cfg.LogStorage.Spec.Nodes.HeapSize)
+		javaOpts = fmt.Sprintf("-Xms%v -Xmx%v", heapSize, heapSize)
+	} else if es.cfg.LogStorage.Spec.Nodes != nil && es.cfg.LogStorage.Spec.Nodes.ResourceRequirements != nil {
 		// Now extract the memory request value to compute the recommended heap size for ES container
 		recommendedHeapSize := memoryQuantityToJVMHeapSize(resources.Requests.Memory())
 		javaOpts = fmt.Sprintf("-Xms%v -Xmx%v", recommendedHeapSize, recommendedHeapSize)
@@ -760,6 +778,12 @@ func (es elasticsearchComponent) elasticsearchCluster() *esv1.Elasticsearch {
 		},
 	}
 
+	if es.cfg.SnapshotRepositoryCredentials != nil {
+		elasticsearch.Spec.SecureSettings = []cmnv1.SecretSource{
+			{SecretName: es.cfg.SnapshotRepositoryCredentials.Name},
+		}
+	}
+
 	return elasticsearch
 }
 
@@ -829,6 +853,13 @@ func memoryQuantityToJVMHeapSize(q *resource.Quantity) string {
 	return recommendedHeapSize
 }
 
+// quantityToJVMHeapSize converts an explicitly configured heap size to a JVM heap size string, rounding it down to a
+// multiple of 1024 bytes as the JVM requires.
+func quantityToJVMHeapSize(q *resource.Quantity) string {
+	rounded := q.Value() / 1024 * 1024
+	return strings.TrimSuffix(resource.NewQuantity(rounded, resource.BinarySI).String(), "i")
+}
+
 // nodeSets calculates the number of NodeSets needed for the Elasticsearch cluster. Multiple NodeSets are returned only
 // if the "nodeSets" field has been set in the LogStorage CR. The number of Nodes for the cluster will be distributed as
 // evenly as possible between the NodeSets.
@@ -1639,6 +1670,38 @@ func (es *elasticsearchComponent) eckOperatorAllowTigeraPolicy() *v3.NetworkPoli
 }
 
 // Allow access to Elasticsearch client nodes from Kibana, ECK Operator and ES Gateway.
+// snapshotRepositoryEgressRules returns the rules that allow Elasticsearch to write snapshots to the object store of
+// the given repository. Without configured endpoints or CIDRs, the well-known endpoints of the repository type are
+// allowed.
+func snapshotRepositoryEgressRules(repo *operatorv1.SnapshotRepository) []v3.Rule {
+	endpoints := repo.Endpoints
+	if len(endpoints) == 0 && len(repo.CIDRs) == 0 {
+		switch repo.Type {
+		case operatorv1.SnapshotRepositoryTypeS3:
+			endpoints = []string{"*.amazonaws.com"}
+		case operatorv1.SnapshotRepositoryTypeGCS:
+			endpoints = []string{"storage.googleapis.com"}
+		}
+	}
+
+	var rules []v3.Rule
+	if len(endpoints) > 0 {
+		rules = append(rules, v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: v3.EntityRule{Domains: endpoints, Ports: networkpolicy.Ports(443)},
+		})
+	}
+	if len(repo.CIDRs) > 0 {
+		rules = append(rules, v3.Rule{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: v3.EntityRule{Nets: repo.CIDRs, Ports: networkpolicy.Ports(443)},
+		})
+	}
+	return rules
+}
+
 func (es *elasticsearchComponent) elasticsearchAllowTigeraPolicy() *v3.NetworkPolicy {
 	egressRules := []v3.Rule{}
 	egressRules = networkpolicy.AppendDNSEgressRules(egressRules, es.cfg.Provider == operatorv1.ProviderOpenShift)
@@ -1646,7 +1709,7 @@ func (es *elasticsearchComponent) elasticsearchAllowTigeraPolicy() *v3.NetworkPo
 		{
 			Action:      v3.Allow,
 			Protocol:    &networkpolicy.TCPProtocol,
-			Destination: DexEntityRule,
+			Destination: DexEntityRule(es.cfg.Authentication),
 		},
 		{
 			Action:      v3.Allow,
@@ -1664,6 +1727,9 @@ func (es *elasticsearchComponent) elasticsearchAllowTigeraPolicy() *v3.NetworkPo
 			Destination: networkpolicy.KubeAPIServerServiceSelectorEntityRule,
 		},
 	}...)
+	if es.cfg.LogStorage != nil && es.cfg.LogStorage.Spec.SnapshotRepository != nil {
+		egressRules = append(egressRules, snapshotRepositoryEgressRules(es.cfg.LogStorage.Spec.SnapshotRepository)...)
+	}
 
 	elasticSearchIngressDestinationEntityRule := v3.EntityRule{
 		Ports: networkpolicy.Ports(ElasticsearchDefaultPort),
@@ -1931,7 +1997,7 @@ func (m *managedClusterLogStorage) Objects() (objsToCreate []client.Object, objs
 	toCreate := []client.Object{}
 	roles, bindings := m.linseedExternalRolesAndBindings()
 	toCreate = append(toCreate,
-		CreateNamespace(ElasticsearchNamespace, m.cfg.Installation.KubernetesProvider, PSSPrivileged),
+		CreateNamespace(ElasticsearchNamespace, m.cfg.Installation, PSSPrivileged),
 		m.elasticsearchExternalService(),
 		m.linseedExternalService(),
 	)
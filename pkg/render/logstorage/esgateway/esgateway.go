@@ -26,6 +26,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -53,11 +54,24 @@ const (
 	PolicyName            = networkpolicy.TigeraComponentPolicyPrefix + "es-gateway-access"
 	ElasticsearchPortName = "es-gateway-elasticsearch-port"
 	KibanaPortName        = "es-gateway-kibana-port"
+	MetricsPortName       = "metrics-port"
 	Port                  = 5554
 
 	ElasticsearchHTTPSEndpoint = "https://tigera-secure-es-http.tigera-elasticsearch.svc:9200"
 
 	KibanaHTTPSEndpoint = "https://tigera-secure-kb-http.tigera-kibana.svc:5601"
+
+	// ConfigMapName is the name of the ConfigMap, and its volume, holding a custom ES Gateway configuration file.
+	ConfigMapName = "tigera-secure-es-gateway-config"
+	ConfigFileKey = "config.yaml"
+	ConfigFileDir = "/etc/es-gateway"
+
+	configHashAnnotation = "hash.operator.tigera.io/es-gateway-config"
+
+	// AccessLogVolumeName is the name of the volume ES Gateway writes its access logs to when an access log sidecar
+	// is configured. The volume is shared with the sidecar and mounted at AccessLogDir in both containers.
+	AccessLogVolumeName = "es-gateway-access-logs"
+	AccessLogDir        = "/var/log/es-gateway"
 )
 
 func EsGateway(c *Config) render.Component {
@@ -83,6 +97,34 @@ type Config struct {
 	EsAdminUserName            string
 	Namespace                  string
 	TruthNamespace             string
+	Authentication             *operatorv1.Authentication
+
+	// IdleConnectionTimeout overrides ES Gateway's default idle connection timeout, if set.
+	IdleConnectionTimeout *metav1.Duration
+
+	// RateLimit configures ES Gateway to rate limit requests to Elasticsearch, if set.
+	RateLimit *operatorv1.ESGatewayRateLimit
+
+	// ElasticHTTPVersion selects the HTTP version of ES Gateway's connections to Elasticsearch, if set.
+	ElasticHTTPVersion *operatorv1.ESGatewayHTTPVersion
+
+	// CustomConfig is the user provided ConfigMap holding a custom ES Gateway configuration file, if any.
+	CustomConfig *corev1.ConfigMap
+
+	// AccessLogSidecar is the user provided container that ships ES Gateway's access logs, if any.
+	AccessLogSidecar *corev1.Container
+
+	// RevisionHistoryLimit overrides the ES Gateway Deployment's revisionHistoryLimit, if set.
+	RevisionHistoryLimit *int32
+
+	// MetricsPort is the port ES Gateway serves Prometheus metrics on, if set.
+	MetricsPort *int32
+
+	// MaxRequestBodySize limits the size of request bodies ES Gateway accepts, if set.
+	MaxRequestBodySize *resource.Quantity
+
+	// ServiceAccountAnnotations are added to the ES Gateway service account, over any set on the Installation.
+	ServiceAccountAnnotations map[string]string
 
 	// Whether the cluster supports pod security policies.
 	UsePSP bool
@@ -128,6 +170,11 @@ func (e *esGateway) Objects() (toCreate, toDelete []client.Object) {
 	if e.cfg.UsePSP {
 		toCreate = append(toCreate, e.esGatewayPodSecurityPolicy())
 	}
+	if e.cfg.CustomConfig != nil {
+		toCreate = append(toCreate, e.esGatewayConfigMap())
+	} else {
+		toDelete = append(toDelete, e.esGatewayConfigMap())
+	}
 	// Create the deployment last to ensure all secrets have been created
 	toCreate = append(toCreate, e.esGatewayDeployment())
 	return toCreate, toDelete
@@ -219,6 +266,29 @@ func (e *esGateway) esGatewayDeployment() *appsv1.Deployment {
 		}},
 		{Name: "ES_GATEWAY_FIPS_MODE_ENABLED", Value: operatorv1.IsFIPSModeEnabledString(e.cfg.Installation.FIPSMode)},
 	}
+	if e.cfg.IdleConnectionTimeout != nil {
+		envVars = append(envVars, corev1.EnvVar{Name: "ES_GATEWAY_ELASTIC_IDLE_CONN_TIMEOUT", Value: e.cfg.IdleConnectionTimeout.Duration.String()})
+	}
+	if rl := e.cfg.RateLimit; rl != nil {
+		envVars = append(envVars, corev1.EnvVar{Name: "ES_GATEWAY_RATE_LIMIT_RPS", Value: fmt.Sprint(rl.RequestsPerSecond)})
+		if rl.Burst != nil {
+			envVars = append(envVars, corev1.EnvVar{Name: "ES_GATEWAY_RATE_LIMIT_BURST", Value: fmt.Sprint(*rl.Burst)})
+		}
+	}
+	if v := e.cfg.ElasticHTTPVersion; v != nil {
+		envVars = append(envVars, corev1.EnvVar{Name: "ES_GATEWAY_ELASTIC_HTTP2_ENABLED", Value: fmt.Sprint(*v == operatorv1.ESGatewayHTTP2)})
+	}
+	if s := e.cfg.MaxRequestBodySize; s != nil {
+		envVars = append(envVars, corev1.EnvVar{Name: "ES_GATEWAY_MAX_REQUEST_BODY_BYTES", Value: fmt.Sprint(s.Value())})
+	}
+	var ports []corev1.ContainerPort
+	if p := e.cfg.MetricsPort; p != nil {
+		envVars = append(envVars,
+			corev1.EnvVar{Name: "ES_GATEWAY_METRICS_ENABLED", Value: "true"},
+			corev1.EnvVar{Name: "ES_GATEWAY_METRICS_PORT", Value: fmt.Sprint(*p)},
+		)
+		ports = append(ports, corev1.ContainerPort{Name: MetricsPortName, ContainerPort: *p, Protocol: corev1.ProtocolTCP})
+	}
 
 	var initContainers []corev1.Container
 	if e.cfg.ESGatewayKeyPair.UseCertificateManagement() {
@@ -237,6 +307,44 @@ func (e *esGateway) esGatewayDeployment() *appsv1.Deployment {
 
 	annotations := e.cfg.TrustedBundle.HashAnnotations()
 	annotations[e.cfg.ESGatewayKeyPair.HashAnnotationKey()] = e.cfg.ESGatewayKeyPair.HashAnnotationValue()
+
+	if e.cfg.CustomConfig != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: ConfigMapName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: ConfigMapName},
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      ConfigMapName,
+			MountPath: ConfigFileDir,
+			ReadOnly:  true,
+		})
+		annotations[configHashAnnotation] = rmeta.AnnotationHash(e.cfg.CustomConfig.Data)
+	}
+
+	var sidecars []corev1.Container
+	if e.cfg.AccessLogSidecar != nil {
+		envVars = append(envVars,
+			corev1.EnvVar{Name: "ES_GATEWAY_ACCESS_LOG_PATH", Value: AccessLogDir + "/access.log"},
+			corev1.EnvVar{Name: "ES_GATEWAY_ACCESS_LOG_FORMAT", Value: "json"},
+		)
+		volumes = append(volumes, corev1.Volume{
+			Name:         AccessLogVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: AccessLogVolumeName, MountPath: AccessLogDir})
+
+		sidecar := e.cfg.AccessLogSidecar.DeepCopy()
+		sidecar.VolumeMounts = append(sidecar.VolumeMounts, corev1.VolumeMount{
+			Name:      AccessLogVolumeName,
+			MountPath: AccessLogDir,
+			ReadOnly:  true,
+		})
+		sidecars = append(sidecars, *sidecar)
+	}
 	podTemplate := &corev1.PodTemplateSpec{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        DeploymentName,
@@ -256,6 +364,7 @@ func (e *esGateway) esGatewayDeployment() *appsv1.Deployment {
 					Image:           e.esGatewayImage,
 					ImagePullPolicy: render.ImagePullPolicy(),
 					Env:             envVars,
+					Ports:           ports,
 					VolumeMounts:    volumeMounts,
 					ReadinessProbe: &corev1.Probe{
 						ProbeHandler: corev1.ProbeHandler{
@@ -272,6 +381,7 @@ func (e *esGateway) esGatewayDeployment() *appsv1.Deployment {
 			},
 		},
 	}
+	podTemplate.Spec.Containers = append(podTemplate.Spec.Containers, sidecars...)
 
 	if e.cfg.Installation.ControlPlaneReplicas != nil && *e.cfg.Installation.ControlPlaneReplicas > 1 {
 		podTemplate.Spec.Affinity = podaffinity.NewPodAntiAffinity(DeploymentName, e.cfg.Namespace)
@@ -294,17 +404,41 @@ func (e *esGateway) esGatewayDeployment() *appsv1.Deployment {
 					MaxSurge:       ptr.IntOrStrPtr("100%"),
 				},
 			},
-			Template: *podTemplate,
-			Replicas: e.cfg.Installation.ControlPlaneReplicas,
+			Template:             *podTemplate,
+			Replicas:             e.cfg.Installation.ControlPlaneReplicas,
+			RevisionHistoryLimit: e.cfg.RevisionHistoryLimit,
+		},
+	}
+}
+
+// esGatewayConfigMap returns the copy of the user provided configuration file in the ES Gateway namespace.
+func (e *esGateway) esGatewayConfigMap() *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName,
+			Namespace: e.cfg.Namespace,
 		},
 	}
+	if e.cfg.CustomConfig != nil {
+		cm.Data = map[string]string{ConfigFileKey: e.cfg.CustomConfig.Data[ConfigFileKey]}
+	}
+	return cm
 }
 
 func (e *esGateway) esGatewayServiceAccount() *corev1.ServiceAccount {
+	annotations := rmeta.GetServiceAccountAnnotations(e.cfg.Installation, operatorv1.ComponentNameESGateway)
+	if len(e.cfg.ServiceAccountAnnotations) > 0 && annotations == nil {
+		annotations = make(map[string]string, len(e.cfg.ServiceAccountAnnotations))
+	}
+	for k, v := range e.cfg.ServiceAccountAnnotations {
+		annotations[k] = v
+	}
 	return &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      ServiceAccountName,
-			Namespace: e.cfg.Namespace,
+			Name:        ServiceAccountName,
+			Namespace:   e.cfg.Namespace,
+			Annotations: annotations,
 		},
 	}
 }
@@ -345,7 +479,7 @@ func (e *esGateway) esGatewayAllowTigeraPolicy() *v3.NetworkPolicy {
 		{
 			Action:      v3.Allow,
 			Protocol:    &networkpolicy.TCPProtocol,
-			Destination: render.DexEntityRule,
+			Destination: render.DexEntityRule(e.cfg.Authentication),
 		},
 		{
 			Action:      v3.Allow,
@@ -367,6 +501,69 @@ func (e *esGateway) esGatewayAllowTigeraPolicy() *v3.NetworkPolicy {
 	esgatewayIngressDestinationEntityRule := v3.EntityRule{
 		Ports: networkpolicy.Ports(Port),
 	}
+	ingressRules := []v3.Rule{
+		{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      render.FluentdSourceEntityRule,
+			Destination: esgatewayIngressDestinationEntityRule,
+		},
+		{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      render.EKSLogForwarderEntityRule,
+			Destination: esgatewayIngressDestinationEntityRule,
+		},
+		{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      render.IntrusionDetectionInstallerSourceEntityRule,
+			Destination: esgatewayIngressDestinationEntityRule,
+		},
+		{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      networkpolicy.DefaultHelper().ManagerSourceEntityRule(),
+			Destination: esgatewayIngressDestinationEntityRule,
+		},
+		{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      render.IntrusionDetectionSourceEntityRule,
+			Destination: esgatewayIngressDestinationEntityRule,
+		},
+		{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      render.ECKOperatorSourceEntityRule,
+			Destination: esgatewayIngressDestinationEntityRule,
+		},
+		{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Source:      esmetrics.ESMetricsSourceEntityRule,
+			Destination: esgatewayIngressDestinationEntityRule,
+		},
+		{
+			Action:      v3.Allow,
+			Protocol:    &networkpolicy.TCPProtocol,
+			Destination: esgatewayIngressDestinationEntityRule,
+			// The operator needs access to Elasticsearch and Kibana (through ES Gateway), however, since the
+			// operator is on the hostnetwork it's hard to create specific network policies for it.
+			// Allow all sources, as node CIDRs are not known. This also applies to DPI, which is host networked
+		},
+	}
+	if p := e.cfg.MetricsPort; p != nil {
+		ingressRules = append(ingressRules, v3.Rule{
+			Action:   v3.Allow,
+			Protocol: &networkpolicy.TCPProtocol,
+			Source:   networkpolicy.PrometheusSourceEntityRule,
+			Destination: v3.EntityRule{
+				Ports: networkpolicy.Ports(uint16(*p)),
+			},
+		})
+	}
+
 	return &v3.NetworkPolicy{
 		TypeMeta: metav1.TypeMeta{Kind: "NetworkPolicy", APIVersion: "projectcalico.org/v3"},
 		ObjectMeta: metav1.ObjectMeta{
@@ -378,59 +575,8 @@ func (e *esGateway) esGatewayAllowTigeraPolicy() *v3.NetworkPolicy {
 			Tier:     networkpolicy.TigeraComponentTierName,
 			Selector: networkpolicy.KubernetesAppSelector(DeploymentName),
 			Types:    []v3.PolicyType{v3.PolicyTypeIngress, v3.PolicyTypeEgress},
-			Ingress: []v3.Rule{
-				{
-					Action:      v3.Allow,
-					Protocol:    &networkpolicy.TCPProtocol,
-					Source:      render.FluentdSourceEntityRule,
-					Destination: esgatewayIngressDestinationEntityRule,
-				},
-				{
-					Action:      v3.Allow,
-					Protocol:    &networkpolicy.TCPProtocol,
-					Source:      render.EKSLogForwarderEntityRule,
-					Destination: esgatewayIngressDestinationEntityRule,
-				},
-				{
-					Action:      v3.Allow,
-					Protocol:    &networkpolicy.TCPProtocol,
-					Source:      render.IntrusionDetectionInstallerSourceEntityRule,
-					Destination: esgatewayIngressDestinationEntityRule,
-				},
-				{
-					Action:      v3.Allow,
-					Protocol:    &networkpolicy.TCPProtocol,
-					Source:      networkpolicy.DefaultHelper().ManagerSourceEntityRule(),
-					Destination: esgatewayIngressDestinationEntityRule,
-				},
-				{
-					Action:      v3.Allow,
-					Protocol:    &networkpolicy.TCPProtocol,
-					Source:      render.IntrusionDetectionSourceEntityRule,
-					Destination: esgatewayIngressDestinationEntityRule,
-				},
-				{
-					Action:      v3.Allow,
-					Protocol:    &networkpolicy.TCPProtocol,
-					Source:      render.ECKOperatorSourceEntityRule,
-					Destination: esgatewayIngressDestinationEntityRule,
-				},
-				{
-					Action:      v3.Allow,
-					Protocol:    &networkpolicy.TCPProtocol,
-					Source:      esmetrics.ESMetricsSourceEntityRule,
-					Destination: esgatewayIngressDestinationEntityRule,
-				},
-				{
-					Action:      v3.Allow,
-					Protocol:    &networkpolicy.TCPProtocol,
-					Destination: esgatewayIngressDestinationEntityRule,
-					// The operator needs access to Elasticsearch and Kibana (through ES Gateway), however, since the
-					// operator is on the hostnetwork it's hard to create specific network policies for it.
-					// Allow all sources, as node CIDRs are not known. This also applies to DPI, which is host networked
-				},
-			},
-			Egress: egressRules,
+			Ingress:  ingressRules,
+			Egress:   egressRules,
 		},
 	}
 }
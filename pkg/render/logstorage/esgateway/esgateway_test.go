@@ -16,6 +16,7 @@ package esgateway
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -25,6 +26,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -40,6 +42,7 @@ import (
 	"github.com/tigera/operator/pkg/dns"
 	"github.com/tigera/operator/pkg/render"
 	relasticsearch "github.com/tigera/operator/pkg/render/common/elasticsearch"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	"github.com/tigera/operator/pkg/render/common/podaffinity"
 	rtest "github.com/tigera/operator/pkg/render/common/test"
 	"github.com/tigera/operator/pkg/render/kubecontrollers"
@@ -237,6 +240,281 @@ var _ = Describe("ES Gateway rendering tests", func() {
 				Entry("for management/standalone, openshift-dns", testutils.AllowTigeraScenario{ManagedCluster: false, Openshift: true}),
 			)
 		})
+		It("should not set the idle connection timeout by default", func() {
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			for _, env := range d.Spec.Template.Spec.Containers[0].Env {
+				Expect(env.Name).NotTo(Equal("ES_GATEWAY_ELASTIC_IDLE_CONN_TIMEOUT"))
+			}
+		})
+
+		It("should render the configured annotations on the ES Gateway service account", func() {
+			installation.ServiceAccountAnnotations = []operatorv1.ServiceAccountAnnotations{
+				{ComponentName: operatorv1.ComponentNameGuardian, Annotations: map[string]string{"iam.gke.io/gcp-service-account": "guardian@project.iam.gserviceaccount.com"}},
+				{ComponentName: operatorv1.ComponentNameESGateway, Annotations: map[string]string{"iam.gke.io/gcp-service-account": "es-gateway@project.iam.gserviceaccount.com"}},
+			}
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			sa, err := rtest.GetResourceOfType[*corev1.ServiceAccount](resources, ServiceAccountName, render.ElasticsearchNamespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sa.Annotations).To(Equal(map[string]string{"iam.gke.io/gcp-service-account": "es-gateway@project.iam.gserviceaccount.com"}))
+		})
+
+		It("should render the LogStorage annotations on the ES Gateway service account over those on the Installation", func() {
+			installation.ServiceAccountAnnotations = []operatorv1.ServiceAccountAnnotations{
+				{ComponentName: operatorv1.ComponentNameESGateway, Annotations: map[string]string{
+					"iam.gke.io/gcp-service-account": "es-gateway@project.iam.gserviceaccount.com",
+					"eks.amazonaws.com/role-arn":     "arn:aws:iam::111122223333:role/installation",
+				}},
+			}
+			cfg.ServiceAccountAnnotations = map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/es-gateway"}
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			sa, err := rtest.GetResourceOfType[*corev1.ServiceAccount](resources, ServiceAccountName, render.ElasticsearchNamespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sa.Annotations).To(Equal(map[string]string{
+				"iam.gke.io/gcp-service-account": "es-gateway@project.iam.gserviceaccount.com",
+				"eks.amazonaws.com/role-arn":     "arn:aws:iam::111122223333:role/es-gateway",
+			}))
+
+			// The Installation's annotations should not be modified.
+			Expect(installation.ServiceAccountAnnotations[0].Annotations).To(HaveKeyWithValue("eks.amazonaws.com/role-arn", "arn:aws:iam::111122223333:role/installation"))
+		})
+
+		It("should render the LogStorage annotations on the ES Gateway service account", func() {
+			cfg.ServiceAccountAnnotations = map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/es-gateway"}
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			sa, err := rtest.GetResourceOfType[*corev1.ServiceAccount](resources, ServiceAccountName, render.ElasticsearchNamespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sa.Annotations).To(Equal(map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/es-gateway"}))
+		})
+
+		It("should set the idle connection timeout when configured", func() {
+			cfg.IdleConnectionTimeout = &metav1.Duration{Duration: 90 * time.Second}
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "ES_GATEWAY_ELASTIC_IDLE_CONN_TIMEOUT", Value: "1m30s"}))
+		})
+
+		It("should not set the Elasticsearch HTTP version by default", func() {
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			for _, env := range d.Spec.Template.Spec.Containers[0].Env {
+				Expect(env.Name).NotTo(Equal("ES_GATEWAY_ELASTIC_HTTP2_ENABLED"))
+			}
+		})
+
+		It("should enable HTTP/2 to Elasticsearch when configured", func() {
+			version := operatorv1.ESGatewayHTTP2
+			cfg.ElasticHTTPVersion = &version
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "ES_GATEWAY_ELASTIC_HTTP2_ENABLED", Value: "true"}))
+		})
+
+		It("should render the revisionHistoryLimit when configured", func() {
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(d.Spec.RevisionHistoryLimit).To(BeNil())
+
+			var limit int32 = 3
+			cfg.RevisionHistoryLimit = &limit
+			component = EsGateway(cfg)
+			resources, _ = component.Objects()
+			d, ok = rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(d.Spec.RevisionHistoryLimit).To(Equal(&limit))
+		})
+
+		It("should not serve metrics by default", func() {
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(d.Spec.Template.Spec.Containers[0].Ports).To(BeEmpty())
+			for _, env := range d.Spec.Template.Spec.Containers[0].Env {
+				Expect(env.Name).NotTo(HavePrefix("ES_GATEWAY_METRICS_"))
+			}
+
+			policy := testutils.GetAllowTigeraPolicyFromResources(types.NamespacedName{Name: PolicyName, Namespace: render.ElasticsearchNamespace}, resources)
+			for _, rule := range policy.Spec.Ingress {
+				Expect(rule.Source).NotTo(Equal(networkpolicy.PrometheusSourceEntityRule))
+			}
+		})
+
+		It("should serve metrics and allow Prometheus to scrape them when configured", func() {
+			var port int32 = 9095
+			cfg.MetricsPort = &port
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			container := d.Spec.Template.Spec.Containers[0]
+			Expect(container.Ports).To(ConsistOf(corev1.ContainerPort{Name: MetricsPortName, ContainerPort: 9095, Protocol: corev1.ProtocolTCP}))
+			Expect(container.Env).To(ContainElements(
+				corev1.EnvVar{Name: "ES_GATEWAY_METRICS_ENABLED", Value: "true"},
+				corev1.EnvVar{Name: "ES_GATEWAY_METRICS_PORT", Value: "9095"},
+			))
+
+			policy := testutils.GetAllowTigeraPolicyFromResources(types.NamespacedName{Name: PolicyName, Namespace: render.ElasticsearchNamespace}, resources)
+			Expect(policy.Spec.Ingress).To(ContainElement(v3.Rule{
+				Action:      v3.Allow,
+				Protocol:    &networkpolicy.TCPProtocol,
+				Source:      networkpolicy.PrometheusSourceEntityRule,
+				Destination: v3.EntityRule{Ports: networkpolicy.Ports(9095)},
+			}))
+		})
+
+		It("should limit the request body size only when configured", func() {
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			for _, env := range d.Spec.Template.Spec.Containers[0].Env {
+				Expect(env.Name).NotTo(Equal("ES_GATEWAY_MAX_REQUEST_BODY_BYTES"))
+			}
+
+			size := resource.MustParse("100Mi")
+			cfg.MaxRequestBodySize = &size
+			component = EsGateway(cfg)
+			resources, _ = component.Objects()
+			d, ok = rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(d.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "ES_GATEWAY_MAX_REQUEST_BODY_BYTES", Value: "104857600"}))
+		})
+
+		It("should render the access log sidecar with a shared log volume when configured", func() {
+			cfg.AccessLogSidecar = &corev1.Container{Name: "log-shipper", Image: "example.com/log-shipper:v1"}
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+
+			Expect(d.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name:         AccessLogVolumeName,
+				VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			}))
+			Expect(d.Spec.Template.Spec.Containers).To(HaveLen(2))
+
+			gateway := d.Spec.Template.Spec.Containers[0]
+			Expect(gateway.Name).To(Equal(DeploymentName))
+			Expect(gateway.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: AccessLogVolumeName, MountPath: "/var/log/es-gateway"}))
+			Expect(gateway.Env).To(ContainElements(
+				corev1.EnvVar{Name: "ES_GATEWAY_ACCESS_LOG_PATH", Value: "/var/log/es-gateway/access.log"},
+				corev1.EnvVar{Name: "ES_GATEWAY_ACCESS_LOG_FORMAT", Value: "json"},
+			))
+
+			sidecar := d.Spec.Template.Spec.Containers[1]
+			Expect(sidecar.Name).To(Equal("log-shipper"))
+			Expect(sidecar.Image).To(Equal("example.com/log-shipper:v1"))
+			Expect(sidecar.VolumeMounts).To(Equal([]corev1.VolumeMount{{Name: AccessLogVolumeName, MountPath: "/var/log/es-gateway", ReadOnly: true}}))
+
+			// The user provided container must not be modified.
+			Expect(cfg.AccessLogSidecar.VolumeMounts).To(BeEmpty())
+		})
+
+		It("should not render the access log sidecar by default", func() {
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(d.Spec.Template.Spec.Containers).To(HaveLen(1))
+			for _, v := range d.Spec.Template.Spec.Volumes {
+				Expect(v.Name).NotTo(Equal(AccessLogVolumeName))
+			}
+			for _, env := range d.Spec.Template.Spec.Containers[0].Env {
+				Expect(env.Name).NotTo(HavePrefix("ES_GATEWAY_ACCESS_LOG_"))
+			}
+		})
+
+		It("should not set a rate limit by default", func() {
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			for _, env := range d.Spec.Template.Spec.Containers[0].Env {
+				Expect(env.Name).NotTo(HavePrefix("ES_GATEWAY_RATE_LIMIT_"))
+			}
+		})
+
+		It("should set the rate limit when configured", func() {
+			burst := int32(200)
+			cfg.RateLimit = &operatorv1.ESGatewayRateLimit{RequestsPerSecond: 50, Burst: &burst}
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			env := d.Spec.Template.Spec.Containers[0].Env
+			Expect(env).To(ContainElement(corev1.EnvVar{Name: "ES_GATEWAY_RATE_LIMIT_RPS", Value: "50"}))
+			Expect(env).To(ContainElement(corev1.EnvVar{Name: "ES_GATEWAY_RATE_LIMIT_BURST", Value: "200"}))
+		})
+
+		It("should not set the burst when only the rate is configured", func() {
+			cfg.RateLimit = &operatorv1.ESGatewayRateLimit{RequestsPerSecond: 50}
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			env := d.Spec.Template.Spec.Containers[0].Env
+			Expect(env).To(ContainElement(corev1.EnvVar{Name: "ES_GATEWAY_RATE_LIMIT_RPS", Value: "50"}))
+			for _, e := range env {
+				Expect(e.Name).NotTo(Equal("ES_GATEWAY_RATE_LIMIT_BURST"))
+			}
+		})
+
+		It("should not mount a custom config file by default", func() {
+			component := EsGateway(cfg)
+			resources, toDelete := component.Objects()
+			Expect(rtest.GetResource(resources, ConfigMapName, render.ElasticsearchNamespace, "", "v1", "ConfigMap")).To(BeNil())
+			Expect(rtest.GetResource(toDelete, ConfigMapName, render.ElasticsearchNamespace, "", "v1", "ConfigMap")).NotTo(BeNil())
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			for _, v := range d.Spec.Template.Spec.Volumes {
+				Expect(v.Name).NotTo(Equal(ConfigMapName))
+			}
+		})
+
+		It("should mount the custom config file when configured", func() {
+			cfg.CustomConfig = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-es-gateway-config", Namespace: common.OperatorNamespace()},
+				Data:       map[string]string{ConfigFileKey: "maxIdleConns: 10\n"},
+			}
+			component := EsGateway(cfg)
+			resources, _ := component.Objects()
+
+			cm, ok := rtest.GetResource(resources, ConfigMapName, render.ElasticsearchNamespace, "", "v1", "ConfigMap").(*corev1.ConfigMap)
+			Expect(ok).To(BeTrue())
+			Expect(cm.Data).To(Equal(map[string]string{"config.yaml": "maxIdleConns: 10\n"}))
+
+			d, ok := rtest.GetResource(resources, DeploymentName, render.ElasticsearchNamespace, "apps", "v1", "Deployment").(*appsv1.Deployment)
+			Expect(ok).To(BeTrue())
+			Expect(d.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: ConfigMapName,
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: ConfigMapName},
+					},
+				},
+			}))
+			Expect(d.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      ConfigMapName,
+				MountPath: "/etc/es-gateway",
+				ReadOnly:  true,
+			}))
+			Expect(d.Spec.Template.Annotations).To(HaveKey("hash.operator.tigera.io/es-gateway-config"))
+		})
+
 		It("should set the right env when FIPS mode is enabled", func() {
 			kp, bundle := getTLS(installation)
 			enabled := operatorv1.FIPSModeEnabled
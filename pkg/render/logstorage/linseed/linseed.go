@@ -524,8 +524,9 @@ func (l *linseed) linseedDeployment() *appsv1.Deployment {
 func (l *linseed) linseedServiceAccount() *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      ServiceAccountName,
-			Namespace: l.namespace,
+			Name:        ServiceAccountName,
+			Namespace:   l.namespace,
+			Annotations: rmeta.GetServiceAccountAnnotations(l.cfg.Installation, operatorv1.ComponentNameLinseed),
 		},
 	}
 }
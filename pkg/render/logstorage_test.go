@@ -25,6 +25,7 @@ import (
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
+	cmnv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/common/v1"
 	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
 	kbv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/kibana/v1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -774,6 +775,70 @@ var _ = Describe("Elasticsearch rendering tests", func() {
 			Expect(nodeSelectors["k2"]).To(Equal("v2"))
 		})
 
+		It("should render the snapshot repository credentials and egress", func() {
+			cfg.LogStorage.Spec.SnapshotRepository = &operatorv1.SnapshotRepository{
+				Name:                  "tigera-backup",
+				Type:                  operatorv1.SnapshotRepositoryTypeS3,
+				Bucket:                "es-backups",
+				CredentialsSecretName: "es-snapshot-credentials",
+			}
+			cfg.SnapshotRepositoryCredentials = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "es-snapshot-credentials", Namespace: common.OperatorNamespace()},
+				Data:       map[string][]byte{"s3.client.default.access_key": []byte("access")},
+			}
+			component := render.LogStorage(cfg)
+
+			createResources, _ := component.Objects()
+			Expect(rtest.GetResource(createResources, "es-snapshot-credentials", render.ElasticsearchNamespace, "", "", "")).NotTo(BeNil())
+			Expect(getElasticsearch(createResources).Spec.SecureSettings).To(ConsistOf(cmnv1.SecretSource{SecretName: "es-snapshot-credentials"}))
+
+			policy := rtest.GetResource(createResources, render.ElasticsearchPolicyName, render.ElasticsearchNamespace, "projectcalico.org", "v3", "NetworkPolicy").(*v3.NetworkPolicy)
+			Expect(policy.Spec.Egress).To(ContainElement(v3.Rule{
+				Action:      v3.Allow,
+				Protocol:    &networkpolicy.TCPProtocol,
+				Destination: v3.EntityRule{Domains: []string{"*.amazonaws.com"}, Ports: networkpolicy.Ports(443)},
+			}))
+			for _, rule := range policy.Spec.Egress {
+				Expect(rule.Destination).NotTo(Equal(v3.EntityRule{Ports: networkpolicy.Ports(443)}))
+			}
+		})
+
+		It("should scope the snapshot repository egress to the configured endpoints and CIDRs", func() {
+			cfg.LogStorage.Spec.SnapshotRepository = &operatorv1.SnapshotRepository{
+				Name:      "tigera-backup",
+				Type:      operatorv1.SnapshotRepositoryTypeS3,
+				Bucket:    "es-backups",
+				Endpoints: []string{"minio.example.com"},
+				CIDRs:     []string{"10.0.0.0/24"},
+			}
+			component := render.LogStorage(cfg)
+
+			createResources, _ := component.Objects()
+			policy := rtest.GetResource(createResources, render.ElasticsearchPolicyName, render.ElasticsearchNamespace, "projectcalico.org", "v3", "NetworkPolicy").(*v3.NetworkPolicy)
+			Expect(policy.Spec.Egress).To(ContainElements(
+				v3.Rule{
+					Action:      v3.Allow,
+					Protocol:    &networkpolicy.TCPProtocol,
+					Destination: v3.EntityRule{Domains: []string{"minio.example.com"}, Ports: networkpolicy.Ports(443)},
+				},
+				v3.Rule{
+					Action:      v3.Allow,
+					Protocol:    &networkpolicy.TCPProtocol,
+					Destination: v3.EntityRule{Nets: []string{"10.0.0.0/24"}, Ports: networkpolicy.Ports(443)},
+				},
+			))
+			for _, rule := range policy.Spec.Egress {
+				Expect(rule.Destination.Domains).NotTo(ContainElement("*.amazonaws.com"))
+			}
+		})
+
+		It("should not render snapshot repository settings by default", func() {
+			component := render.LogStorage(cfg)
+
+			createResources, _ := component.Objects()
+			Expect(getElasticsearch(createResources).Spec.SecureSettings).To(BeEmpty())
+		})
+
 		It("should configures Kibana publicBaseUrl when BaseURL is specified", func() {
 			cfg.ElasticLicenseType = render.ElasticsearchLicenseTypeBasic
 			cfg.BaseURL = "https://test.domain.com"
@@ -1233,6 +1298,24 @@ var _ = Describe("Elasticsearch rendering tests", func() {
 					Expect(pod.Env[0].Value).To(Equal("-Xms2G -Xmx2G"))
 				})
 
+				It("sets the JVM heap size to the configured heap size", func() {
+					heapSize := resource.MustParse("3Gi")
+					cfg.LogStorage.Spec.Nodes = &operatorv1.Nodes{
+						Count:    1,
+						HeapSize: &heapSize,
+						ResourceRequirements: &corev1.ResourceRequirements{
+							Limits:   corev1.ResourceList{"memory": resource.MustParse("8Gi")},
+							Requests: corev1.ResourceList{"memory": resource.MustParse("8Gi")},
+						},
+					}
+
+					component := render.LogStorage(cfg)
+
+					createResources, _ := component.Objects()
+					pod := getElasticsearch(createResources).Spec.NodeSets[0].PodTemplate.Spec.Containers[0]
+					Expect(pod.Env[0].Value).To(Equal("-Xms3G -Xmx3G"))
+				})
+
 				It("sets value of Limits to user's Requests when user's Limits is not set and default Limits is lesser than Requests", func() {
 					res := corev1.ResourceRequirements{
 						Requests: corev1.ResourceList{
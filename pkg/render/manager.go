@@ -130,6 +130,7 @@ type ManagerConfiguration struct {
 	VoltronRouteConfig *manager.VoltronRouteConfig
 
 	KeyValidatorConfig authentication.KeyValidatorConfig
+	Authentication     *operatorv1.Authentication
 	ESSecrets          []*corev1.Secret
 	ClusterConfig      *relasticsearch.ClusterConfig
 	PullSecrets        []*corev1.Secret
@@ -221,7 +222,7 @@ func (c *managerComponent) Objects() ([]client.Object, []client.Object) {
 
 	if !c.cfg.Tenant.MultiTenant() {
 		// In multi-tenant environments, the namespace is pre-created. So, only create it if we're not in a multi-tenant environment.
-		objs = append(objs, CreateNamespace(c.cfg.Namespace, c.cfg.Installation.KubernetesProvider, PSSRestricted))
+		objs = append(objs, CreateNamespace(c.cfg.Namespace, c.cfg.Installation, PSSRestricted))
 
 		// For multi-tenant environments, the management cluster itself isn't shown in the UI so we only need to create these
 		// when there is no tenant.
@@ -953,7 +954,7 @@ func (c *managerComponent) managerAllowTigeraNetworkPolicy() *v3.NetworkPolicy {
 		{
 			Action:      v3.Allow,
 			Protocol:    &networkpolicy.TCPProtocol,
-			Destination: DexEntityRule,
+			Destination: DexEntityRule(c.cfg.Authentication),
 		},
 		{
 			Action:      v3.Allow,
@@ -35,6 +35,7 @@ import (
 	"github.com/tigera/operator/pkg/render/common/authentication"
 	relasticsearch "github.com/tigera/operator/pkg/render/common/elasticsearch"
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/networkpolicy"
 	"github.com/tigera/operator/pkg/render/common/podaffinity"
 	"github.com/tigera/operator/pkg/render/common/secret"
 	rtest "github.com/tigera/operator/pkg/render/common/test"
@@ -1004,6 +1005,29 @@ var _ = Describe("Tigera Secure Manager rendering tests", func() {
 			Entry("for management/standalone, kube-dns", testutils.AllowTigeraScenario{ManagedCluster: false, Openshift: false}),
 			Entry("for management/standalone, openshift-dns", testutils.AllowTigeraScenario{ManagedCluster: false, Openshift: true}),
 		)
+
+		It("should allow egress to Dex in a custom dex namespace", func() {
+			resources := renderObjects(renderConfig{
+				oidc:                    true,
+				installation:            installation,
+				compliance:              compliance,
+				complianceFeatureActive: true,
+				ns:                      render.ManagerNamespace,
+				authentication:          &operatorv1.Authentication{Spec: operatorv1.AuthenticationSpec{DexNamespace: "tenant-a-dex"}},
+			})
+
+			policy := testutils.GetAllowTigeraPolicyFromResources(policyName, resources)
+			Expect(policy.Spec.Egress).To(ContainElement(v3.Rule{
+				Action:      v3.Allow,
+				Protocol:    &networkpolicy.TCPProtocol,
+				Destination: networkpolicy.CreateEntityRule("tenant-a-dex", render.DexObjectName, render.DexPort),
+			}))
+			Expect(policy.Spec.Egress).NotTo(ContainElement(v3.Rule{
+				Action:      v3.Allow,
+				Protocol:    &networkpolicy.TCPProtocol,
+				Destination: networkpolicy.CreateEntityRule(render.DexNamespace, render.DexObjectName, render.DexPort),
+			}))
+		})
 	})
 
 	Context("multi-tenant rendering", func() {
@@ -1294,6 +1318,7 @@ type renderConfig struct {
 	tenant                  *operatorv1.Tenant
 	manager                 *operatorv1.Manager
 	externalElastic         bool
+	authentication          *operatorv1.Authentication
 }
 
 func renderObjects(roc renderConfig) []client.Object {
@@ -1366,6 +1391,7 @@ func renderObjects(roc renderConfig) []client.Object {
 		Tenant:                  roc.tenant,
 		Manager:                 roc.manager,
 		ExternalElastic:         roc.externalElastic,
+		Authentication:          roc.authentication,
 	}
 	component, err := render.Manager(cfg)
 	Expect(err).To(BeNil(), "Expected Manager to create successfully %s", err)
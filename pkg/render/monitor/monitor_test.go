@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v3 "github.com/tigera/api/pkg/apis/projectcalico/v3"
+	"github.com/tigera/api/pkg/lib/numorstring"
 
 	operatorv1 "github.com/tigera/operator/api/v1"
 	"github.com/tigera/operator/pkg/apis"
@@ -939,6 +940,35 @@ var _ = Describe("monitor rendering tests", func() {
 			},
 		}))
 	})
+
+	It("Should scrape Felix metrics on the node metrics port if it is set", func() {
+		cfg.Installation.NodeMetricsPort = ptr.Int32ToPtr(1234)
+		component := monitor.Monitor(cfg)
+		Expect(component.ResolveImages(nil)).NotTo(HaveOccurred())
+		toCreate, _ := component.Objects()
+
+		sm := rtest.GetResource(toCreate, monitor.CalicoNodeMonitor, common.TigeraPrometheusNamespace, "monitoring.coreos.com", "v1", monitoringv1.ServiceMonitorsKind).(*monitoringv1.ServiceMonitor)
+		Expect(sm.Spec.Endpoints).To(HaveLen(3))
+		Expect(sm.Spec.Endpoints[2]).To(Equal(monitoringv1.Endpoint{
+			HonorLabels:   true,
+			Interval:      "5s",
+			Port:          render.NodeMetricsPortName,
+			ScrapeTimeout: "5s",
+			Scheme:        "http",
+		}))
+
+		// Prometheus must be allowed to reach Felix on the same port.
+		policyComponent := monitor.MonitorPolicy(cfg)
+		policies, _ := policyComponent.Objects()
+		policy := testutils.GetAllowTigeraPolicyFromResources(types.NamespacedName{Name: "allow-tigera.prometheus", Namespace: "tigera-prometheus"}, policies)
+		Expect(policy).NotTo(BeNil())
+		var ports []numorstring.Port
+		for _, rule := range policy.Spec.Egress {
+			ports = append(ports, rule.Destination.Ports...)
+		}
+		Expect(ports).To(ContainElement(numorstring.SinglePort(1234)))
+		Expect(ports).NotTo(ContainElement(numorstring.SinglePort(uint16(render.DefaultNodeMetricsPort))))
+	})
 })
 
 type resource struct {
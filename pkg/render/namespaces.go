@@ -15,7 +15,11 @@
 package render
 
 import (
+	"fmt"
+	"strings"
+
 	rmeta "github.com/tigera/operator/pkg/render/common/meta"
+	"github.com/tigera/operator/pkg/render/common/resourcequota"
 	"github.com/tigera/operator/pkg/render/common/secret"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +40,13 @@ type NamespaceConfiguration struct {
 	Installation *operatorv1.InstallationSpec
 	PullSecrets  []*corev1.Secret
 	Terminating  bool
+
+	// ResourceQuotas are the ResourceQuotas to create in operator managed namespaces.
+	ResourceQuotas []operatorv1.NamespaceResourceQuota
+
+	// StaleResourceQuotaNamespaces are the operator managed namespaces from which
+	// a previously created ResourceQuota should be removed.
+	StaleResourceQuotaNamespaces []string
 }
 
 type namespaceComponent struct {
@@ -53,7 +64,7 @@ func (c *namespaceComponent) SupportedOSType() rmeta.OSType {
 
 func (c *namespaceComponent) Objects() ([]client.Object, []client.Object) {
 	ns := []client.Object{
-		CreateNamespace(common.CalicoNamespace, c.cfg.Installation.KubernetesProvider, PSSPrivileged),
+		CreateNamespace(common.CalicoNamespace, c.cfg.Installation, PSSPrivileged),
 	}
 
 	// If we're terminating, we don't want to delete the namespace right away.
@@ -64,17 +75,27 @@ func (c *namespaceComponent) Objects() ([]client.Object, []client.Object) {
 
 	if c.cfg.Installation.Variant == operatorv1.TigeraSecureEnterprise {
 		// We need to always have ns tigera-dex even when the Authentication CR is not present, so policies can be added to this namespace.
-		ns = append(ns, CreateNamespace(DexObjectName, c.cfg.Installation.KubernetesProvider, PSSRestricted))
+		ns = append(ns, CreateNamespace(DexObjectName, c.cfg.Installation, PSSRestricted))
 	}
 	if len(c.cfg.PullSecrets) > 0 {
 		ns = append(ns, secret.ToRuntimeObjects(secret.CopyToNamespace(common.CalicoNamespace, c.cfg.PullSecrets...)...)...)
 	}
 
+	var toDelete []client.Object
+	for _, q := range c.cfg.ResourceQuotas {
+		rq := resourcequota.ResourceQuota(resourcequota.NamespaceResourceQuotaName, q.Namespace, *q.Spec.DeepCopy())
+		rq.Labels = map[string]string{resourcequota.NamespaceResourceQuotaLabel: "true"}
+		ns = append(ns, rq)
+	}
+	for _, n := range c.cfg.StaleResourceQuotaNamespaces {
+		toDelete = append(toDelete, resourcequota.ResourceQuota(resourcequota.NamespaceResourceQuotaName, n, corev1.ResourceQuotaSpec{}))
+	}
+
 	if c.cfg.Terminating {
-		return nil, ns
+		return nil, append(ns, toDelete...)
 	}
 
-	return ns, nil
+	return ns, toDelete
 }
 
 func (c *namespaceComponent) Ready() bool {
@@ -89,7 +110,7 @@ const (
 	PSSRestricted = "restricted"
 )
 
-func CreateNamespace(name string, provider operatorv1.Provider, pss PodSecurityStandard) *corev1.Namespace {
+func CreateNamespace(name string, installation *operatorv1.InstallationSpec, pss PodSecurityStandard) *corev1.Namespace {
 	ns := &corev1.Namespace{
 		TypeMeta: metav1.TypeMeta{Kind: "Namespace", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
@@ -103,9 +124,15 @@ func CreateNamespace(name string, provider operatorv1.Provider, pss PodSecurityS
 
 	// Add in labels for configuring pod security standards.
 	// https://kubernetes.io/docs/concepts/security/pod-security-standards/
-	ns.Labels["pod-security.kubernetes.io/enforce"] = string(pss)
-	ns.Labels["pod-security.kubernetes.io/enforce-version"] = "latest"
+	for _, mode := range podSecurityAdmissionModes(installation) {
+		ns.Labels[fmt.Sprintf("pod-security.kubernetes.io/%s", strings.ToLower(string(mode)))] = string(pss)
+		ns.Labels[fmt.Sprintf("pod-security.kubernetes.io/%s-version", strings.ToLower(string(mode)))] = "latest"
+	}
 
+	var provider operatorv1.Provider
+	if installation != nil {
+		provider = installation.KubernetesProvider
+	}
 	switch provider {
 	case operatorv1.ProviderOpenShift:
 		ns.Labels["openshift.io/run-level"] = "0"
@@ -115,3 +142,12 @@ func CreateNamespace(name string, provider operatorv1.Provider, pss PodSecurityS
 	}
 	return ns
 }
+
+// podSecurityAdmissionModes returns the Pod Security Admission modes to label namespaces with. Only the enforce
+// mode is labelled unless the Installation configures otherwise.
+func podSecurityAdmissionModes(installation *operatorv1.InstallationSpec) []operatorv1.PodSecurityAdmissionMode {
+	if installation == nil || len(installation.PodSecurityAdmissionModes) == 0 {
+		return []operatorv1.PodSecurityAdmissionMode{operatorv1.PodSecurityAdmissionModeEnforce}
+	}
+	return installation.PodSecurityAdmissionModes
+}
@@ -18,6 +18,8 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	operatorv1 "github.com/tigera/operator/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/tigera/operator/pkg/render"
@@ -44,6 +46,42 @@ var _ = Describe("Namespace rendering tests", func() {
 		Expect(meta.GetAnnotations()).NotTo(ContainElement("openshift.io/node-selector"))
 	})
 
+	It("should label the calico-system namespace as privileged for pod security admission enforcement only by default", func() {
+		component := render.Namespaces(cfg)
+		resources, _ := component.Objects()
+		Expect(resources).To(HaveLen(1))
+		labels := resources[0].(metav1.ObjectMetaAccessor).GetObjectMeta().GetLabels()
+		Expect(labels).To(HaveKeyWithValue("pod-security.kubernetes.io/enforce", "privileged"))
+		Expect(labels).To(HaveKeyWithValue("pod-security.kubernetes.io/enforce-version", "latest"))
+		Expect(labels).NotTo(HaveKey("pod-security.kubernetes.io/audit"))
+		Expect(labels).NotTo(HaveKey("pod-security.kubernetes.io/warn"))
+	})
+
+	It("should label namespaces for each configured pod security admission mode", func() {
+		cfg.Installation.Variant = operatorv1.TigeraSecureEnterprise
+		cfg.Installation.PodSecurityAdmissionModes = []operatorv1.PodSecurityAdmissionMode{
+			operatorv1.PodSecurityAdmissionModeEnforce, operatorv1.PodSecurityAdmissionModeAudit, operatorv1.PodSecurityAdmissionModeWarn,
+		}
+		component := render.Namespaces(cfg)
+		resources, _ := component.Objects()
+		Expect(resources).To(HaveLen(2))
+
+		// calico-system hosts calico-node, so it is labelled privileged for every mode.
+		rtest.ExpectResourceTypeAndObjectMetadata(resources[0], "calico-system", "", "", "v1", "Namespace")
+		labels := resources[0].(metav1.ObjectMetaAccessor).GetObjectMeta().GetLabels()
+		for _, mode := range []string{"enforce", "audit", "warn"} {
+			Expect(labels).To(HaveKeyWithValue("pod-security.kubernetes.io/"+mode, "privileged"))
+			Expect(labels).To(HaveKeyWithValue("pod-security.kubernetes.io/"+mode+"-version", "latest"))
+		}
+
+		// tigera-dex hosts no privileged components, so it keeps the restricted standard.
+		rtest.ExpectResourceTypeAndObjectMetadata(resources[1], "tigera-dex", "", "", "v1", "Namespace")
+		labels = resources[1].(metav1.ObjectMetaAccessor).GetObjectMeta().GetLabels()
+		for _, mode := range []string{"enforce", "audit", "warn"} {
+			Expect(labels).To(HaveKeyWithValue("pod-security.kubernetes.io/"+mode, "restricted"))
+		}
+	})
+
 	It("should render a namespace for openshift", func() {
 		cfg.Installation.KubernetesProvider = operatorv1.ProviderOpenShift
 		component := render.Namespaces(cfg)
@@ -90,4 +128,31 @@ var _ = Describe("Namespace rendering tests", func() {
 		Expect(meta.GetLabels()["openshift.io/run-level"]).To(Equal("0"))
 		Expect(meta.GetAnnotations()["openshift.io/node-selector"]).To(Equal(""))
 	})
+
+	It("should render resource quotas when configured", func() {
+		spec := corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("4"),
+				corev1.ResourcePods:        resource.MustParse("50"),
+			},
+		}
+		cfg.ResourceQuotas = []operatorv1.NamespaceResourceQuota{{Namespace: "calico-system", Spec: spec}}
+		cfg.StaleResourceQuotaNamespaces = []string{"tigera-dex"}
+		component := render.Namespaces(cfg)
+		resources, toDelete := component.Objects()
+		Expect(resources).To(HaveLen(2))
+		rtest.ExpectResourceTypeAndObjectMetadata(resources[1], "tigera-namespace-quota", "calico-system", "", "v1", "ResourceQuota")
+		Expect(resources[1].(*corev1.ResourceQuota).Spec).To(Equal(spec))
+		Expect(resources[1].(*corev1.ResourceQuota).Labels).To(HaveKeyWithValue("operator.tigera.io/namespace-quota", "true"))
+
+		Expect(toDelete).To(HaveLen(1))
+		rtest.ExpectResourceTypeAndObjectMetadata(toDelete[0], "tigera-namespace-quota", "tigera-dex", "", "v1", "ResourceQuota")
+	})
+
+	It("should not render resource quotas by default", func() {
+		component := render.Namespaces(cfg)
+		resources, toDelete := component.Objects()
+		Expect(resources).To(HaveLen(1))
+		Expect(toDelete).To(BeEmpty())
+	})
 })
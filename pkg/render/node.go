@@ -50,6 +50,9 @@ const (
 	birdTemplateHashAnnotation = "hash.operator.tigera.io/bird-templates"
 	BPFOperatorAnnotation      = "operator.tigera.io/bpfEnabled"
 
+	// NodeMetricsPortOperatorAnnotation records the node metrics port the operator set on the FelixConfiguration.
+	NodeMetricsPortOperatorAnnotation = "operator.tigera.io/nodeMetricsPort"
+
 	nodeCniConfigAnnotation   = "hash.operator.tigera.io/cni-config"
 	bgpLayoutHashAnnotation   = "hash.operator.tigera.io/bgp-layout"
 	bgpBindModeHashAnnotation = "hash.operator.tigera.io/bgp-bind-mode"
@@ -67,8 +70,27 @@ const (
 	CalicoNodeObjectName          = "calico-node"
 	CalicoCNIPluginObjectName     = "calico-cni-plugin"
 	BPFVolumeName                 = "bpffs"
+
+	// BGPConvergedConditionType is the pod condition calico-node sets on its own pod once its BGP sessions
+	// have converged. It is used as a readiness gate when spec.calicoNetwork.bgpReadinessGate is enabled.
+	BGPConvergedConditionType corev1.PodConditionType = "projectcalico.org/BGPConverged"
+
+	// NodeMetricsPortName is the name of the metrics Service port that exposes the Felix Prometheus metrics.
+	NodeMetricsPortName = "felix-metrics-port"
+	// DefaultNodeMetricsPort is the port Felix serves Prometheus metrics on when no port is configured.
+	DefaultNodeMetricsPort int32 = 9091
 )
 
+// NodeMetricsPort returns the port calico/node serves Felix Prometheus metrics on, and whether the Installation enables
+// them. The calico/node environment, the metrics Service and the ServiceMonitor all derive the port from here so that
+// they agree.
+func NodeMetricsPort(installation *operatorv1.InstallationSpec) (int32, bool) {
+	if installation == nil || installation.NodeMetricsPort == nil {
+		return DefaultNodeMetricsPort, false
+	}
+	return *installation.NodeMetricsPort, true
+}
+
 var (
 	// The port used by calico/node to report Calico Enterprise BGP metrics.
 	// This is currently not intended to be user configurable.
@@ -128,6 +150,10 @@ type NodeConfiguration struct {
 
 	// Whether the cluster supports pod security policies.
 	UsePSP bool
+
+	// The generation of the Installation. Added as a label to calico-node pods when the Installation
+	// sets CalicoNodeGenerationLabel.
+	InstallationGeneration int64
 }
 
 // Node creates the node daemonset and other resources for the daemonset to operate normally.
@@ -263,9 +289,10 @@ func (c *nodeComponent) nodeServiceAccount() *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:       CalicoNodeObjectName,
-			Namespace:  common.CalicoNamespace,
-			Finalizers: finalizer,
+			Name:        CalicoNodeObjectName,
+			Namespace:   common.CalicoNamespace,
+			Finalizers:  finalizer,
+			Annotations: rmeta.GetServiceAccountAnnotations(c.cfg.Installation, operatorv1.ComponentNameNode),
 		},
 	}
 }
@@ -903,9 +930,9 @@ func (c *nodeComponent) nodeDaemonset(cniCfgMap *corev1.ConfigMap) *appsv1.Daemo
 	}
 
 	// Include annotation for prometheus scraping configuration.
-	if c.cfg.Installation.NodeMetricsPort != nil {
+	if port, enabled := NodeMetricsPort(c.cfg.Installation); enabled {
 		annotations["prometheus.io/scrape"] = "true"
-		annotations["prometheus.io/port"] = fmt.Sprintf("%d", *c.cfg.Installation.NodeMetricsPort)
+		annotations["prometheus.io/port"] = fmt.Sprintf("%d", port)
 	}
 
 	// check tech preview annotation for calico-node apparmor profile
@@ -965,6 +992,11 @@ func (c *nodeComponent) nodeDaemonset(cniCfgMap *corev1.ConfigMap) *appsv1.Daemo
 		annotations[bgpBindModeHashAnnotation] = rmeta.AnnotationHash(c.cfg.BindMode)
 	}
 
+	var labels map[string]string
+	if key := c.cfg.Installation.CalicoNodeGenerationLabel; key != "" {
+		labels = map[string]string{key: strconv.FormatInt(c.cfg.InstallationGeneration, 10)}
+	}
+
 	// Determine the name to use for the calico/node daemonset. For mixed-mode, we run the enterprise DaemonSet
 	// with its own name so as to not conflict.
 	ds := appsv1.DaemonSet{
@@ -976,6 +1008,7 @@ func (c *nodeComponent) nodeDaemonset(cniCfgMap *corev1.ConfigMap) *appsv1.Daemo
 		Spec: appsv1.DaemonSetSpec{
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
 					Annotations: annotations,
 				},
 				Spec: corev1.PodSpec{
@@ -990,7 +1023,7 @@ func (c *nodeComponent) nodeDaemonset(cniCfgMap *corev1.ConfigMap) *appsv1.Daemo
 					Volumes:                       c.nodeVolumes(),
 				},
 			},
-			UpdateStrategy: c.cfg.Installation.NodeUpdateStrategy,
+			UpdateStrategy: nodeUpdateStrategy(c.cfg.Installation),
 		},
 	}
 
@@ -1007,12 +1040,62 @@ func (c *nodeComponent) nodeDaemonset(cniCfgMap *corev1.ConfigMap) *appsv1.Daemo
 		migration.LimitDaemonSetToMigratedNodes(&ds)
 	}
 
+	if bgpReadinessGateEnabled(c.cfg.Installation) {
+		ds.Spec.Template.Spec.ReadinessGates = []corev1.PodReadinessGate{{ConditionType: BGPConvergedConditionType}}
+	}
+
 	if overrides := c.cfg.Installation.CalicoNodeDaemonSet; overrides != nil {
 		rcomp.ApplyDaemonSetOverrides(&ds, overrides)
+
+		// An affinity override replaces the operator's affinity wholesale. Re-apply the operator's required node
+		// affinity so calico-node is still kept off nodes it cannot run on.
+		if overrides.GetAffinity() != nil && affinity != nil {
+			ds.Spec.Template.Spec.Affinity = withRequiredNodeAffinity(ds.Spec.Template.Spec.Affinity, affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+		}
+
+		// Add any user-provided sidecar containers after those managed by the operator.
+		ds.Spec.Template.Spec.Containers = append(ds.Spec.Template.Spec.Containers, overrides.GetExtraContainers()...)
 	}
 	return &ds
 }
 
+// withRequiredNodeAffinity returns a copy of the given affinity that additionally requires the given node selector.
+// Node selector terms are ORed, so each of the required terms is ANDed into each of the existing terms.
+func withRequiredNodeAffinity(affinity *corev1.Affinity, required *corev1.NodeSelector) *corev1.Affinity {
+	affinity = affinity.DeepCopy()
+	if required == nil || len(required.NodeSelectorTerms) == 0 {
+		return affinity
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	existing := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if existing == nil || len(existing.NodeSelectorTerms) == 0 {
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = required.DeepCopy()
+		return affinity
+	}
+
+	var terms []corev1.NodeSelectorTerm
+	for _, e := range existing.NodeSelectorTerms {
+		for _, r := range required.NodeSelectorTerms {
+			term := *e.DeepCopy()
+			term.MatchExpressions = append(term.MatchExpressions, r.DeepCopy().MatchExpressions...)
+			term.MatchFields = append(term.MatchFields, r.DeepCopy().MatchFields...)
+			terms = append(terms, term)
+		}
+	}
+	existing.NodeSelectorTerms = terms
+	return affinity
+}
+
+// hostPathInitContainerName is the name of the init container that prepares the host paths of a non-privileged
+// calico-node.
+const hostPathInitContainerName = "hostpath-init"
+
+// CalicoNodeContainerNames are the names of the containers and init containers the operator may add to the
+// calico-node DaemonSet.
+var CalicoNodeContainerNames = []string{CalicoNodeObjectName, "install-cni", "flexvol-driver", "mount-bpffs", hostPathInitContainerName}
+
 // cniDirectories returns the binary and network config directories for the configured platform.
 func (c *nodeComponent) cniDirectories() (string, string, string) {
 	var cniBinDir, cniNetDir, cniLogDir string
@@ -1296,6 +1379,7 @@ func (c *nodeComponent) nodeContainer() corev1.Container {
 		VolumeMounts:    c.nodeVolumeMounts(),
 		LivenessProbe:   lp,
 		ReadinessProbe:  rp,
+		StartupProbe:    c.nodeStartupProbe(),
 		Lifecycle:       c.nodeLifecycle(),
 	}
 }
@@ -1413,7 +1497,6 @@ func (c *nodeComponent) nodeEnvVars() []corev1.EnvVar {
 		{Name: "WAIT_FOR_DATASTORE", Value: "true"},
 		{Name: "CLUSTER_TYPE", Value: clusterType},
 		{Name: "CALICO_DISABLE_FILE_LOGGING", Value: "false"},
-		{Name: "FELIX_DEFAULTENDPOINTTOHOSTACTION", Value: "ACCEPT"},
 		{Name: "FELIX_HEALTHENABLED", Value: "true"},
 		{Name: "FELIX_HEALTHPORT", Value: fmt.Sprintf("%d", c.cfg.FelixHealthPort)},
 		{
@@ -1437,6 +1520,12 @@ func (c *nodeComponent) nodeEnvVars() []corev1.EnvVar {
 		{Name: "NO_DEFAULT_POOLS", Value: "true"},
 	}
 
+	// Felix's environment takes precedence over FelixConfiguration, so only accept traffic from workloads to the host
+	// here when the action is not set on the Installation. Otherwise, the operator writes it to FelixConfiguration.
+	if cn := c.cfg.Installation.CalicoNetwork; cn == nil || cn.DefaultEndpointToHostAction == nil {
+		nodeEnv = append(nodeEnv, corev1.EnvVar{Name: "FELIX_DEFAULTENDPOINTTOHOSTACTION", Value: "ACCEPT"})
+	}
+
 	// We need at least the CN or URISAN set, we depend on the validation
 	// done by the core_controller that the Secret will have one.
 	if c.cfg.TLS.TyphaCommonName != "" {
@@ -1446,6 +1535,11 @@ func (c *nodeComponent) nodeEnvVars() []corev1.EnvVar {
 		nodeEnv = append(nodeEnv, corev1.EnvVar{Name: "FELIX_TYPHAURISAN", Value: c.cfg.TLS.TyphaURISAN})
 	}
 
+	if bgpReadinessGateEnabled(c.cfg.Installation) {
+		// Tell calico-node which pod condition to set once BGP has converged.
+		nodeEnv = append(nodeEnv, corev1.EnvVar{Name: "CALICO_BGP_READINESS_GATE", Value: string(BGPConvergedConditionType)})
+	}
+
 	if c.cfg.Installation.CNI != nil && c.cfg.Installation.CNI.Type == operatorv1.PluginCalico {
 		// If using Calico CNI, we need to manage CNI credential rotation on the host.
 		nodeEnv = append(nodeEnv, corev1.EnvVar{Name: "CALICO_MANAGE_CNI", Value: "true"})
@@ -1602,26 +1696,29 @@ func (c *nodeComponent) nodeEnvVars() []corev1.EnvVar {
 		nodeEnv = append(nodeEnv, extraNodeEnv...)
 	}
 
-	if c.cfg.Installation.NodeMetricsPort != nil {
+	if port, enabled := NodeMetricsPort(c.cfg.Installation); enabled {
 		// If a node metrics port was given, then enable felix prometheus metrics and set the port.
 		// Note that this takes precedence over any FelixConfiguration resources in the cluster.
 		extraNodeEnv := []corev1.EnvVar{
 			{Name: "FELIX_PROMETHEUSMETRICSENABLED", Value: "true"},
-			{Name: "FELIX_PROMETHEUSMETRICSPORT", Value: fmt.Sprintf("%d", *c.cfg.Installation.NodeMetricsPort)},
+			{Name: "FELIX_PROMETHEUSMETRICSPORT", Value: fmt.Sprintf("%d", port)},
 		}
 		nodeEnv = append(nodeEnv, extraNodeEnv...)
 	}
 
-	// Configure provider specific environment variables here.
-	switch c.cfg.Installation.KubernetesProvider {
-	// For AKS/AzureVNET and EKS/VPCCNI, we must explicitly ask felix to add host IP's to wireguard ifaces
-	case operatorv1.ProviderAKS:
-		if c.cfg.Installation.CNI.Type == operatorv1.PluginAzureVNET {
-			nodeEnv = append(nodeEnv, corev1.EnvVar{Name: "FELIX_WIREGUARDHOSTENCRYPTIONENABLED", Value: "true"})
-		}
-	case operatorv1.ProviderEKS:
-		if c.cfg.Installation.CNI.Type == operatorv1.PluginAmazonVPC {
-			nodeEnv = append(nodeEnv, corev1.EnvVar{Name: "FELIX_WIREGUARDHOSTENCRYPTIONENABLED", Value: "true"})
+	// Configure provider specific environment variables here. WireGuard host encryption configured on the
+	// Installation is written to FelixConfiguration instead, so it must not be overridden.
+	if cn := c.cfg.Installation.CalicoNetwork; cn == nil || cn.WireguardHostEncryption == nil {
+		switch c.cfg.Installation.KubernetesProvider {
+		// For AKS/AzureVNET and EKS/VPCCNI, we must explicitly ask felix to add host IP's to wireguard ifaces
+		case operatorv1.ProviderAKS:
+			if c.cfg.Installation.CNI.Type == operatorv1.PluginAzureVNET {
+				nodeEnv = append(nodeEnv, corev1.EnvVar{Name: "FELIX_WIREGUARDHOSTENCRYPTIONENABLED", Value: "true"})
+			}
+		case operatorv1.ProviderEKS:
+			if c.cfg.Installation.CNI.Type == operatorv1.PluginAmazonVPC {
+				nodeEnv = append(nodeEnv, corev1.EnvVar{Name: "FELIX_WIREGUARDHOSTENCRYPTIONENABLED", Value: "true"})
+			}
 		}
 	}
 
@@ -1708,11 +1805,65 @@ func (c *nodeComponent) nodeLivenessReadinessProbes() (*corev1.Probe, *corev1.Pr
 	return lp, rp
 }
 
+// nodeStartupProbe creates the node's startup probe, if one is configured on the calico-node DaemonSet overrides.
+func (c *nodeComponent) nodeStartupProbe() *corev1.Probe {
+	if c.cfg.Installation.CalicoNodeDaemonSet == nil {
+		return nil
+	}
+	sp := c.cfg.Installation.CalicoNodeDaemonSet.GetStartupProbe(CalicoNodeObjectName)
+	if sp == nil {
+		return nil
+	}
+
+	probe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Host: "localhost",
+				Path: "/liveness",
+				Port: intstr.FromInt(c.cfg.FelixHealthPort),
+			},
+		},
+		TimeoutSeconds:   10,
+		PeriodSeconds:    10,
+		FailureThreshold: 30,
+	}
+	if sp.PeriodSeconds != nil {
+		probe.PeriodSeconds = *sp.PeriodSeconds
+	}
+	if sp.FailureThreshold != nil {
+		probe.FailureThreshold = *sp.FailureThreshold
+	}
+	return probe
+}
+
 // nodeMetricsService creates a Service which exposes two endpoints on calico/node for
 // reporting Prometheus metrics (for policy enforcement activity and BGP stats).
 // This service is used internally by Calico Enterprise and is separate from general
 // Prometheus metrics which are user-configurable.
 func (c *nodeComponent) nodeMetricsService() *corev1.Service {
+	ports := []corev1.ServicePort{
+		{
+			Name:       "calico-metrics-port",
+			Port:       int32(c.cfg.NodeReporterMetricsPort),
+			TargetPort: intstr.FromInt(c.cfg.NodeReporterMetricsPort),
+			Protocol:   corev1.ProtocolTCP,
+		},
+		{
+			Name:       "calico-bgp-metrics-port",
+			Port:       nodeBGPReporterPort,
+			TargetPort: intstr.FromInt(int(nodeBGPReporterPort)),
+			Protocol:   corev1.ProtocolTCP,
+		},
+	}
+	if port, enabled := NodeMetricsPort(c.cfg.Installation); enabled {
+		ports = append(ports, corev1.ServicePort{
+			Name:       NodeMetricsPortName,
+			Port:       port,
+			TargetPort: intstr.FromInt(int(port)),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+
 	return &corev1.Service{
 		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
@@ -1727,20 +1878,7 @@ func (c *nodeComponent) nodeMetricsService() *corev1.Service {
 			// a huge set of iptables rules for this service since there's an instance
 			// on every node.
 			ClusterIP: "None",
-			Ports: []corev1.ServicePort{
-				{
-					Name:       "calico-metrics-port",
-					Port:       int32(c.cfg.NodeReporterMetricsPort),
-					TargetPort: intstr.FromInt(c.cfg.NodeReporterMetricsPort),
-					Protocol:   corev1.ProtocolTCP,
-				},
-				{
-					Name:       "calico-bgp-metrics-port",
-					Port:       nodeBGPReporterPort,
-					TargetPort: intstr.FromInt(int(nodeBGPReporterPort)),
-					Protocol:   corev1.ProtocolTCP,
-				},
-			},
+			Ports:     ports,
 		},
 	}
 }
@@ -1783,7 +1921,7 @@ func (c *nodeComponent) hostPathInitContainer() corev1.Container {
 	}
 
 	return corev1.Container{
-		Name:            "hostpath-init",
+		Name:            hostPathInitContainerName,
 		Image:           c.nodeImage,
 		ImagePullPolicy: ImagePullPolicy(),
 		Command:         []string{"sh", "-c", "calico-node -hostpath-init"},
@@ -1807,10 +1945,10 @@ func (c *nodeComponent) runAsNonPrivileged() bool {
 func getAutodetectionMethod(ad *operatorv1.NodeAddressAutodetection) string {
 	if ad != nil {
 		if len(ad.Interface) != 0 {
-			return fmt.Sprintf("interface=%s", ad.Interface)
+			return fmt.Sprintf("interface=%s", strings.Join(InterfacePatterns(ad.Interface), ","))
 		}
 		if len(ad.SkipInterface) != 0 {
-			return fmt.Sprintf("skip-interface=%s", ad.SkipInterface)
+			return fmt.Sprintf("skip-interface=%s", strings.Join(InterfacePatterns(ad.SkipInterface), ","))
 		}
 		if len(ad.CanReach) != 0 {
 			return fmt.Sprintf("can-reach=%s", ad.CanReach)
@@ -1830,6 +1968,16 @@ func getAutodetectionMethod(ad *operatorv1.NodeAddressAutodetection) string {
 	return ""
 }
 
+// InterfacePatterns splits a comma-separated list of interface regexes, as accepted by the Interface and
+// SkipInterface autodetection methods, into its individual patterns with surrounding whitespace removed.
+func InterfacePatterns(s string) []string {
+	patterns := strings.Split(s, ",")
+	for i := range patterns {
+		patterns[i] = strings.TrimSpace(patterns[i])
+	}
+	return patterns
+}
+
 // GetIPv4Pool returns the IPv4 IPPool in an installation, or nil if one can't be found.
 func GetIPv4Pool(pools []operatorv1.IPPool) *operatorv1.IPPool {
 	for ii, pool := range pools {
@@ -1865,6 +2013,29 @@ func bgpEnabled(instance *operatorv1.InstallationSpec) bool {
 		*instance.CalicoNetwork.BGP == operatorv1.BGPEnabled
 }
 
+// nodeUpdateStrategy returns the calico-node DaemonSet update strategy, with maxUnavailable capped to
+// MaxConcurrentNodeRestarts if it is set. It is used for both the Linux and Windows DaemonSets, so the cap
+// applies to each of them separately rather than being a budget they share.
+func nodeUpdateStrategy(instance *operatorv1.InstallationSpec) appsv1.DaemonSetUpdateStrategy {
+	strategy := *instance.NodeUpdateStrategy.DeepCopy()
+	limit := instance.MaxConcurrentNodeRestarts
+	if limit == nil || strategy.RollingUpdate == nil {
+		return strategy
+	}
+	if mu := strategy.RollingUpdate.MaxUnavailable; mu != nil && mu.Type == intstr.Int && mu.IntVal > *limit {
+		capped := intstr.FromInt(int(*limit))
+		strategy.RollingUpdate.MaxUnavailable = &capped
+	}
+	return strategy
+}
+
+// bgpReadinessGateEnabled returns true if calico-node pod readiness should wait for BGP convergence, false otherwise.
+func bgpReadinessGateEnabled(instance *operatorv1.InstallationSpec) bool {
+	return bgpEnabled(instance) &&
+		instance.CalicoNetwork.BGPReadinessGate != nil &&
+		*instance.CalicoNetwork.BGPReadinessGate == operatorv1.BGPReadinessGateEnabled
+}
+
 // getMTU returns the MTU configured in the Installation if there is one, nil otherwise.
 func getMTU(instance *operatorv1.InstallationSpec) *int32 {
 	var mtu *int32
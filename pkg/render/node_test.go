@@ -2231,6 +2231,38 @@ var _ = Describe("Node rendering tests", func() {
 					rtest.ExpectEnv(ds.Spec.Template.Spec.Containers[0].Env, "IP_AUTODETECTION_METHOD", "skip-interface=eth*")
 				})
 
+				It("should support multiple interface regexes", func() {
+					defaultInstance.CalicoNetwork.NodeAddressAutodetectionV4.FirstFound = nil
+					defaultInstance.CalicoNetwork.NodeAddressAutodetectionV4.Interface = "^bond[0-9]+$, ^eth0\\.[0-9]+$"
+					component := render.Node(&cfg)
+					Expect(component.ResolveImages(nil)).To(BeNil())
+					resources, _ := component.Objects()
+
+					dsResource := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet")
+					Expect(dsResource).ToNot(BeNil())
+
+					ds := dsResource.(*appsv1.DaemonSet)
+					rtest.ExpectEnv(ds.Spec.Template.Spec.Containers[0].Env, "IP_AUTODETECTION_METHOD", "interface=^bond[0-9]+$,^eth0\\.[0-9]+$")
+				})
+
+				It("should support multiple skip-interface regexes for each family", func() {
+					defaultInstance.CalicoNetwork.NodeAddressAutodetectionV4.FirstFound = nil
+					defaultInstance.CalicoNetwork.NodeAddressAutodetectionV4.SkipInterface = "^docker.*,^veth.*,^cali.*"
+					defaultInstance.CalicoNetwork.NodeAddressAutodetectionV6 = &operatorv1.NodeAddressAutodetection{
+						Interface: "^bond0\\.[0-9]+$,^bond1\\.[0-9]+$",
+					}
+					component := render.Node(&cfg)
+					Expect(component.ResolveImages(nil)).To(BeNil())
+					resources, _ := component.Objects()
+
+					dsResource := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet")
+					Expect(dsResource).ToNot(BeNil())
+
+					ds := dsResource.(*appsv1.DaemonSet)
+					rtest.ExpectEnv(ds.Spec.Template.Spec.Containers[0].Env, "IP_AUTODETECTION_METHOD", "skip-interface=^docker.*,^veth.*,^cali.*")
+					rtest.ExpectEnv(ds.Spec.Template.Spec.Containers[0].Env, "IP6_AUTODETECTION_METHOD", "interface=^bond0\\.[0-9]+$,^bond1\\.[0-9]+$")
+				})
+
 				It("should support cidr", func() {
 					defaultInstance.CalicoNetwork.NodeAddressAutodetectionV4.FirstFound = nil
 					defaultInstance.CalicoNetwork.NodeAddressAutodetectionV4.CIDRS = []string{"10.0.1.0/24", "10.0.2.0/24"}
@@ -2333,6 +2365,38 @@ var _ = Describe("Node rendering tests", func() {
 				Expect(ds.Spec.Template.Annotations["prometheus.io/port"]).To(Equal("1234"))
 			})
 
+			It("should expose the NodeMetricsPort on the metrics service", func() {
+				var nodeMetricsPort int32 = 1234
+				defaultInstance.Variant = operatorv1.TigeraSecureEnterprise
+				defaultInstance.NodeMetricsPort = &nodeMetricsPort
+				cfg.NodeReporterMetricsPort = 9081
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, _ := component.Objects()
+
+				ds := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+				Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "FELIX_PROMETHEUSMETRICSPORT", Value: "1234"}))
+
+				svc := rtest.GetResource(resources, render.CalicoNodeMetricsService, "calico-system", "", "v1", "Service").(*corev1.Service)
+				Expect(svc.Spec.Ports).To(ContainElement(corev1.ServicePort{
+					Name:       render.NodeMetricsPortName,
+					Port:       1234,
+					TargetPort: intstr.FromInt(1234),
+					Protocol:   corev1.ProtocolTCP,
+				}))
+			})
+
+			It("should not expose a Felix metrics port on the metrics service if NodeMetricsPort is nil", func() {
+				defaultInstance.Variant = operatorv1.TigeraSecureEnterprise
+				cfg.NodeReporterMetricsPort = 9081
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, _ := component.Objects()
+
+				svc := rtest.GetResource(resources, render.CalicoNodeMetricsService, "calico-system", "", "v1", "Service").(*corev1.Service)
+				Expect(svc.Spec.Ports).To(HaveLen(2))
+			})
+
 			It("should not render a FlexVolume container if FlexVolumePath is set to None", func() {
 				defaultInstance.FlexVolumePath = "None"
 				component := render.Node(&cfg)
@@ -2363,6 +2427,37 @@ var _ = Describe("Node rendering tests", func() {
 				Expect(ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable).To(Equal(&two))
 			})
 
+			It("should cap MaxUnavailable to MaxConcurrentNodeRestarts", func() {
+				five := intstr.FromInt(5)
+				limit := int32(2)
+				defaultInstance.NodeUpdateStrategy.RollingUpdate.MaxUnavailable = &five
+				defaultInstance.MaxConcurrentNodeRestarts = &limit
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, _ := component.Objects()
+
+				dsResource := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet")
+				ds := dsResource.(*appsv1.DaemonSet)
+				two := intstr.FromInt(2)
+				Expect(ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable).To(Equal(&two))
+
+				// The Installation itself is not modified.
+				Expect(defaultInstance.NodeUpdateStrategy.RollingUpdate.MaxUnavailable).To(Equal(&five))
+			})
+
+			It("should not raise MaxUnavailable to MaxConcurrentNodeRestarts", func() {
+				limit := int32(3)
+				defaultInstance.MaxConcurrentNodeRestarts = &limit
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, _ := component.Objects()
+
+				dsResource := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet")
+				ds := dsResource.(*appsv1.DaemonSet)
+				one := intstr.FromInt(1)
+				Expect(ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable).To(Equal(&one))
+			})
+
 			It("should render LinuxPolicySetupTimeoutSeconds if a custom value was set", func() {
 				two := int32(2)
 				defaultInstance.CalicoNetwork.LinuxPolicySetupTimeoutSeconds = &two
@@ -3179,6 +3274,52 @@ var _ = Describe("Node rendering tests", func() {
 				})
 			})
 
+			Context("with the BGP readiness gate", func() {
+				It("should not add a readiness gate by default", func() {
+					component := render.Node(&cfg)
+					Expect(component.ResolveImages(nil)).To(BeNil())
+					resources, _ := component.Objects()
+
+					dsResource := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet")
+					ds := dsResource.(*appsv1.DaemonSet)
+					Expect(ds.Spec.Template.Spec.ReadinessGates).To(BeEmpty())
+					Expect(ds.Spec.Template.Spec.Containers[0].Env).NotTo(ContainElement(
+						HaveField("Name", "CALICO_BGP_READINESS_GATE"),
+					))
+				})
+
+				It("should add the BGP converged readiness gate when enabled", func() {
+					gate := operatorv1.BGPReadinessGateEnabled
+					cfg.Installation.CalicoNetwork.BGPReadinessGate = &gate
+					component := render.Node(&cfg)
+					Expect(component.ResolveImages(nil)).To(BeNil())
+					resources, _ := component.Objects()
+
+					dsResource := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet")
+					ds := dsResource.(*appsv1.DaemonSet)
+					Expect(ds.Spec.Template.Spec.ReadinessGates).To(ConsistOf(
+						corev1.PodReadinessGate{ConditionType: "projectcalico.org/BGPConverged"},
+					))
+					Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(
+						corev1.EnvVar{Name: "CALICO_BGP_READINESS_GATE", Value: "projectcalico.org/BGPConverged"},
+					))
+				})
+
+				It("should not add a readiness gate when BGP is disabled", func() {
+					gate := operatorv1.BGPReadinessGateEnabled
+					bgpDisabled := operatorv1.BGPDisabled
+					cfg.Installation.CalicoNetwork.BGPReadinessGate = &gate
+					cfg.Installation.CalicoNetwork.BGP = &bgpDisabled
+					component := render.Node(&cfg)
+					Expect(component.ResolveImages(nil)).To(BeNil())
+					resources, _ := component.Objects()
+
+					dsResource := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet")
+					ds := dsResource.(*appsv1.DaemonSet)
+					Expect(ds.Spec.Template.Spec.ReadinessGates).To(BeEmpty())
+				})
+			})
+
 			Context("with k8s overrides set", func() {
 				It("should override k8s endpoints", func() {
 					cfg.K8sServiceEp = k8sapi.ServiceEndpoint{
@@ -3299,6 +3440,81 @@ var _ = Describe("Node rendering tests", func() {
 				rtest.ExpectEnv(deploy.Spec.Template.Spec.Containers[0].Env, "CALICO_EARLY_NETWORKING", render.BGPLayoutPath)
 			})
 
+			It("should accept traffic from workloads to the host by default", func() {
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, _ := component.Objects()
+				dsResource := rtest.GetResource(resources, common.NodeDaemonSetName, common.CalicoNamespace, "apps", "v1", "DaemonSet")
+				Expect(dsResource).ToNot(BeNil())
+
+				ds := dsResource.(*appsv1.DaemonSet)
+				rtest.ExpectEnv(ds.Spec.Template.Spec.Containers[0].Env, "FELIX_DEFAULTENDPOINTTOHOSTACTION", "ACCEPT")
+			})
+
+			It("should leave the default endpoint to host action to FelixConfiguration when set on the Installation", func() {
+				action := operatorv1.EndpointToHostActionDrop
+				cfg.Installation.CalicoNetwork.DefaultEndpointToHostAction = &action
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, _ := component.Objects()
+				dsResource := rtest.GetResource(resources, common.NodeDaemonSetName, common.CalicoNamespace, "apps", "v1", "DaemonSet")
+				Expect(dsResource).ToNot(BeNil())
+
+				ds := dsResource.(*appsv1.DaemonSet)
+				for _, env := range ds.Spec.Template.Spec.Containers[0].Env {
+					Expect(env.Name).NotTo(Equal("FELIX_DEFAULTENDPOINTTOHOSTACTION"))
+				}
+			})
+
+			DescribeTable("should leave WireGuard host encryption to FelixConfiguration when set on the Installation",
+				func(hostEncryption *operatorv1.WireguardHostEncryptionType, expectEnv bool) {
+					cfg.Installation.KubernetesProvider = operatorv1.ProviderEKS
+					cfg.Installation.CNI = &operatorv1.CNISpec{Type: operatorv1.PluginAmazonVPC}
+					cfg.Installation.CalicoNetwork = &operatorv1.CalicoNetworkSpec{WireguardHostEncryption: hostEncryption}
+					component := render.Node(&cfg)
+					Expect(component.ResolveImages(nil)).To(BeNil())
+					resources, _ := component.Objects()
+					ds := rtest.GetResource(resources, common.NodeDaemonSetName, common.CalicoNamespace, "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+
+					envVar := corev1.EnvVar{Name: "FELIX_WIREGUARDHOSTENCRYPTIONENABLED", Value: "true"}
+					if expectEnv {
+						Expect(ds.Spec.Template.Spec.Containers[0].Env).To(ContainElement(envVar))
+					} else {
+						Expect(ds.Spec.Template.Spec.Containers[0].Env).NotTo(ContainElement(envVar))
+					}
+				},
+				Entry("not set", nil, true),
+				Entry("Enabled", ptr.ToPtr(operatorv1.WireguardHostEncryptionEnabled), false),
+				Entry("Disabled", ptr.ToPtr(operatorv1.WireguardHostEncryptionDisabled), false),
+			)
+
+			It("should label calico-node pods with the Installation generation when configured", func() {
+				cfg.Installation.CalicoNodeGenerationLabel = "example.com/installation-generation"
+				cfg.InstallationGeneration = 7
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, _ := component.Objects()
+				dsResource := rtest.GetResource(resources, common.NodeDaemonSetName, common.CalicoNamespace, "apps", "v1", "DaemonSet")
+				Expect(dsResource).ToNot(BeNil())
+
+				ds := dsResource.(*appsv1.DaemonSet)
+				Expect(ds.Spec.Template.Labels).To(HaveKeyWithValue("example.com/installation-generation", "7"))
+			})
+
+			It("should render the configured annotations on the calico-node service account only", func() {
+				cfg.Installation.ServiceAccountAnnotations = []operatorv1.ServiceAccountAnnotations{
+					{ComponentName: operatorv1.ComponentNameNode, Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/calico-node"}},
+				}
+				component := render.Node(&cfg)
+				Expect(component.ResolveImages(nil)).To(BeNil())
+				resources, _ := component.Objects()
+
+				sa := rtest.GetResource(resources, render.CalicoNodeObjectName, common.CalicoNamespace, "", "v1", "ServiceAccount").(*corev1.ServiceAccount)
+				Expect(sa.Annotations).To(Equal(map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/calico-node"}))
+				cniSA := rtest.GetResource(resources, render.CalicoCNIPluginObjectName, common.CalicoNamespace, "", "v1", "ServiceAccount").(*corev1.ServiceAccount)
+				Expect(cniSA.Annotations).To(BeEmpty())
+			})
+
 			It("should render the correct env and/or images when FIPS mode is enabled (EE)", func() {
 				fipsEnabled := operatorv1.FIPSModeEnabled
 				cfg.Installation.FIPSMode = &fipsEnabled
@@ -3463,10 +3679,95 @@ var _ = Describe("Node rendering tests", func() {
 					Expect(ds.Spec.Template.Spec.NodeSelector).To(HaveLen(1))
 					Expect(ds.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("custom-node-selector", "value"))
 
+					Expect(ds.Spec.Template.Spec.Affinity).To(Equal(affinity))
+
 					Expect(ds.Spec.Template.Spec.Tolerations).To(HaveLen(1))
 					Expect(ds.Spec.Template.Spec.Tolerations[0]).To(Equal(toleration))
 				})
 
+				It("should preserve the operator's required node affinity when the affinity is overridden", func() {
+					defaultInstance.KubernetesProvider = operatorv1.ProviderEKS
+					defaultInstance.CalicoNodeDaemonSet = &operatorv1.CalicoNodeDaemonSet{
+						Spec: &operatorv1.CalicoNodeDaemonSetSpec{
+							Template: &operatorv1.CalicoNodeDaemonSetPodTemplateSpec{
+								Spec: &operatorv1.CalicoNodeDaemonSetPodSpec{
+									Affinity: &corev1.Affinity{
+										NodeAffinity: &corev1.NodeAffinity{
+											RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+												NodeSelectorTerms: []corev1.NodeSelectorTerm{
+													{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gpu", Operator: corev1.NodeSelectorOpExists}}},
+													{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "special", Operator: corev1.NodeSelectorOpExists}}},
+												},
+											},
+											PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{{
+												Weight:     10,
+												Preference: corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gpu", Operator: corev1.NodeSelectorOpExists}}},
+											}},
+										},
+									},
+								},
+							},
+						},
+					}
+
+					component := render.Node(&cfg)
+					Expect(component.ResolveImages(nil)).To(BeNil())
+					resources, _ := component.Objects()
+					ds := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+
+					fargate := corev1.NodeSelectorRequirement{
+						Key:      "eks.amazonaws.com/compute-type",
+						Operator: corev1.NodeSelectorOpNotIn,
+						Values:   []string{"fargate"},
+					}
+					nodeAffinity := ds.Spec.Template.Spec.Affinity.NodeAffinity
+					Expect(nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(
+						corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gpu", Operator: corev1.NodeSelectorOpExists}, fargate}},
+						corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "special", Operator: corev1.NodeSelectorOpExists}, fargate}},
+					))
+					Expect(nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution).To(HaveLen(1))
+
+					// The override on the Installation should not be modified.
+					overrideTerms := defaultInstance.CalicoNodeDaemonSet.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+					Expect(overrideTerms[0].MatchExpressions).To(HaveLen(1))
+				})
+
+				It("should add the operator's required node affinity to an affinity override without one", func() {
+					defaultInstance.KubernetesProvider = operatorv1.ProviderAKS
+					defaultInstance.CalicoNodeDaemonSet = &operatorv1.CalicoNodeDaemonSet{
+						Spec: &operatorv1.CalicoNodeDaemonSetSpec{
+							Template: &operatorv1.CalicoNodeDaemonSetPodTemplateSpec{
+								Spec: &operatorv1.CalicoNodeDaemonSetPodSpec{
+									Affinity: &corev1.Affinity{
+										PodAntiAffinity: &corev1.PodAntiAffinity{
+											PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+												Weight:          1,
+												PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: "kubernetes.io/hostname"},
+											}},
+										},
+									},
+								},
+							},
+						},
+					}
+
+					component := render.Node(&cfg)
+					Expect(component.ResolveImages(nil)).To(BeNil())
+					resources, _ := component.Objects()
+					ds := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet").(*appsv1.DaemonSet)
+
+					Expect(ds.Spec.Template.Spec.Affinity.PodAntiAffinity).NotTo(BeNil())
+					Expect(ds.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(
+						corev1.NodeSelectorTerm{
+							MatchExpressions: []corev1.NodeSelectorRequirement{{
+								Key:      "type",
+								Operator: corev1.NodeSelectorOpNotIn,
+								Values:   []string{"virtual-kubelet"},
+							}},
+						},
+					))
+				})
+
 				It("should override ComponentResources", func() {
 					defaultInstance.ComponentResources = []operatorv1.ComponentResource{
 						{
@@ -3499,6 +3800,92 @@ var _ = Describe("Node rendering tests", func() {
 					Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
 					Expect(ds.Spec.Template.Spec.Containers[0].Resources).To(Equal(rr2))
 				})
+
+				It("should render a startup probe when configured", func() {
+					defaultInstance.CalicoNodeDaemonSet = &operatorv1.CalicoNodeDaemonSet{
+						Spec: &operatorv1.CalicoNodeDaemonSetSpec{
+							Template: &operatorv1.CalicoNodeDaemonSetPodTemplateSpec{
+								Spec: &operatorv1.CalicoNodeDaemonSetPodSpec{
+									Containers: []operatorv1.CalicoNodeDaemonSetContainer{
+										{
+											Name:         "calico-node",
+											StartupProbe: &operatorv1.CalicoNodeStartupProbe{FailureThreshold: ptr.Int32ToPtr(60)},
+										},
+									},
+								},
+							},
+						},
+					}
+
+					component := render.Node(&cfg)
+					resources, _ := component.Objects()
+					dsResource := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet")
+					Expect(dsResource).ToNot(BeNil())
+
+					ds := dsResource.(*appsv1.DaemonSet)
+					container := rtest.GetContainer(ds.Spec.Template.Spec.Containers, "calico-node")
+					Expect(container.StartupProbe).To(Equal(&corev1.Probe{
+						ProbeHandler: corev1.ProbeHandler{
+							HTTPGet: &corev1.HTTPGetAction{
+								Host: "localhost",
+								Path: "/liveness",
+								Port: intstr.FromInt(cfg.FelixHealthPort),
+							},
+						},
+						TimeoutSeconds:   10,
+						PeriodSeconds:    10,
+						FailureThreshold: 60,
+					}))
+					// The liveness and readiness probes are unchanged.
+					Expect(container.LivenessProbe).NotTo(BeNil())
+					Expect(container.ReadinessProbe).NotTo(BeNil())
+				})
+
+				It("should not render a startup probe by default", func() {
+					component := render.Node(&cfg)
+					resources, _ := component.Objects()
+					dsResource := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet")
+					Expect(dsResource).ToNot(BeNil())
+
+					ds := dsResource.(*appsv1.DaemonSet)
+					container := rtest.GetContainer(ds.Spec.Template.Spec.Containers, "calico-node")
+					Expect(container.StartupProbe).To(BeNil())
+				})
+
+				It("should add user-provided sidecar containers", func() {
+					sidecar := corev1.Container{
+						Name:         "telemetry",
+						Image:        "example.com/telemetry:v1",
+						Resources:    rr1,
+						VolumeMounts: []corev1.VolumeMount{{Name: "var-run-calico", MountPath: "/var/run/calico", ReadOnly: true}},
+					}
+					defaultInstance.CalicoNodeDaemonSet = &operatorv1.CalicoNodeDaemonSet{
+						Spec: &operatorv1.CalicoNodeDaemonSetSpec{
+							Template: &operatorv1.CalicoNodeDaemonSetPodTemplateSpec{
+								Spec: &operatorv1.CalicoNodeDaemonSetPodSpec{
+									ExtraContainers: []corev1.Container{sidecar},
+								},
+							},
+						},
+					}
+
+					component := render.Node(&cfg)
+					resources, _ := component.Objects()
+					dsResource := rtest.GetResource(resources, "calico-node", "calico-system", "apps", "v1", "DaemonSet")
+					Expect(dsResource).ToNot(BeNil())
+
+					ds := dsResource.(*appsv1.DaemonSet)
+					Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(2))
+					Expect(ds.Spec.Template.Spec.Containers[0].Name).To(Equal("calico-node"))
+					Expect(ds.Spec.Template.Spec.Containers[1]).To(Equal(sidecar))
+
+					// The sidecar mounts a volume that is part of the calico-node pod.
+					var volumeNames []string
+					for _, v := range ds.Spec.Template.Spec.Volumes {
+						volumeNames = append(volumeNames, v.Name)
+					}
+					Expect(volumeNames).To(ContainElement("var-run-calico"))
+				})
 			})
 		})
 	}
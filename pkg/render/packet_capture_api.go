@@ -64,6 +64,7 @@ type PacketCaptureApiConfiguration struct {
 	Openshift                   bool
 	Installation                *operatorv1.InstallationSpec
 	KeyValidatorConfig          authentication.KeyValidatorConfig
+	Authentication              *operatorv1.Authentication
 	ServerCertSecret            certificatemanagement.KeyPairInterface
 	TrustedBundle               certificatemanagement.TrustedBundle
 	ClusterDomain               string
@@ -107,7 +108,7 @@ func (pc *packetCaptureApiComponent) SupportedOSType() rmeta.OSType {
 
 func (pc *packetCaptureApiComponent) Objects() ([]client.Object, []client.Object) {
 	objs := []client.Object{
-		CreateNamespace(PacketCaptureNamespace, pc.cfg.Installation.KubernetesProvider, PSSRestricted),
+		CreateNamespace(PacketCaptureNamespace, pc.cfg.Installation, PSSRestricted),
 	}
 	objs = append(objs, secret.ToRuntimeObjects(secret.CopyToNamespace(PacketCaptureNamespace, pc.cfg.PullSecrets...)...)...)
 
@@ -352,7 +353,7 @@ func allowTigeraPolicy(cfg *PacketCaptureApiConfiguration) *v3.NetworkPolicy {
 		egressRules = append(egressRules, v3.Rule{
 			Action:      v3.Allow,
 			Protocol:    &networkpolicy.TCPProtocol,
-			Destination: DexEntityRule,
+			Destination: DexEntityRule(cfg.Authentication),
 		})
 	}
 
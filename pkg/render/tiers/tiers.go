@@ -29,9 +29,12 @@ import (
 const (
 	ClusterDNSPolicyName   = networkpolicy.TigeraComponentPolicyPrefix + "cluster-dns"
 	NodeLocalDNSPolicyName = networkpolicy.TigeraComponentPolicyPrefix + "node-local-dns"
+
+	// AllowTigeraTierOrder is the order of the allow-tigera tier. Tiers for user workloads must be ordered after it.
+	AllowTigeraTierOrder = 100.0
 )
 
-var defaultTierOrder = 100.0
+var defaultTierOrder = AllowTigeraTierOrder
 
 func Tiers(cfg *Config) render.Component {
 	return tiersComponent{cfg: cfg}
@@ -45,6 +48,9 @@ type Config struct {
 	// populated dynamically by the controller in order to correctly capture the set of namespaces
 	// that require inclusion in policy generated by this component.
 	CalicoNamespaces []string
+
+	// UserWorkloadTier is an optional tier for user workloads that is rendered in addition to allow-tigera.
+	UserWorkloadTier *operatorv1.UserWorkloadTier
 }
 
 type DNSEgressCIDR struct {
@@ -74,6 +80,10 @@ func (t tiersComponent) Objects() ([]client.Object, []client.Object) {
 		objsToDelete = append(objsToDelete, t.allowTigeraNodeLocalDNSPolicy())
 	}
 
+	if t.cfg.UserWorkloadTier != nil {
+		objsToCreate = append(objsToCreate, t.userWorkloadTier())
+	}
+
 	return objsToCreate, objsToDelete
 }
 
@@ -100,6 +110,19 @@ func (t tiersComponent) allowTigeraTier() *v3.Tier {
 	}
 }
 
+func (t tiersComponent) userWorkloadTier() *v3.Tier {
+	order := float64(t.cfg.UserWorkloadTier.Order)
+	return &v3.Tier{
+		TypeMeta: metav1.TypeMeta{Kind: "Tier", APIVersion: "projectcalico.org/v3"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: t.cfg.UserWorkloadTier.Name,
+		},
+		Spec: v3.TierSpec{
+			Order: &order,
+		},
+	}
+}
+
 // allowTigeraClusterDNSPolicy creates a NetworkPolicy that applies to the DNS pods in the cluster and
 // inserts an Ingress rule to ensure all Tigera components can access the DNS pods. It defers other ingress
 // to subsequent tiers using a Pass rule.
@@ -171,4 +171,30 @@ var _ = Describe("Tiers rendering tests", func() {
 			Entry("for when ipMode is not provided", nil),
 		)
 	})
+	Context("user workload tier rendering", func() {
+		It("should not render a user workload tier by default", func() {
+			component := tiers.Tiers(cfg)
+			resourcesToCreate, _ := component.Objects()
+
+			var tierNames []string
+			for _, obj := range resourcesToCreate {
+				if _, ok := obj.(*v3.Tier); ok {
+					tierNames = append(tierNames, obj.GetName())
+				}
+			}
+			Expect(tierNames).To(ConsistOf("allow-tigera"))
+		})
+
+		It("should render the configured user workload tier after allow-tigera", func() {
+			cfg.UserWorkloadTier = &v1.UserWorkloadTier{Name: "workloads", Order: 500}
+			component := tiers.Tiers(cfg)
+			resourcesToCreate, _ := component.Objects()
+
+			allowTigera := rtest.GetGlobalResource(resourcesToCreate, "allow-tigera", "projectcalico.org", "v3", "Tier").(*v3.Tier)
+			userTier := rtest.GetGlobalResource(resourcesToCreate, "workloads", "projectcalico.org", "v3", "Tier").(*v3.Tier)
+			Expect(*userTier.Spec.Order).To(Equal(500.0))
+			Expect(*userTier.Spec.Order).To(BeNumerically(">", *allowTigera.Spec.Order))
+			Expect(userTier.Labels).NotTo(HaveKey("projectcalico.org/system-tier"))
+		})
+	})
 })
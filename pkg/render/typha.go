@@ -162,8 +162,9 @@ func (c *typhaComponent) typhaServiceAccount() *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      TyphaServiceAccountName,
-			Namespace: common.CalicoNamespace,
+			Name:        TyphaServiceAccountName,
+			Namespace:   common.CalicoNamespace,
+			Annotations: rmeta.GetServiceAccountAnnotations(c.cfg.Installation, operatorv1.ComponentNameTypha),
 		},
 	}
 }
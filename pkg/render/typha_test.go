@@ -604,6 +604,8 @@ var _ = Describe("Typha rendering tests", func() {
 			Expect(d.Spec.Template.Spec.NodeSelector).To(HaveLen(1))
 			Expect(d.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("custom-node-selector", "value"))
 
+			Expect(d.Spec.Template.Spec.Affinity).To(Equal(affinity))
+
 			Expect(d.Spec.Template.Spec.TopologySpreadConstraints).To(HaveLen(1))
 			Expect(d.Spec.Template.Spec.TopologySpreadConstraints[0].MaxSkew).To(Equal(int32(1)))
 
@@ -688,6 +690,54 @@ var _ = Describe("Typha rendering tests", func() {
 			Expect(d.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("custom-node-selector", "value"))
 		})
 
+		It("should override TyphaAffinity when an affinity is specified", func() {
+			installation.TyphaAffinity = &operatorv1.TyphaAffinity{
+				NodeAffinity: &operatorv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+							MatchExpressions: []corev1.NodeSelectorRequirement{{
+								Key:      "deprecated",
+								Operator: corev1.NodeSelectorOpExists,
+							}},
+						}},
+					},
+				},
+			}
+
+			affinity := &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+						NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+							MatchExpressions: []corev1.NodeSelectorRequirement{{
+								Key:      "node-pool",
+								Operator: corev1.NodeSelectorOpIn,
+								Values:   []string{"infra"},
+							}},
+						}},
+					},
+				},
+			}
+			installation.TyphaDeployment = &operatorv1.TyphaDeployment{
+				Spec: &operatorv1.TyphaDeploymentSpec{
+					Template: &operatorv1.TyphaDeploymentPodTemplateSpec{
+						Spec: &operatorv1.TyphaDeploymentPodSpec{
+							Affinity: affinity,
+						},
+					},
+				},
+			}
+			component := render.Typha(&cfg)
+			Expect(component.ResolveImages(nil)).To(BeNil())
+			resources, _ := component.Objects()
+
+			dResource := rtest.GetResource(resources, "calico-typha", "calico-system", "apps", "v1", "Deployment")
+			Expect(dResource).ToNot(BeNil())
+
+			d := dResource.(*appsv1.Deployment)
+
+			Expect(d.Spec.Template.Spec.Affinity).To(Equal(affinity))
+		})
+
 		It("should override ControlPlaneTolerations when specified", func() {
 			cfg.Installation.ControlPlaneTolerations = rmeta.TolerateControlPlane
 
@@ -140,6 +140,29 @@ func (c *windowsComponent) Ready() bool {
 // This service is used internally by Calico Enterprise and is separate from general
 // Prometheus metrics which are user-configurable.
 func (c *windowsComponent) nodeMetricsService() *corev1.Service {
+	ports := []corev1.ServicePort{
+		{
+			Name:       "calico-metrics-port",
+			Port:       int32(c.cfg.NodeReporterMetricsPort),
+			TargetPort: intstr.FromInt(c.cfg.NodeReporterMetricsPort),
+			Protocol:   corev1.ProtocolTCP,
+		},
+		{
+			Name:       "calico-bgp-metrics-port",
+			Port:       nodeBGPReporterPort,
+			TargetPort: intstr.FromInt(int(nodeBGPReporterPort)),
+			Protocol:   corev1.ProtocolTCP,
+		},
+	}
+	if port, enabled := NodeMetricsPort(c.cfg.Installation); enabled {
+		ports = append(ports, corev1.ServicePort{
+			Name:       NodeMetricsPortName,
+			Port:       port,
+			TargetPort: intstr.FromInt(int(port)),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+
 	return &corev1.Service{
 		TypeMeta: metav1.TypeMeta{Kind: "Service", APIVersion: "v1"},
 		ObjectMeta: metav1.ObjectMeta{
@@ -154,20 +177,7 @@ func (c *windowsComponent) nodeMetricsService() *corev1.Service {
 			// a huge set of iptables rules for this service since there's an instance
 			// on every node.
 			ClusterIP: "None",
-			Ports: []corev1.ServicePort{
-				{
-					Name:       "calico-metrics-port",
-					Port:       int32(c.cfg.NodeReporterMetricsPort),
-					TargetPort: intstr.FromInt(c.cfg.NodeReporterMetricsPort),
-					Protocol:   corev1.ProtocolTCP,
-				},
-				{
-					Name:       "calico-bgp-metrics-port",
-					Port:       nodeBGPReporterPort,
-					TargetPort: intstr.FromInt(int(nodeBGPReporterPort)),
-					Protocol:   corev1.ProtocolTCP,
-				},
-			},
+			Ports:     ports,
 		},
 	}
 }
@@ -682,12 +692,12 @@ func (c *windowsComponent) windowsEnvVars() []corev1.EnvVar {
 		windowsEnv = append(windowsEnv, extraNodeEnv...)
 	}
 
-	if c.cfg.Installation.NodeMetricsPort != nil {
+	if port, enabled := NodeMetricsPort(c.cfg.Installation); enabled {
 		// If a node metrics port was given, then enable felix prometheus metrics and set the port.
 		// Note that this takes precedence over any FelixConfiguration resources in the cluster.
 		extraNodeEnv := []corev1.EnvVar{
 			{Name: "FELIX_PROMETHEUSMETRICSENABLED", Value: "true"},
-			{Name: "FELIX_PROMETHEUSMETRICSPORT", Value: fmt.Sprintf("%d", *c.cfg.Installation.NodeMetricsPort)},
+			{Name: "FELIX_PROMETHEUSMETRICSPORT", Value: fmt.Sprintf("%d", port)},
 		}
 		windowsEnv = append(windowsEnv, extraNodeEnv...)
 	}
@@ -804,9 +814,9 @@ func (c *windowsComponent) windowsDaemonset(cniCfgMap *corev1.ConfigMap) *appsv1
 	}
 
 	// Include annotation for prometheus scraping configuration.
-	if c.cfg.Installation.NodeMetricsPort != nil {
+	if port, enabled := NodeMetricsPort(c.cfg.Installation); enabled {
 		annotations["prometheus.io/scrape"] = "true"
-		annotations["prometheus.io/port"] = fmt.Sprintf("%d", *c.cfg.Installation.NodeMetricsPort)
+		annotations["prometheus.io/port"] = fmt.Sprintf("%d", port)
 	}
 
 	var affinity *corev1.Affinity
@@ -867,7 +877,7 @@ func (c *windowsComponent) windowsDaemonset(cniCfgMap *corev1.ConfigMap) *appsv1
 					Volumes: c.windowsVolumes(),
 				},
 			},
-			UpdateStrategy: c.cfg.Installation.NodeUpdateStrategy,
+			UpdateStrategy: nodeUpdateStrategy(c.cfg.Installation),
 		},
 	}
 
@@ -2119,6 +2119,15 @@ var _ = Describe("Windows rendering tests", func() {
 		// Assert we set annotations properly.
 		Expect(ds.Spec.Template.Annotations["prometheus.io/scrape"]).To(Equal("true"))
 		Expect(ds.Spec.Template.Annotations["prometheus.io/port"]).To(Equal("1234"))
+
+		// Assert the metrics service exposes the same port.
+		svc := rtest.GetResource(resources, render.WindowsNodeMetricsService, "calico-system", "", "v1", "Service").(*corev1.Service)
+		Expect(svc.Spec.Ports).To(ContainElement(corev1.ServicePort{
+			Name:       render.NodeMetricsPortName,
+			Port:       1234,
+			TargetPort: intstr.FromInt(1234),
+			Protocol:   corev1.ProtocolTCP,
+		}))
 	})
 
 	It("should render MaxUnavailable if a custom value was set", func() {
@@ -2137,6 +2146,34 @@ var _ = Describe("Windows rendering tests", func() {
 		Expect(ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable).To(Equal(&two))
 	})
 
+	It("should cap MaxUnavailable to MaxConcurrentNodeRestarts", func() {
+		five := intstr.FromInt(5)
+		limit := int32(2)
+		defaultInstance.NodeUpdateStrategy.RollingUpdate.MaxUnavailable = &five
+		defaultInstance.MaxConcurrentNodeRestarts = &limit
+		component := render.Windows(&cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		dsResource := rtest.GetResource(resources, "calico-node-windows", "calico-system", "apps", "v1", "DaemonSet")
+		ds := dsResource.(*appsv1.DaemonSet)
+		two := intstr.FromInt(2)
+		Expect(ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable).To(Equal(&two))
+	})
+
+	It("should not raise MaxUnavailable to MaxConcurrentNodeRestarts", func() {
+		limit := int32(3)
+		defaultInstance.MaxConcurrentNodeRestarts = &limit
+		component := render.Windows(&cfg)
+		Expect(component.ResolveImages(nil)).To(BeNil())
+		resources, _ := component.Objects()
+
+		dsResource := rtest.GetResource(resources, "calico-node-windows", "calico-system", "apps", "v1", "DaemonSet")
+		ds := dsResource.(*appsv1.DaemonSet)
+		one := intstr.FromInt(1)
+		Expect(ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable).To(Equal(&one))
+	})
+
 	It("should render cni config with host-local", func() {
 		defaultInstance.CNI.IPAM.Type = operatorv1.IPAMPluginHostLocal
 		component := render.Windows(&cfg)